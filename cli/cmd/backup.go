@@ -0,0 +1,376 @@
+package cmd
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	sp "github.com/linkerd/linkerd2/controller/gen/apis/serviceprofile/v1alpha2"
+	l5dcharts "github.com/linkerd/linkerd2/pkg/charts/linkerd2"
+	"github.com/linkerd/linkerd2/pkg/healthcheck"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/linkerd/linkerd2/pkg/multicluster"
+	"github.com/linkerd/linkerd2/pkg/version"
+	"github.com/spf13/cobra"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// backupFormatVersion is bumped whenever the layout of the archive
+	// changes in a way older versions of the restore command can't read
+	backupFormatVersion = 1
+
+	backupMetadataFile        = "metadata.yaml"
+	backupConfigOverridesFile = "config/linkerd-config-overrides.yaml"
+	backupTrustAnchorsFile    = "identity/trust-anchors.pem"
+	backupIssuerSecretFile    = "identity/linkerd-identity-issuer.yaml"
+	backupServiceProfilesFile = "serviceprofiles.yaml"
+	backupLinksFile           = "multicluster/links.yaml"
+	backupLinkSecretsFile     = "multicluster/credentials.yaml"
+
+	linkCRDName = "links.multicluster.linkerd.io"
+)
+
+var serviceProfileGVR = sp.SchemeGroupVersion.WithResource("serviceprofiles")
+
+type (
+	// backupMetadata describes where and when a backup was taken
+	backupMetadata struct {
+		FormatVersion  int    `json:"formatVersion"`
+		LinkerdVersion string `json:"linkerdVersion"`
+		CLIVersion     string `json:"cliVersion"`
+		Namespace      string `json:"namespace"`
+		CreatedAt      string `json:"createdAt"`
+	}
+
+	// backupArchive holds everything needed to rebuild a control plane:
+	// the values it was installed with, its identity, the ServiceProfiles of
+	// the meshed workloads and the multicluster Links along with their
+	// credentials
+	backupArchive struct {
+		Metadata        backupMetadata
+		ConfigOverrides []byte
+		TrustAnchors    string
+		IssuerSecret    *unstructured.Unstructured
+		ServiceProfiles []*unstructured.Unstructured
+		Links           []*unstructured.Unstructured
+		LinkSecrets     []*unstructured.Unstructured
+	}
+)
+
+// newCmdBackup creates a new cobra command `backup` which exports the
+// resources needed to rebuild the control plane into an archive
+func newCmdBackup() *cobra.Command {
+	file := "linkerd-backup.tar.gz"
+
+	cmd := &cobra.Command{
+		Use:   "backup",
+		Short: "Export the Linkerd control plane configuration into an archive",
+		Long: `Export the Linkerd control plane configuration into an archive.
+
+The archive contains the values the control plane was installed with, the trust
+anchors and issuer credentials, all the ServiceProfiles and, if the multicluster
+extension is installed, the Links and their cluster credentials. It can be used
+with 'linkerd restore' to reinstall the control plane into an empty cluster.
+
+The archive contains private keys and cluster credentials, and must be stored
+securely.`,
+		Example: `  # Backup the control plane into linkerd-backup.tar.gz
+  linkerd backup
+
+  # Backup the control plane into a specific file
+  linkerd backup --file /backups/linkerd-$(date +%F).tar.gz`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			k8sAPI, err := k8s.NewAPI(kubeconfigPath, kubeContext, impersonate, impersonateGroup, 30*time.Second)
+			if err != nil {
+				return err
+			}
+
+			archive, err := createBackup(cmd.Context(), k8sAPI)
+			if err != nil {
+				return err
+			}
+
+			f, err := os.OpenFile(file, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			if err := archive.write(f); err != nil {
+				return err
+			}
+
+			fmt.Printf("%s Linkerd %s backed up into %s (%d ServiceProfiles, %d Links)\n",
+				okStatus, archive.Metadata.LinkerdVersion, file, len(archive.ServiceProfiles), len(archive.Links))
+			fmt.Printf("%s %s contains private keys and must be stored securely\n", warnStatus, file)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&file, "file", "f", file, "Path of the archive to write")
+
+	return cmd
+}
+
+// createBackup reads the resources needed to rebuild the control plane
+// installed in controlPlaneNamespace
+func createBackup(ctx context.Context, k *k8s.KubernetesAPI) (*backupArchive, error) {
+	serverVersion, err := healthcheck.GetServerVersion(ctx, controlPlaneNamespace, k)
+	if err != nil {
+		return nil, err
+	}
+	archive := &backupArchive{
+		Metadata: backupMetadata{
+			FormatVersion:  backupFormatVersion,
+			LinkerdVersion: serverVersion,
+			CLIVersion:     version.Version,
+			Namespace:      controlPlaneNamespace,
+			CreatedAt:      time.Now().UTC().Format(time.RFC3339),
+		},
+	}
+
+	overrides, err := k.CoreV1().Secrets(controlPlaneNamespace).Get(ctx, configOverridesSecretName, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		return nil, fmt.Errorf("secret/%s not found. If Linkerd was installed with Helm, please use Helm to back up its values. Otherwise, please use the 'linkerd repair' command to restore it first", configOverridesSecretName)
+	}
+	if err != nil {
+		return nil, err
+	}
+	data, ok := overrides.Data[configOverridesSecretName]
+	if !ok {
+		return nil, fmt.Errorf("secret/%s is missing %s data", configOverridesSecretName, configOverridesSecretName)
+	}
+	archive.ConfigOverrides = data
+
+	cm, err := k.CoreV1().ConfigMaps(controlPlaneNamespace).Get(ctx, k8s.ConfigConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s: %s", k8s.ConfigConfigMapName, err)
+	}
+	values, err := l5dcharts.ValuesFromConfigMap(cm)
+	if err != nil {
+		return nil, err
+	}
+	archive.TrustAnchors = values.IdentityTrustAnchorsPEM
+
+	issuer, err := k.CoreV1().Secrets(controlPlaneNamespace).Get(ctx, k8s.IdentityIssuerSecretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the issuer credentials: %s", err)
+	}
+	issuer.SetGroupVersionKind(schema.GroupVersionKind{Version: "v1", Kind: "Secret"})
+	if archive.IssuerSecret, err = toCleanUnstructured(issuer); err != nil {
+		return nil, err
+	}
+
+	profiles, err := k.DynamicClient.Resource(serviceProfileGVR).Namespace(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ServiceProfiles: %s", err)
+	}
+	for i := range profiles.Items {
+		archive.ServiceProfiles = append(archive.ServiceProfiles, cleanObject(&profiles.Items[i]))
+	}
+
+	// Links can only exist if the multicluster extension is installed
+	_, err = k.Apiextensions.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, linkCRDName, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		return archive, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	links, err := k.DynamicClient.Resource(multicluster.LinkGVR).Namespace(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Links: %s", err)
+	}
+	for i := range links.Items {
+		link, err := multicluster.NewLink(links.Items[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse Link %s: %s", links.Items[i].GetName(), err)
+		}
+		secret, err := k.CoreV1().Secrets(link.Namespace).Get(ctx, link.ClusterCredentialsSecret, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the credentials of Link %s: %s", link.Name, err)
+		}
+		secret.SetGroupVersionKind(schema.GroupVersionKind{Version: "v1", Kind: "Secret"})
+		cleanSecret, err := toCleanUnstructured(secret)
+		if err != nil {
+			return nil, err
+		}
+		archive.Links = append(archive.Links, cleanObject(&links.Items[i]))
+		archive.LinkSecrets = append(archive.LinkSecrets, cleanSecret)
+	}
+
+	return archive, nil
+}
+
+// toCleanUnstructured converts a typed object into a clean unstructured one
+func toCleanUnstructured(obj runtime.Object) (*unstructured.Unstructured, error) {
+	objMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	return cleanObject(&unstructured.Unstructured{Object: objMap}), nil
+}
+
+// cleanObject returns a copy of obj without the fields set by the API
+// server, so that it can be created again in another cluster
+func cleanObject(obj *unstructured.Unstructured) *unstructured.Unstructured {
+	clean := obj.DeepCopy()
+	for _, field := range []string{"uid", "resourceVersion", "generation", "creationTimestamp", "selfLink", "managedFields", "ownerReferences"} {
+		unstructured.RemoveNestedField(clean.Object, "metadata", field)
+	}
+	unstructured.RemoveNestedField(clean.Object, "status")
+	return clean
+}
+
+// write writes the archive as a gzipped tarball to w
+func (a *backupArchive) write(w io.Writer) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	metadata, err := yaml.Marshal(a.Metadata)
+	if err != nil {
+		return err
+	}
+	issuer, err := marshalObjects([]*unstructured.Unstructured{a.IssuerSecret})
+	if err != nil {
+		return err
+	}
+	profiles, err := marshalObjects(a.ServiceProfiles)
+	if err != nil {
+		return err
+	}
+	links, err := marshalObjects(a.Links)
+	if err != nil {
+		return err
+	}
+	linkSecrets, err := marshalObjects(a.LinkSecrets)
+	if err != nil {
+		return err
+	}
+
+	files := []struct {
+		name string
+		data []byte
+	}{
+		{backupMetadataFile, metadata},
+		{backupConfigOverridesFile, a.ConfigOverrides},
+		{backupTrustAnchorsFile, []byte(a.TrustAnchors)},
+		{backupIssuerSecretFile, issuer},
+		{backupServiceProfilesFile, profiles},
+		{backupLinksFile, links},
+		{backupLinkSecretsFile, linkSecrets},
+	}
+	for _, file := range files {
+		header := &tar.Header{
+			Name:    file.name,
+			Mode:    0600,
+			Size:    int64(len(file.data)),
+			ModTime: time.Now(),
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if _, err := tw.Write(file.data); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// readBackup reads an archive written by backupArchive.write
+func readBackup(r io.Reader) (*backupArchive, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("invalid backup archive: %s", err)
+	}
+	defer gz.Close()
+
+	files := map[string][]byte{}
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid backup archive: %s", err)
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+		files[header.Name] = data
+	}
+
+	metadata, ok := files[backupMetadataFile]
+	if !ok {
+		return nil, fmt.Errorf("invalid backup archive: %s not found", backupMetadataFile)
+	}
+	archive := &backupArchive{}
+	if err := yaml.Unmarshal(metadata, &archive.Metadata); err != nil {
+		return nil, fmt.Errorf("invalid backup archive: %s", err)
+	}
+	if archive.Metadata.FormatVersion != backupFormatVersion {
+		return nil, fmt.Errorf("unsupported backup format version %d, expected %d", archive.Metadata.FormatVersion, backupFormatVersion)
+	}
+
+	archive.ConfigOverrides = files[backupConfigOverridesFile]
+	if len(archive.ConfigOverrides) == 0 {
+		return nil, fmt.Errorf("invalid backup archive: %s not found", backupConfigOverridesFile)
+	}
+	archive.TrustAnchors = string(files[backupTrustAnchorsFile])
+
+	issuer, err := readManifestObjects(bytes.NewReader(files[backupIssuerSecretFile]))
+	if err != nil {
+		return nil, err
+	}
+	if len(issuer) != 1 {
+		return nil, fmt.Errorf("invalid backup archive: %s must contain a single secret", backupIssuerSecretFile)
+	}
+	archive.IssuerSecret = issuer[0]
+
+	if archive.ServiceProfiles, err = readManifestObjects(bytes.NewReader(files[backupServiceProfilesFile])); err != nil {
+		return nil, err
+	}
+	if archive.Links, err = readManifestObjects(bytes.NewReader(files[backupLinksFile])); err != nil {
+		return nil, err
+	}
+	if archive.LinkSecrets, err = readManifestObjects(bytes.NewReader(files[backupLinkSecretsFile])); err != nil {
+		return nil, err
+	}
+	if len(archive.Links) != len(archive.LinkSecrets) {
+		return nil, errors.New("invalid backup archive: the number of Links and credentials don't match")
+	}
+
+	return archive, nil
+}
+
+// marshalObjects returns the objects as a multi-document YAML
+func marshalObjects(objs []*unstructured.Unstructured) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, obj := range objs {
+		objYAML, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString("---\n")
+		buf.Write(objYAML)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,339 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/linkerd/linkerd2/pkg/multicluster"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+const backupConfigOverrides = `identityTrustAnchorsPEM: trust-anchors
+identity:
+  issuer:
+    tls:
+      crtPEM: issuer-crt
+      keyPEM: issuer-key
+proxy:
+  logLevel: debug
+`
+
+func backupTestObject(apiVersion, kind, namespace, name string, fields map[string]interface{}) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	for k, v := range fields {
+		obj.Object[k] = v
+	}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	return obj
+}
+
+func newBackupTestAPI(t *testing.T, crds []string, objs ...runtime.Object) *k8s.KubernetesAPI {
+	t.Helper()
+
+	k, err := k8s.NewFakeAPI(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: linkerd-config
+  namespace: linkerd
+data:
+  values: |
+    linkerdVersion: stable-2.11.0
+    identityTrustAnchorsPEM: trust-anchors
+`, `
+apiVersion: v1
+kind: Secret
+metadata:
+  name: linkerd-config-overrides
+  namespace: linkerd
+  uid: 2e2b7c36-b0a4-4c4f-a2d2-3b5f8c5d6a01
+data:
+  linkerd-config-overrides: `+b64(backupConfigOverrides)+`
+`, `
+apiVersion: v1
+kind: Secret
+metadata:
+  name: linkerd-identity-issuer
+  namespace: linkerd
+  resourceVersion: "1234"
+data:
+  crt.pem: `+b64("issuer-crt")+`
+  key.pem: `+b64("issuer-key")+`
+`, `
+apiVersion: v1
+kind: Secret
+metadata:
+  name: cluster-credentials-east
+  namespace: linkerd-multicluster
+data:
+  kubeconfig: `+b64("kubeconfig")+`
+`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	for _, name := range crds {
+		crd := &apiextv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: apiextv1.CustomResourceDefinitionStatus{
+				Conditions: []apiextv1.CustomResourceDefinitionCondition{
+					{Type: apiextv1.Established, Status: apiextv1.ConditionTrue},
+				},
+			},
+		}
+		if _, err := k.Apiextensions.ApiextensionsV1().CustomResourceDefinitions().Create(context.Background(), crd, metav1.CreateOptions{}); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	}
+
+	k.DynamicClient = dynamicfake.NewSimpleDynamicClientWithCustomListKinds(
+		runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			serviceProfileGVR:    "ServiceProfileList",
+			multicluster.LinkGVR: "LinkList",
+		},
+		objs...,
+	)
+	return k
+}
+
+func b64(s string) string {
+	return base64.StdEncoding.EncodeToString([]byte(s))
+}
+
+func backupTestObjects() []runtime.Object {
+	profile := backupTestObject("linkerd.io/v1alpha2", "ServiceProfile", "emojivoto", "web-svc.emojivoto.svc.cluster.local", map[string]interface{}{
+		"spec": map[string]interface{}{
+			"routes": []interface{}{
+				map[string]interface{}{"name": "GET /", "condition": map[string]interface{}{"method": "GET", "pathRegex": "/"}},
+			},
+		},
+	})
+	profile.SetUID("9d7a1f5c-0c4b-4a43-8f0a-6a4c4f1b2c3d")
+	profile.SetResourceVersion("42")
+
+	link := backupTestObject(k8s.LinkAPIGroupVersion, k8s.LinkKind, "linkerd-multicluster", "east", map[string]interface{}{
+		"spec": map[string]interface{}{
+			"clusterCredentialsSecret":      "cluster-credentials-east",
+			"gatewayAddress":                "10.0.0.1",
+			"gatewayIdentity":               "linkerd-gateway.linkerd-multicluster.serviceaccount.identity.linkerd.cluster.local",
+			"gatewayPort":                   "4143",
+			"probeSpec":                     map[string]interface{}{"path": "/ready", "period": "3s", "port": "4191"},
+			"targetClusterDomain":           "cluster.local",
+			"targetClusterName":             "east",
+			"targetClusterLinkerdNamespace": "linkerd",
+			"selector":                      map[string]interface{}{},
+		},
+	})
+
+	return []runtime.Object{profile, link}
+}
+
+func TestBackupRoundTrip(t *testing.T) {
+	k := newBackupTestAPI(t, []string{linkCRDName}, backupTestObjects()...)
+
+	archive, err := createBackup(context.Background(), k)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	var buf bytes.Buffer
+	if err := archive.write(&buf); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	restored, err := readBackup(&buf)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if restored.Metadata.LinkerdVersion != "stable-2.11.0" || restored.Metadata.Namespace != "linkerd" {
+		t.Errorf("Unexpected metadata: %+v", restored.Metadata)
+	}
+	if string(restored.ConfigOverrides) != backupConfigOverrides {
+		t.Errorf("Expected the config overrides %q, got %q", backupConfigOverrides, restored.ConfigOverrides)
+	}
+	if restored.TrustAnchors != "trust-anchors" {
+		t.Errorf("Expected the trust anchors to be backed up, got %q", restored.TrustAnchors)
+	}
+	if restored.IssuerSecret.GetName() != "linkerd-identity-issuer" || restored.IssuerSecret.GetResourceVersion() != "" {
+		t.Errorf("Unexpected issuer secret: %v", restored.IssuerSecret)
+	}
+	if len(restored.ServiceProfiles) != 1 || restored.ServiceProfiles[0].GetUID() != "" || restored.ServiceProfiles[0].GetResourceVersion() != "" {
+		t.Errorf("Unexpected ServiceProfiles: %v", restored.ServiceProfiles)
+	}
+	if len(restored.Links) != 1 || len(restored.LinkSecrets) != 1 || restored.LinkSecrets[0].GetName() != "cluster-credentials-east" {
+		t.Errorf("Unexpected Links: %v %v", restored.Links, restored.LinkSecrets)
+	}
+}
+
+func TestBackupWithoutMulticluster(t *testing.T) {
+	k := newBackupTestAPI(t, nil, backupTestObjects()...)
+
+	archive, err := createBackup(context.Background(), k)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(archive.Links) != 0 {
+		t.Errorf("Expected no Links to be backed up without the Link CRD, got %v", archive.Links)
+	}
+}
+
+func TestReadBackupInvalid(t *testing.T) {
+	archive := &backupArchive{
+		Metadata:        backupMetadata{FormatVersion: backupFormatVersion + 1},
+		ConfigOverrides: []byte(backupConfigOverrides),
+		IssuerSecret:    backupTestObject("v1", "Secret", "linkerd", "linkerd-identity-issuer", nil),
+	}
+	var buf bytes.Buffer
+	if err := archive.write(&buf); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	_, err := readBackup(&buf)
+	if err == nil || !strings.Contains(err.Error(), "unsupported backup format version") {
+		t.Fatalf("Expected an unsupported version error, got %v", err)
+	}
+
+	if _, err := readBackup(strings.NewReader("not an archive")); err == nil {
+		t.Fatal("Expected an error")
+	}
+}
+
+func TestRestore(t *testing.T) {
+	objs := backupTestObjects()
+	archive := &backupArchive{
+		Metadata:        backupMetadata{FormatVersion: backupFormatVersion, Namespace: "linkerd"},
+		ConfigOverrides: []byte(backupConfigOverrides),
+		TrustAnchors:    "trust-anchors",
+		IssuerSecret:    backupTestObject("v1", "Secret", "linkerd", "linkerd-identity-issuer", nil),
+		ServiceProfiles: []*unstructured.Unstructured{objs[0].(*unstructured.Unstructured)},
+		Links:           []*unstructured.Unstructured{objs[1].(*unstructured.Unstructured)},
+		LinkSecrets:     []*unstructured.Unstructured{backupTestObject("v1", "Secret", "linkerd-multicluster", "cluster-credentials-east", nil)},
+	}
+
+	k, err := k8s.NewFakeAPI()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	k.Apiextensions = newBackupTestAPI(t, []string{serviceProfileCRDName}).Apiextensions
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+	k.DynamicClient = dynamicClient
+
+	createdObjects := func() []string {
+		var created []string
+		for _, action := range dynamicClient.Actions() {
+			if create, ok := action.(k8stesting.CreateAction); ok {
+				obj := create.GetObject().(*unstructured.Unstructured)
+				created = append(created, obj.GetKind()+"/"+obj.GetName())
+			}
+		}
+		dynamicClient.ClearActions()
+		return created
+	}
+
+	// The emojivoto namespace doesn't exist in the cluster yet, so its
+	// ServiceProfile can't be restored
+	var out bytes.Buffer
+	if err := restore(context.Background(), k, archive, time.Second, &out); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	created := createdObjects()
+	if len(created) == 0 || created[0] != "Namespace/linkerd" {
+		t.Fatalf("Expected the namespace to be created first, got %v", created)
+	}
+	for _, name := range created {
+		if name == "ServiceProfile/web-svc.emojivoto.svc.cluster.local" {
+			t.Errorf("Expected the ServiceProfile not to be restored without its namespace")
+		}
+		if name == "Link/east" {
+			t.Errorf("Expected the Links not to be restored without the Link CRD")
+		}
+	}
+	if !strings.Contains(out.String(), "1 ServiceProfiles not restored: please create the namespaces emojivoto") {
+		t.Errorf("Expected a warning about the ServiceProfiles, got:\n%s", out.String())
+	}
+	if !strings.Contains(out.String(), "1 Links not restored") {
+		t.Errorf("Expected a warning about the Links, got:\n%s", out.String())
+	}
+
+	// Once the namespace is created, running the command again restores the
+	// ServiceProfile
+	emojivoto := backupTestObject("v1", "Namespace", "", "emojivoto", nil)
+	if _, err := dynamicClient.Resource(namespaceGVR).Create(context.Background(), emojivoto, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	dynamicClient.ClearActions()
+	out.Reset()
+	if err := restore(context.Background(), k, archive, time.Second, &out); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	created = createdObjects()
+	if len(created) == 0 || created[len(created)-1] != "ServiceProfile/web-svc.emojivoto.svc.cluster.local" {
+		t.Errorf("Expected the ServiceProfile to be restored, got %v", created)
+	}
+	if !strings.Contains(out.String(), "1 ServiceProfiles restored") {
+		t.Errorf("Expected the ServiceProfile to be restored, got:\n%s", out.String())
+	}
+}
+
+func TestRestoreWaitsForControlPlane(t *testing.T) {
+	objs := backupTestObjects()
+	archive := &backupArchive{
+		Metadata:        backupMetadata{FormatVersion: backupFormatVersion, Namespace: "linkerd"},
+		ConfigOverrides: []byte(backupConfigOverrides),
+		ServiceProfiles: []*unstructured.Unstructured{objs[0].(*unstructured.Unstructured)},
+	}
+
+	k, err := k8s.NewFakeAPI(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: linkerd-config
+  namespace: linkerd
+`, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: linkerd-sp-validator
+  namespace: linkerd
+  labels:
+    linkerd.io/control-plane-ns: linkerd
+spec:
+  replicas: 1
+status:
+  replicas: 1
+  updatedReplicas: 1
+  availableReplicas: 0
+`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	k.Apiextensions = newBackupTestAPI(t, []string{serviceProfileCRDName}).Apiextensions
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+	k.DynamicClient = dynamicClient
+
+	var out bytes.Buffer
+	err = restore(context.Background(), k, archive, 100*time.Millisecond, &out)
+	if err == nil || !strings.Contains(err.Error(), "timed out waiting for linkerd/deployment/linkerd-sp-validator") {
+		t.Fatalf("Expected a timeout waiting for the sp-validator, got %v", err)
+	}
+	for _, action := range dynamicClient.Actions() {
+		if _, ok := action.(k8stesting.CreateAction); ok {
+			t.Fatalf("Expected no object to be created before the control plane is ready, got %v", action)
+		}
+	}
+}
@@ -23,18 +23,23 @@ import (
 type checkOptions struct {
 	versionOverride    string
 	preInstallOnly     bool
+	preUpgrade         bool
 	dataPlaneOnly      bool
 	wait               time.Duration
 	namespace          string
 	cniEnabled         bool
 	output             string
 	cliVersionOverride string
+	include            []string
+	exclude            []string
+	webhooks           bool
 }
 
 func newCheckOptions() *checkOptions {
 	return &checkOptions{
 		versionOverride:    "",
 		preInstallOnly:     false,
+		preUpgrade:         false,
 		dataPlaneOnly:      false,
 		wait:               300 * time.Second,
 		namespace:          "",
@@ -51,7 +56,9 @@ func (options *checkOptions) nonConfigFlagSet() *pflag.FlagSet {
 	flags.BoolVar(&options.cniEnabled, "linkerd-cni-enabled", options.cniEnabled, "When running pre-installation checks (--pre), assume the linkerd-cni plugin is already installed, and a NET_ADMIN check is not needed")
 	flags.StringVarP(&options.namespace, "namespace", "n", options.namespace, "Namespace to use for --proxy checks (default: all namespaces)")
 	flags.BoolVar(&options.preInstallOnly, "pre", options.preInstallOnly, "Only run pre-installation checks, to determine if the control plane can be installed")
+	flags.BoolVar(&options.preUpgrade, "upgrade", options.preUpgrade, "When running pre-installation checks (--pre), check instead that the installed control plane can be upgraded to the version of the CLI")
 	flags.BoolVar(&options.dataPlaneOnly, "proxy", options.dataPlaneOnly, "Only run data-plane checks, to determine if the data plane is healthy")
+	flags.BoolVar(&options.webhooks, "webhooks", options.webhooks, "Also send a synthetic admission review to each of the Linkerd webhooks, through a port-forward, and report their latencies")
 
 	return flags
 }
@@ -62,8 +69,9 @@ func (options *checkOptions) checkFlagSet() *pflag.FlagSet {
 
 	flags.StringVar(&options.versionOverride, "expected-version", options.versionOverride, "Overrides the version used when checking if Linkerd is running the latest version (mostly for testing)")
 	flags.StringVar(&options.cliVersionOverride, "cli-version-override", "", "Used to override the version of the cli (mostly for testing)")
-	flags.StringVarP(&options.output, "output", "o", options.output, "Output format. One of: basic, json, short")
+	flags.StringVarP(&options.output, "output", "o", options.output, "Output format. One of: basic, json, short, junit, sarif")
 	flags.DurationVar(&options.wait, "wait", options.wait, "Maximum allowed time for all tests to pass")
+	pkgcmd.AddCheckSelectionFlags(flags, &options.include, &options.exclude)
 
 	return flags
 }
@@ -75,10 +83,21 @@ func (options *checkOptions) validate() error {
 	if !options.preInstallOnly && options.cniEnabled {
 		return errors.New("--linkerd-cni-enabled can only be used with --pre")
 	}
-	if options.output != tableOutput && options.output != jsonOutput && options.output != shortOutput {
-		return fmt.Errorf("Invalid output type '%s'. Supported output types are: %s, %s, %s", options.output, jsonOutput, tableOutput, shortOutput)
+	if !options.preInstallOnly && options.preUpgrade {
+		return errors.New("--upgrade can only be used with --pre")
 	}
-	return nil
+	if options.preInstallOnly && options.webhooks {
+		return errors.New("--pre and --webhooks flags are mutually exclusive")
+	}
+	if options.preUpgrade && options.cniEnabled {
+		return errors.New("--upgrade and --linkerd-cni-enabled flags are mutually exclusive")
+	}
+	switch options.output {
+	case tableOutput, jsonOutput, shortOutput, junitOutput, sarifOutput:
+	default:
+		return fmt.Errorf("Invalid output type '%s'. Supported output types are: %s, %s, %s, %s, %s", options.output, jsonOutput, tableOutput, shortOutput, junitOutput, sarifOutput)
+	}
+	return healthcheck.ValidatePatterns(append(options.include, options.exclude...))
 }
 
 // newCmdCheckConfig is a subcommand for `linkerd check config`
@@ -125,6 +144,9 @@ non-zero exit code.`,
   # Check that the Linkerd control plane can be installed in the "test" namespace
   linkerd check --pre --linkerd-namespace test
 
+  # Check that the installed Linkerd control plane can be upgraded to the CLI version
+  linkerd check --pre --upgrade
+
   # Check that "linkerd install config" succeeded
   linkerd check config
 
@@ -162,8 +184,16 @@ func configureAndRunChecks(cmd *cobra.Command, wout io.Writer, werr io.Writer, s
 		healthcheck.LinkerdVersionChecks,
 	}
 
-	var installManifest string
-	if options.preInstallOnly {
+	var installManifest, upgradeManifest string
+	if options.preUpgrade {
+		checks = append(checks, healthcheck.LinkerdConfigChecks)
+		checks = append(checks, healthcheck.LinkerdPreUpgradeChecks)
+		upgradeManifest, err = renderUpgradeManifest(cmd.Context())
+		if err != nil {
+			fmt.Fprint(os.Stderr, fmt.Errorf("Error rendering upgrade manifest: %v", err))
+			os.Exit(1)
+		}
+	} else if options.preInstallOnly {
 		checks = append(checks, healthcheck.LinkerdPreInstallChecks)
 		if options.cniEnabled {
 			checks = append(checks, healthcheck.LinkerdCNIPluginChecks)
@@ -182,6 +212,9 @@ func configureAndRunChecks(cmd *cobra.Command, wout io.Writer, werr io.Writer, s
 			checks = append(checks, healthcheck.LinkerdControlPlaneExistenceChecks)
 			checks = append(checks, healthcheck.LinkerdIdentity)
 			checks = append(checks, healthcheck.LinkerdWebhooksAndAPISvcTLS)
+			if options.webhooks {
+				checks = append(checks, healthcheck.LinkerdWebhooksChecks)
+			}
 			checks = append(checks, healthcheck.LinkerdControlPlaneProxyChecks)
 
 			if options.dataPlaneOnly {
@@ -209,8 +242,30 @@ func configureAndRunChecks(cmd *cobra.Command, wout io.Writer, werr io.Writer, s
 		RetryDeadline:         time.Now().Add(options.wait),
 		CNIEnabled:            options.cniEnabled,
 		InstallManifest:       installManifest,
+		UpgradeManifest:       upgradeManifest,
+		Include:               options.include,
+		Exclude:               options.exclude,
 	})
 
+	// Reports cover both the core and the extensions checks in a single
+	// document
+	if options.output == junitOutput || options.output == sarifOutput {
+		extensions, err := getExtensions(cmd.Context())
+		if err != nil {
+			err = fmt.Errorf("failed to run extensions checks: %s", err)
+			fmt.Fprintln(werr, err)
+			os.Exit(1)
+		}
+		runner := healthcheck.Runners{hc, healthcheck.ExtensionsRunner{
+			Extensions: extensions,
+			Flags:      getExtensionCheckFlags(cmd.Flags()),
+		}}
+		if !healthcheck.RunChecks(wout, werr, runner, options.output) {
+			os.Exit(1)
+		}
+		return nil
+	}
+
 	if options.output != jsonOutput {
 		healthcheck.PrintCoreChecksHeader(wout)
 	}
@@ -232,29 +287,38 @@ func configureAndRunChecks(cmd *cobra.Command, wout io.Writer, werr io.Writer, s
 }
 
 func runExtensionChecks(cmd *cobra.Command, wout io.Writer, werr io.Writer, opts *checkOptions) (bool, error) {
-	kubeAPI, err := k8s.NewAPI(kubeconfigPath, kubeContext, impersonate, impersonateGroup, 0)
-	if err != nil {
-		return false, err
-	}
-
-	namespaces, err := kubeAPI.GetAllNamespacesWithExtensionLabel(cmd.Context())
+	extensions, err := getExtensions(cmd.Context())
 	if err != nil {
 		return false, err
 	}
 
 	success := true
 	// no extensions to check
-	if len(namespaces) == 0 {
+	if len(extensions) == 0 {
 		return success, nil
 	}
 
-	nsLabels := make([]string, len(namespaces))
-	for i, ns := range namespaces {
-		nsLabels[i] = ns.Labels[k8s.LinkerdExtensionLabel]
+	extensionSuccess := healthcheck.RunExtensionsChecks(wout, werr, extensions, getExtensionCheckFlags(cmd.Flags()), opts.output)
+	return extensionSuccess, nil
+}
+
+// getExtensions returns the names of the extensions installed in the cluster
+func getExtensions(ctx context.Context) ([]string, error) {
+	kubeAPI, err := k8s.NewAPI(kubeconfigPath, kubeContext, impersonate, impersonateGroup, 0)
+	if err != nil {
+		return nil, err
 	}
 
-	extensionSuccess := healthcheck.RunExtensionsChecks(wout, werr, nsLabels, getExtensionCheckFlags(cmd.Flags()), opts.output)
-	return extensionSuccess, nil
+	namespaces, err := kubeAPI.GetAllNamespacesWithExtensionLabel(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	extensions := make([]string, len(namespaces))
+	for i, ns := range namespaces {
+		extensions[i] = ns.Labels[k8s.LinkerdExtensionLabel]
+	}
+	return extensions, nil
 }
 
 func getExtensionCheckFlags(lf *pflag.FlagSet) []string {
@@ -272,6 +336,16 @@ func getExtensionCheckFlags(lf *pflag.FlagSet) []string {
 			}
 		}
 	}
+	// slice flags are only forwarded when set, as their string value is
+	// never empty
+	for _, flag := range []string{"include", "exclude"} {
+		f := lf.Lookup(flag)
+		if f != nil && f.Changed {
+			if sv, ok := f.Value.(pflag.SliceValue); ok {
+				cmdLineFlags = append(cmdLineFlags, fmt.Sprintf("--%s=%s", f.Name, strings.Join(sv.GetSlice(), ",")))
+			}
+		}
+	}
 	cmdLineFlags = append(cmdLineFlags, "--output=json")
 	return cmdLineFlags
 }
@@ -289,3 +363,24 @@ func renderInstallManifest(ctx context.Context) (string, error) {
 	}
 	return b.String(), nil
 }
+
+// renderUpgradeManifest renders the manifest `linkerd upgrade` would output,
+// without applying any flags on top of the stored values
+func renderUpgradeManifest(ctx context.Context) (string, error) {
+	k, err := k8s.NewAPI(kubeconfigPath, kubeContext, impersonate, impersonateGroup, 0)
+	if err != nil {
+		return "", err
+	}
+
+	values, err := loadUpgradeValues(ctx, k)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	err = render(&b, values, "", valuespkg.Options{})
+	if err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
@@ -3,9 +3,11 @@ package cmd
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"testing"
+	"time"
 
 	"github.com/linkerd/linkerd2/pkg/healthcheck"
 )
@@ -78,4 +80,24 @@ func TestCheckStatus(t *testing.T) {
 			t.Fatalf("Expected function to render:\n%s\bbut got:\n%s", expectedContent, output)
 		}
 	})
+	reportResults := healthcheck.CheckResults{
+		Results: []healthcheck.CheckResult{
+			{Category: "category", Description: "check1", Duration: 20 * time.Millisecond},
+			{Category: "category", Description: "check2", Retry: true, Err: errors.New("waiting for check to complete")},
+			{Category: "category", Description: "check2", HintURL: "https://linkerd.io/checks/#hint-anchor", Duration: 1500 * time.Millisecond, Err: errors.New("This should contain instructions for fail")},
+			{Category: "extension", Description: "check3", HintURL: "https://linkerd.io/checks/#warning-anchor", Warning: true, Err: errors.New("This should contain instructions for warning")},
+		},
+	}
+
+	t.Run("Prints expected output in junit", func(t *testing.T) {
+		output := bytes.NewBufferString("")
+		healthcheck.RunChecks(output, stderr, reportResults, junitOutput)
+		testDataDiffer.DiffTestdata(t, "check_output_junit.golden", output.String())
+	})
+
+	t.Run("Prints expected output in sarif", func(t *testing.T) {
+		output := bytes.NewBufferString("")
+		healthcheck.RunChecks(output, stderr, reportResults, sarifOutput)
+		testDataDiffer.DiffTestdata(t, "check_output_sarif.golden", output.String())
+	})
 }
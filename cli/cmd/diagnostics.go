@@ -27,12 +27,16 @@ This command provides subcommands to diagnose the functionality of Linkerd.`,
  
   # Get the endpoints for authorities in Linkerd's control-plane itself
   linkerd diagnostics endpoints web.linkerd-viz.svc.cluster.local:8084
+
+  # Get proxy resources recommendations for the workloads in the emojivoto namespace
+  linkerd diagnostics proxy-resources -n emojivoto
   `,
 	}
 
 	diagnosticsCmd.AddCommand(newCmdControllerMetrics())
 	diagnosticsCmd.AddCommand(newCmdEndpoints())
 	diagnosticsCmd.AddCommand(newCmdMetrics())
+	diagnosticsCmd.AddCommand(newCmdProxyResources())
 
 	return diagnosticsCmd
 }
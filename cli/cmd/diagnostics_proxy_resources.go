@@ -0,0 +1,363 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/linkerd/linkerd2/controller/heartbeat"
+	pkgcmd "github.com/linkerd/linkerd2/pkg/cmd"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	promApi "github.com/prometheus/client_golang/api"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	patchOutput = "patch"
+
+	vizExtensionName        = "viz"
+	vizPrometheusDeployment = "prometheus"
+	vizPrometheusPort       = 9090
+)
+
+type (
+	proxyResourcesOptions struct {
+		namespace     string
+		allNamespaces bool
+		window        time.Duration
+		cpuQuantile   float64
+		headroom      float64
+		prometheusURL string
+		output        string
+	}
+
+	// proxyUsage is the resource usage of the proxy of a pod over the window
+	proxyUsage struct {
+		cpu    float64 // cores
+		memory float64 // bytes
+	}
+
+	// proxyResourcesRecommendation holds the proxy resources recommended for
+	// a workload, based on the usage of the proxies of its pods
+	proxyResourcesRecommendation struct {
+		Namespace          string `json:"namespace"`
+		Kind               string `json:"kind"`
+		Name               string `json:"name"`
+		Pods               int    `json:"pods"`
+		CPUUsage           string `json:"cpuUsage"`
+		MemoryUsage        string `json:"memoryUsage"`
+		CurrentCPURequest  string `json:"currentCpuRequest"`
+		CurrentMemoryLimit string `json:"currentMemoryLimit"`
+		CPURequest         string `json:"cpuRequest"`
+		MemoryLimit        string `json:"memoryLimit"`
+	}
+)
+
+func newProxyResourcesOptions() *proxyResourcesOptions {
+	return &proxyResourcesOptions{
+		window:      24 * time.Hour,
+		cpuQuantile: 0.95,
+		headroom:    0.2,
+		output:      tableOutput,
+	}
+}
+
+func (o *proxyResourcesOptions) validate() error {
+	if o.output != tableOutput && o.output != jsonOutput && o.output != patchOutput {
+		return fmt.Errorf("--output supports %s, %s and %s", tableOutput, jsonOutput, patchOutput)
+	}
+	if o.cpuQuantile <= 0 || o.cpuQuantile > 1 {
+		return errors.New("--cpu-quantile must be in (0, 1]")
+	}
+	if o.headroom < 0 {
+		return errors.New("--headroom can't be negative")
+	}
+	if o.window < 5*time.Minute {
+		return errors.New("--window must be at least 5m")
+	}
+	return nil
+}
+
+func newCmdProxyResources() *cobra.Command {
+	options := newProxyResourcesOptions()
+
+	cmd := &cobra.Command{
+		Use:   "proxy-resources [flags]",
+		Args:  cobra.NoArgs,
+		Short: "Recommend proxy resources for the meshed workloads based on their usage",
+		Long: `Recommend proxy resources for the meshed workloads based on their usage.
+
+This command queries Prometheus for the CPU and memory used by the proxies of
+each meshed workload over a window, and recommends values for the
+config.linkerd.io/proxy-cpu-request and config.linkerd.io/proxy-memory-limit
+annotations: the CPU request covers the given quantile of the CPU usage, and the
+memory limit covers the maximum memory usage, both with some headroom.
+
+By default, the Prometheus instance of the viz extension is used. The
+recommendations can be output as patches, adding the annotations to the pod
+template of the workloads.`,
+		Example: `  # Recommend proxy resources for the workloads of the emojivoto namespace
+  linkerd diagnostics proxy-resources -n emojivoto
+
+  # Base the recommendations on the last week, with 50% of headroom
+  linkerd diagnostics proxy-resources -A --window 168h --headroom 0.5
+
+  # Apply the recommendations
+  linkerd diagnostics proxy-resources -n emojivoto -o patch | sh`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.validate(); err != nil {
+				return err
+			}
+			if options.allNamespaces {
+				options.namespace = corev1.NamespaceAll
+			} else if options.namespace == "" {
+				options.namespace = pkgcmd.GetDefaultNamespace(kubeconfigPath, kubeContext)
+			}
+
+			k8sAPI, err := k8s.NewAPI(kubeconfigPath, kubeContext, impersonate, impersonateGroup, 0)
+			if err != nil {
+				return err
+			}
+
+			promURL := options.prometheusURL
+			if promURL == "" {
+				vizNs, err := k8sAPI.GetNamespaceWithExtensionLabel(cmd.Context(), vizExtensionName)
+				if err != nil {
+					return fmt.Errorf("%s; please install the viz extension or use --prometheus-url", err)
+				}
+				portForward, err := k8s.NewPortForward(cmd.Context(), k8sAPI, vizNs.Name, vizPrometheusDeployment, "localhost", 0, vizPrometheusPort, verbose)
+				if err != nil {
+					return err
+				}
+				defer portForward.Stop()
+				if err := portForward.Init(); err != nil {
+					return fmt.Errorf("failed to port-forward to Prometheus: %s", err)
+				}
+				promURL = portForward.URLFor("")
+			}
+			promClient, err := promApi.NewClient(promApi.Config{Address: promURL})
+			if err != nil {
+				return err
+			}
+
+			recommendations, err := recommendProxyResources(cmd.Context(), k8sAPI, promv1.NewAPI(promClient), options)
+			if err != nil {
+				return err
+			}
+			return writeProxyResources(os.Stdout, recommendations, options.output)
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.namespace, "namespace", "n", options.namespace, "Namespace of the workloads")
+	cmd.Flags().BoolVarP(&options.allNamespaces, "all-namespaces", "A", options.allNamespaces, "Recommend proxy resources for the workloads of all namespaces")
+	cmd.Flags().DurationVar(&options.window, "window", options.window, "Time window of the usage the recommendations are based on")
+	cmd.Flags().Float64Var(&options.cpuQuantile, "cpu-quantile", options.cpuQuantile, "Quantile of the CPU usage covered by the recommended CPU request")
+	cmd.Flags().Float64Var(&options.headroom, "headroom", options.headroom, "Ratio added to the usage in the recommendations, e.g. 0.2 recommends 120% of the usage")
+	cmd.Flags().StringVar(&options.prometheusURL, "prometheus-url", options.prometheusURL, "URL of the Prometheus instance to query, instead of the one of the viz extension")
+	cmd.Flags().StringVarP(&options.output, "output", "o", options.output, fmt.Sprintf("Output format; one of: %s, %s or %s", tableOutput, jsonOutput, patchOutput))
+
+	return cmd
+}
+
+// recommendProxyResources returns the recommended proxy resources for each
+// workload with meshed pods in the namespace
+func recommendProxyResources(ctx context.Context, k8sAPI *k8s.KubernetesAPI, promAPI promv1.API, options *proxyResourcesOptions) ([]proxyResourcesRecommendation, error) {
+	usages, err := queryProxyUsage(ctx, promAPI, options)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := k8sAPI.CoreV1().Pods(options.namespace).List(ctx, metav1.ListOptions{LabelSelector: k8s.ControllerNSLabel})
+	if err != nil {
+		return nil, err
+	}
+
+	byWorkload := map[string]*proxyResourcesRecommendation{}
+	maxUsages := map[string]*proxyUsage{}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		usage, ok := usages[pod.Namespace+"/"+pod.Name]
+		if !ok {
+			continue
+		}
+		kind, name := k8s.Pod, pod.Name
+		workload, err := k8sAPI.GetPodWorkload(ctx, *pod)
+		if err != nil {
+			return nil, err
+		}
+		if workload != nil {
+			kind, name = workload.Kind, workload.Name
+		}
+
+		key := fmt.Sprintf("%s/%s/%s", pod.Namespace, kind, name)
+		rec, ok := byWorkload[key]
+		if !ok {
+			rec = &proxyResourcesRecommendation{
+				Namespace:          pod.Namespace,
+				Kind:               kind,
+				Name:               name,
+				CurrentCPURequest:  "-",
+				CurrentMemoryLimit: "-",
+			}
+			for _, container := range pod.Spec.Containers {
+				if container.Name == k8s.ProxyContainerName {
+					rec.CurrentCPURequest = quantityString(container.Resources.Requests, corev1.ResourceCPU)
+					rec.CurrentMemoryLimit = quantityString(container.Resources.Limits, corev1.ResourceMemory)
+				}
+			}
+			byWorkload[key] = rec
+			maxUsages[key] = &proxyUsage{}
+		}
+		rec.Pods++
+		maxUsage := maxUsages[key]
+		maxUsage.cpu = math.Max(maxUsage.cpu, usage.cpu)
+		maxUsage.memory = math.Max(maxUsage.memory, usage.memory)
+	}
+
+	recommendations := make([]proxyResourcesRecommendation, 0, len(byWorkload))
+	for key, rec := range byWorkload {
+		usage := maxUsages[key]
+		rec.CPUUsage = cpuQuantity(usage.cpu).String()
+		rec.MemoryUsage = memoryQuantity(usage.memory).String()
+		rec.CPURequest = cpuQuantity(usage.cpu * (1 + options.headroom)).String()
+		rec.MemoryLimit = memoryQuantity(usage.memory * (1 + options.headroom)).String()
+		recommendations = append(recommendations, *rec)
+	}
+	sort.Slice(recommendations, func(i, j int) bool {
+		a, b := recommendations[i], recommendations[j]
+		return a.Namespace+"/"+a.Kind+"/"+a.Name < b.Namespace+"/"+b.Kind+"/"+b.Name
+	})
+	return recommendations, nil
+}
+
+// queryProxyUsage returns the usage of the proxies over the window, indexed
+// by namespace/pod
+func queryProxyUsage(ctx context.Context, promAPI promv1.API, options *proxyResourcesOptions) (map[string]*proxyUsage, error) {
+	window := model.Duration(options.window).String()
+	selectors := heartbeat.CadvisorSelectors(k8s.ProxyContainerName, options.namespace)
+	cpuQuery := heartbeat.CPUQuantileQuery("max by (namespace, pod) ", options.cpuQuantile, window, selectors)
+	memoryQuery := heartbeat.MemoryQuery("max by (namespace, pod) ", window, selectors)
+
+	usages := map[string]*proxyUsage{}
+	for _, q := range []struct {
+		query string
+		set   func(*proxyUsage, float64)
+	}{
+		{cpuQuery, func(u *proxyUsage, v float64) { u.cpu = v }},
+		{memoryQuery, func(u *proxyUsage, v float64) { u.memory = v }},
+	} {
+		res, _, err := promAPI.Query(ctx, q.query, time.Time{})
+		if err != nil {
+			return nil, fmt.Errorf("Prometheus query failed: %s", err)
+		}
+		vector, ok := res.(model.Vector)
+		if !ok {
+			return nil, fmt.Errorf("unexpected query result type (expected Vector): %s", res.Type())
+		}
+		for _, sample := range vector {
+			value := float64(sample.Value)
+			if math.IsNaN(value) {
+				continue
+			}
+			key := fmt.Sprintf("%s/%s", sample.Metric["namespace"], sample.Metric["pod"])
+			if _, ok := usages[key]; !ok {
+				usages[key] = &proxyUsage{}
+			}
+			q.set(usages[key], value)
+		}
+	}
+	return usages, nil
+}
+
+// cpuQuantity rounds the cores up to the next millicore
+func cpuQuantity(cores float64) *resource.Quantity {
+	return resource.NewMilliQuantity(int64(math.Max(1, math.Ceil(cores*1000))), resource.DecimalSI)
+}
+
+// memoryQuantity rounds the bytes up to the next mebibyte
+func memoryQuantity(b float64) *resource.Quantity {
+	mib := int64(math.Max(1, math.Ceil(b/(1<<20))))
+	return resource.NewQuantity(mib<<20, resource.BinarySI)
+}
+
+func quantityString(resources corev1.ResourceList, name corev1.ResourceName) string {
+	if q, ok := resources[name]; ok {
+		return q.String()
+	}
+	return "-"
+}
+
+func writeProxyResources(w io.Writer, recommendations []proxyResourcesRecommendation, output string) error {
+	switch output {
+	case jsonOutput:
+		b, err := json.MarshalIndent(recommendations, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(w, string(b))
+	case patchOutput:
+		for _, rec := range recommendations {
+			patch, err := proxyResourcesPatch(rec)
+			if err != nil {
+				fmt.Fprintf(w, "# %s/%s/%s: %s\n", rec.Namespace, rec.Kind, rec.Name, err)
+				continue
+			}
+			fmt.Fprintf(w, "kubectl -n %s patch %s %s --type merge -p '%s'\n", rec.Namespace, rec.Kind, rec.Name, patch)
+		}
+	default:
+		if len(recommendations) == 0 {
+			fmt.Fprintln(w, "No meshed workloads with proxy usage found")
+			return nil
+		}
+		var buf bytes.Buffer
+		tw := tabwriter.NewWriter(&buf, 0, 0, padding, ' ', 0)
+		fmt.Fprintln(tw, strings.Join([]string{"NAMESPACE", "WORKLOAD", "PODS", "CPU USAGE", "CPU REQUEST", "RECOMMENDED", "MEMORY USAGE", "MEMORY LIMIT", "RECOMMENDED"}, "\t"))
+		for _, rec := range recommendations {
+			fmt.Fprintf(tw, "%s\t%s/%s\t%d\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				rec.Namespace, rec.Kind, rec.Name, rec.Pods,
+				rec.CPUUsage, rec.CurrentCPURequest, rec.CPURequest,
+				rec.MemoryUsage, rec.CurrentMemoryLimit, rec.MemoryLimit)
+		}
+		tw.Flush()
+		fmt.Fprint(w, buf.String())
+	}
+	return nil
+}
+
+// proxyResourcesPatch returns the merge patch setting the recommended
+// resources as annotations of the pod template of the workload
+func proxyResourcesPatch(rec proxyResourcesRecommendation) ([]byte, error) {
+	switch rec.Kind {
+	case k8s.Deployment, k8s.StatefulSet, k8s.DaemonSet:
+	default:
+		return nil, fmt.Errorf("%s has no pod template, the annotations must be set manually", rec.Kind)
+	}
+
+	patch := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"annotations": map[string]string{
+						k8s.ProxyCPURequestAnnotation:  rec.CPURequest,
+						k8s.ProxyMemoryLimitAnnotation: rec.MemoryLimit,
+					},
+				},
+			},
+		},
+	}
+	return json.Marshal(patch)
+}
@@ -0,0 +1,196 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/linkerd/linkerd2/pkg/prometheus"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+)
+
+// usageProm returns the CPU or memory usage depending on the query
+type usageProm struct {
+	prometheus.MockProm
+	cpu    model.Vector
+	memory model.Vector
+}
+
+func (m *usageProm) Query(ctx context.Context, query string, ts time.Time) (model.Value, promv1.Warnings, error) {
+	m.QueriesExecuted = append(m.QueriesExecuted, query)
+	if strings.Contains(query, "container_cpu_usage_seconds_total") {
+		return m.cpu, nil, nil
+	}
+	return m.memory, nil, nil
+}
+
+func usageSample(pod string, value float64) *model.Sample {
+	return &model.Sample{
+		Metric: model.Metric{"namespace": "emojivoto", "pod": model.LabelValue(pod)},
+		Value:  model.SampleValue(value),
+	}
+}
+
+func TestRecommendProxyResources(t *testing.T) {
+	k8sAPI, err := k8s.NewFakeAPI(`
+apiVersion: apps/v1
+kind: ReplicaSet
+metadata:
+  name: web-5f86686c4d
+  namespace: emojivoto
+  ownerReferences:
+  - apiVersion: apps/v1
+    kind: Deployment
+    name: web
+    uid: "1"
+    controller: true
+`, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: web-5f86686c4d-abcde
+  namespace: emojivoto
+  labels:
+    linkerd.io/control-plane-ns: linkerd
+  ownerReferences:
+  - apiVersion: apps/v1
+    kind: ReplicaSet
+    name: web-5f86686c4d
+    uid: "2"
+    controller: true
+spec:
+  containers:
+  - name: linkerd-proxy
+    resources:
+      requests:
+        cpu: 100m
+      limits:
+        memory: 250Mi
+`, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: web-5f86686c4d-fghij
+  namespace: emojivoto
+  labels:
+    linkerd.io/control-plane-ns: linkerd
+  ownerReferences:
+  - apiVersion: apps/v1
+    kind: ReplicaSet
+    name: web-5f86686c4d
+    uid: "2"
+    controller: true
+`, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: debug
+  namespace: emojivoto
+  labels:
+    linkerd.io/control-plane-ns: linkerd
+`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	promAPI := &usageProm{
+		cpu: model.Vector{
+			usageSample("web-5f86686c4d-abcde", 0.0102),
+			usageSample("web-5f86686c4d-fghij", 0.02),
+			usageSample("debug", 0.0001),
+		},
+		memory: model.Vector{
+			usageSample("web-5f86686c4d-abcde", 20*(1<<20)),
+			usageSample("web-5f86686c4d-fghij", 10*(1<<20)),
+			usageSample("debug", 5*(1<<20)),
+		},
+	}
+
+	options := newProxyResourcesOptions()
+	options.namespace = "emojivoto"
+	recommendations, err := recommendProxyResources(context.Background(), k8sAPI, promAPI, options)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := []proxyResourcesRecommendation{
+		{
+			Namespace:          "emojivoto",
+			Kind:               "deployment",
+			Name:               "web",
+			Pods:               2,
+			CPUUsage:           "20m",
+			MemoryUsage:        "20Mi",
+			CurrentCPURequest:  "100m",
+			CurrentMemoryLimit: "250Mi",
+			CPURequest:         "24m",
+			MemoryLimit:        "24Mi",
+		},
+		{
+			Namespace:          "emojivoto",
+			Kind:               "pod",
+			Name:               "debug",
+			Pods:               1,
+			CPUUsage:           "1m",
+			MemoryUsage:        "5Mi",
+			CurrentCPURequest:  "-",
+			CurrentMemoryLimit: "-",
+			CPURequest:         "1m",
+			MemoryLimit:        "6Mi",
+		},
+	}
+	if !reflect.DeepEqual(recommendations, expected) {
+		t.Fatalf("Expected recommendations\n%+v\ngot\n%+v", expected, recommendations)
+	}
+
+	for _, query := range promAPI.QueriesExecuted {
+		if !strings.Contains(query, `namespace="emojivoto"`) || !strings.Contains(query, "[1d") {
+			t.Errorf("Unexpected query: %s", query)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := writeProxyResources(&buf, recommendations, patchOutput); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	expectedPatch := `kubectl -n emojivoto patch deployment web --type merge -p '{"spec":{"template":{"metadata":{"annotations":{"config.linkerd.io/proxy-cpu-request":"24m","config.linkerd.io/proxy-memory-limit":"24Mi"}}}}}'
+# emojivoto/pod/debug: pod has no pod template, the annotations must be set manually
+`
+	if buf.String() != expectedPatch {
+		t.Fatalf("Expected patches\n%s\ngot\n%s", expectedPatch, buf.String())
+	}
+}
+
+func TestProxyResourcesPatch(t *testing.T) {
+	recommendations := []proxyResourcesRecommendation{
+		{Namespace: "emojivoto", Kind: "statefulset", Name: "db", CPURequest: "10m", MemoryLimit: "20Mi"},
+		{Namespace: "emojivoto", Kind: "daemonset", Name: "agent", CPURequest: "10m", MemoryLimit: "20Mi"},
+	}
+
+	var buf bytes.Buffer
+	if err := writeProxyResources(&buf, recommendations, patchOutput); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	expectedPatch := `kubectl -n emojivoto patch statefulset db --type merge -p '{"spec":{"template":{"metadata":{"annotations":{"config.linkerd.io/proxy-cpu-request":"10m","config.linkerd.io/proxy-memory-limit":"20Mi"}}}}}'
+kubectl -n emojivoto patch daemonset agent --type merge -p '{"spec":{"template":{"metadata":{"annotations":{"config.linkerd.io/proxy-cpu-request":"10m","config.linkerd.io/proxy-memory-limit":"20Mi"}}}}}'
+`
+	if buf.String() != expectedPatch {
+		t.Fatalf("Expected patches\n%s\ngot\n%s", expectedPatch, buf.String())
+	}
+}
+
+func TestWriteProxyResourcesEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	if err := writeProxyResources(&buf, nil, tableOutput); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	expected := "No meshed workloads with proxy usage found\n"
+	if buf.String() != expected {
+		t.Fatalf("Expected output %q, got %q", expected, buf.String())
+	}
+}
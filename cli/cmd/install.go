@@ -64,6 +64,7 @@ var (
 		"templates/identity-rbac.yaml",
 		"templates/destination-rbac.yaml",
 		"templates/heartbeat-rbac.yaml",
+		"templates/health-checker-rbac.yaml",
 		"templates/serviceprofile-crd.yaml",
 		"templates/trafficsplit-crd.yaml",
 		"templates/proxy-injector-rbac.yaml",
@@ -75,6 +76,7 @@ var (
 		"templates/identity.yaml",
 		"templates/destination.yaml",
 		"templates/heartbeat.yaml",
+		"templates/health-checker.yaml",
 		"templates/proxy-injector.yaml",
 	}
 
@@ -366,6 +368,9 @@ func render(w io.Writer, values *l5dcharts.Values, stage string, options valuesp
 	if err != nil {
 		return err
 	}
+	if err := l5dcharts.ValidateValues(valuesOverrides); err != nil {
+		return err
+	}
 
 	vals, err := chartutil.CoalesceValues(chart, valuesOverrides)
 	if err != nil {
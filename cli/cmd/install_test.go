@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/linkerd/linkerd2/cli/flag"
@@ -119,6 +120,7 @@ func TestRender(t *testing.T) {
 		ControllerReplicas: 1,
 		ProxyInjector:      defaultValues.ProxyInjector,
 		ProfileValidator:   defaultValues.ProfileValidator,
+		HealthChecker:      defaultValues.HealthChecker,
 	}
 
 	haValues, err := testInstallOptionsHA(true)
@@ -161,6 +163,14 @@ func TestRender(t *testing.T) {
 	withHeartBeatDisabledValues.DisableHeartBeat = true
 	addFakeTLSSecrets(withHeartBeatDisabledValues)
 
+	withHealthCheckerValues, err := testInstallOptions()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v\n", err)
+	}
+	withHealthCheckerValues.HealthChecker.Enabled = true
+	withHealthCheckerValues.HealthChecker.DataPlaneNamespace = "emojivoto"
+	addFakeTLSSecrets(withHealthCheckerValues)
+
 	withControlPlaneTracingValues, err := testInstallOptions()
 	if err != nil {
 		t.Fatalf("Unexpected error: %v\n", err)
@@ -203,11 +213,13 @@ func TestRender(t *testing.T) {
 		{cniEnabledValues, "install_no_init_container.golden", values.Options{}},
 		{withProxyIgnoresValues, "install_proxy_ignores.golden", values.Options{}},
 		{withHeartBeatDisabledValues, "install_heartbeat_disabled_output.golden", values.Options{}},
+		{withHealthCheckerValues, "install_health_checker_output.golden", values.Options{}},
 		{withControlPlaneTracingValues, "install_controlplane_tracing_output.golden", values.Options{}},
 		{withCustomRegistryValues, "install_custom_registry.golden", values.Options{}},
 		{withCustomDestinationGetNetsValues, "install_default_override_dst_get_nets.golden", values.Options{}},
 		{defaultValues, "install_custom_domain.golden", values.Options{Values: []string{"namespace=l5d"}}},
 		{defaultValues, "install_values_file.golden", values.Options{ValueFiles: []string{filepath.Join("testdata", "install_config.yaml")}}},
+		{defaultValues, "install_injection_policy.golden", values.Options{Values: []string{"proxyInjector.enforceInjectionPolicy=true"}}},
 	}
 
 	for i, tc := range testCases {
@@ -222,6 +234,34 @@ func TestRender(t *testing.T) {
 	}
 }
 
+func TestRenderInvalidValues(t *testing.T) {
+	defaultValues, err := testInstallOptions()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v\n", err)
+	}
+
+	options := values.Options{
+		Values:     []string{"proxy.logFormat=xml"},
+		ValueFiles: []string{filepath.Join("testdata", "install_config_invalid.yaml")},
+	}
+	expected := []string{
+		"(root): Additional property controllerReplica is not allowed",
+		"proxy.logFormat: proxy.logFormat must be one of the following",
+		"proxy.resources.cpu: Invalid type. Expected: [object,null], given: string",
+	}
+
+	var buf bytes.Buffer
+	err = render(&buf, defaultValues, "", options)
+	if err == nil {
+		t.Fatal("Expected error, got nothing")
+	}
+	for _, e := range expected {
+		if !strings.Contains(err.Error(), e) {
+			t.Errorf("Expected error to contain %q, got %q", e, err)
+		}
+	}
+}
+
 func TestValidateAndBuild_Errors(t *testing.T) {
 	t.Run("Fails validation for invalid ignoreInboundPorts", func(t *testing.T) {
 		values, err := testInstallOptions()
@@ -0,0 +1,376 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	l5dcharts "github.com/linkerd/linkerd2/pkg/charts/linkerd2"
+	"github.com/linkerd/linkerd2/pkg/config"
+	"github.com/linkerd/linkerd2/pkg/healthcheck"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/linkerd/linkerd2/pkg/version"
+	"github.com/spf13/cobra"
+	valuespkg "helm.sh/helm/v3/pkg/cli/values"
+	corev1 "k8s.io/api/core/v1"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/yaml"
+)
+
+const serviceProfileCRDName = "serviceprofiles.linkerd.io"
+
+var namespaceGVR = schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+
+type restoreOptions struct {
+	file       string
+	wait       time.Duration
+	skipChecks bool
+}
+
+// newCmdRestore creates a new cobra command `restore` which reinstalls the
+// control plane from an archive created by `linkerd backup`
+func newCmdRestore() *cobra.Command {
+	options := restoreOptions{
+		file: "linkerd-backup.tar.gz",
+		wait: 300 * time.Second,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Reinstall the Linkerd control plane from an archive created by 'linkerd backup'",
+		Long: `Reinstall the Linkerd control plane from an archive created by 'linkerd backup'.
+
+The control plane is installed into the namespace it was backed up from, with
+the same values and identity, using the version of this CLI. The ServiceProfiles
+are then restored, as well as the multicluster Links and their credentials if
+the multicluster extension is already installed. Finally, the control plane is
+verified by running the same checks as 'linkerd check'.
+
+The ServiceProfiles and Links of namespaces which don't exist in the cluster
+are skipped. If the control plane is already installed, only the
+ServiceProfiles and Links missing from the cluster are restored. This allows to
+run the command again after creating those namespaces, or after installing the
+multicluster extension.`,
+		Example: `  # Restore the control plane from linkerd-backup.tar.gz
+  linkerd restore
+
+  # Restore the control plane from a specific file
+  linkerd restore --file /backups/linkerd-2021-10-19.tar.gz`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			f, err := os.Open(options.file)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			archive, err := readBackup(f)
+			if err != nil {
+				return err
+			}
+			if archive.Metadata.Namespace != controlPlaneNamespace {
+				return fmt.Errorf("the backup was taken from the %s namespace, please use --linkerd-namespace %s", archive.Metadata.Namespace, archive.Metadata.Namespace)
+			}
+
+			k8sAPI, err := k8s.NewAPI(kubeconfigPath, kubeContext, impersonate, impersonateGroup, 30*time.Second)
+			if err != nil {
+				return err
+			}
+			if err := restore(cmd.Context(), k8sAPI, archive, options.wait, os.Stdout); err != nil {
+				fmt.Fprintf(os.Stderr, "%s %s\n", failStatus, err)
+				os.Exit(1)
+			}
+
+			if options.skipChecks {
+				return nil
+			}
+			fmt.Println()
+			if !runRestoreChecks(options.wait) {
+				os.Exit(1)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.file, "file", "f", options.file, "Path of the archive to restore from")
+	cmd.Flags().DurationVar(&options.wait, "wait", options.wait, "Maximum allowed time for the control plane to be ready")
+	cmd.Flags().BoolVar(&options.skipChecks, "skip-checks", options.skipChecks, "Don't run the checks once the control plane is restored")
+
+	return cmd
+}
+
+// restore reinstalls the control plane from the archive, unless it's already
+// installed, and creates the ServiceProfiles and Links which don't exist yet
+func restore(ctx context.Context, k *k8s.KubernetesAPI, archive *backupArchive, timeout time.Duration, w io.Writer) error {
+	_, err := k.CoreV1().ConfigMaps(controlPlaneNamespace).Get(ctx, k8s.ConfigConfigMapName, metav1.GetOptions{})
+	switch {
+	case err == nil:
+		fmt.Fprintf(w, "%s control plane already installed in the %s namespace, skipping\n", warnStatus, controlPlaneNamespace)
+	case kerrors.IsNotFound(err):
+		if archive.Metadata.LinkerdVersion != version.Version {
+			fmt.Fprintf(w, "%s the backup was taken from Linkerd %s and will be restored with Linkerd %s\n", warnStatus, archive.Metadata.LinkerdVersion, version.Version)
+		}
+		if err := restoreControlPlane(ctx, k, archive); err != nil {
+			return fmt.Errorf("failed to restore the control plane: %s", err)
+		}
+		fmt.Fprintf(w, "%s control plane installed in the %s namespace\n", okStatus, controlPlaneNamespace)
+	default:
+		return err
+	}
+
+	if err := waitForCRD(ctx, k, serviceProfileCRDName, timeout); err != nil {
+		return err
+	}
+	if err := waitForControlPlane(ctx, k, timeout); err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "%s control plane is ready\n", okStatus)
+	profiles, missing, err := withExistingNamespaces(ctx, k, archive.ServiceProfiles)
+	if err != nil {
+		return err
+	}
+	if len(missing) > 0 {
+		fmt.Fprintf(w, "%s %d ServiceProfiles not restored: please create the namespaces %s and run this command again\n", warnStatus, len(archive.ServiceProfiles)-len(profiles), strings.Join(missing, ", "))
+	}
+	created, err := createObjects(ctx, k, profiles)
+	if err != nil {
+		return fmt.Errorf("failed to restore the ServiceProfiles: %s", err)
+	}
+	fmt.Fprintf(w, "%s %d ServiceProfiles restored\n", okStatus, created)
+
+	if len(archive.Links) == 0 {
+		return nil
+	}
+	_, err = k.Apiextensions.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, linkCRDName, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		fmt.Fprintf(w, "%s %d Links not restored: please install the multicluster extension and run this command again\n", warnStatus, len(archive.Links))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	links, missing, err := withExistingNamespaces(ctx, k, archive.Links)
+	if err != nil {
+		return err
+	}
+	if len(missing) > 0 {
+		fmt.Fprintf(w, "%s %d Links not restored: please create the namespaces %s and run this command again\n", warnStatus, len(archive.Links)-len(links), strings.Join(missing, ", "))
+	}
+	linkSecrets, _, err := withExistingNamespaces(ctx, k, archive.LinkSecrets)
+	if err != nil {
+		return err
+	}
+	if _, err := createObjects(ctx, k, linkSecrets); err != nil {
+		return fmt.Errorf("failed to restore the Links credentials: %s", err)
+	}
+	created, err = createObjects(ctx, k, links)
+	if err != nil {
+		return fmt.Errorf("failed to restore the Links: %s", err)
+	}
+	fmt.Fprintf(w, "%s %d Links restored\n", okStatus, created)
+
+	return nil
+}
+
+// restoreControlPlane renders the control plane from the values of the
+// archive, and creates its resources. The issuer secret is created right
+// after the namespaces when it's managed externally, as the chart doesn't
+// render it in that case.
+func restoreControlPlane(ctx context.Context, k *k8s.KubernetesAPI, archive *backupArchive) error {
+	values, err := restoredValues(archive)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := render(&buf, values, "", valuespkg.Options{}); err != nil {
+		return err
+	}
+	objs, err := readManifestObjects(&buf)
+	if err != nil {
+		return err
+	}
+
+	var namespaces, others []*unstructured.Unstructured
+	for _, obj := range objs {
+		if obj.GetKind() == "Namespace" {
+			namespaces = append(namespaces, obj)
+		} else {
+			others = append(others, obj)
+		}
+	}
+	if _, err := createObjects(ctx, k, namespaces); err != nil {
+		return err
+	}
+	if values.Identity.Issuer.Scheme == string(corev1.SecretTypeTLS) {
+		if _, err := createObjects(ctx, k, []*unstructured.Unstructured{archive.IssuerSecret}); err != nil {
+			return err
+		}
+	}
+	_, err = createObjects(ctx, k, others)
+	return err
+}
+
+// restoredValues returns the values to install the control plane with: the
+// chart defaults along with the overrides of the archive
+func restoredValues(archive *backupArchive) (*l5dcharts.Values, error) {
+	values, err := l5dcharts.NewValues()
+	if err != nil {
+		return nil, err
+	}
+	overrides, err := config.RemoveGlobalFieldIfPresent(archive.ConfigOverrides)
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(overrides, values); err != nil {
+		return nil, err
+	}
+
+	if values.Identity.Issuer.Scheme == string(corev1.SecretTypeTLS) {
+		// The trust anchors of externally managed certificates aren't part
+		// of the overrides
+		values.IdentityTrustAnchorsPEM = archive.TrustAnchors
+	}
+	if values.IdentityTrustAnchorsPEM == "" {
+		return nil, errors.New("the backup doesn't contain the trust anchors")
+	}
+	if values.Identity.Issuer.Scheme != string(corev1.SecretTypeTLS) &&
+		(values.Identity.Issuer.TLS.CrtPEM == "" || values.Identity.Issuer.TLS.KeyPEM == "") {
+		return nil, errors.New("the backup doesn't contain the issuer credentials")
+	}
+
+	return values, nil
+}
+
+// createObjects creates the objects, skipping those which already exist, and
+// returns the number of objects created
+func createObjects(ctx context.Context, k *k8s.KubernetesAPI, objs []*unstructured.Unstructured) (int, error) {
+	created := 0
+	for _, obj := range objs {
+		resource, _ := meta.UnsafeGuessKindToResource(obj.GroupVersionKind())
+		_, err := k.DynamicClient.Resource(resource).Namespace(obj.GetNamespace()).Create(ctx, obj, metav1.CreateOptions{})
+		if kerrors.IsAlreadyExists(err) {
+			continue
+		}
+		if err != nil {
+			return created, fmt.Errorf("failed to create %s/%s: %s", obj.GetKind(), obj.GetName(), err)
+		}
+		created++
+	}
+	return created, nil
+}
+
+// withExistingNamespaces returns the objects whose namespace exists in the
+// cluster, as the objects of a missing namespace can't be created, along with
+// the sorted list of the missing namespaces
+func withExistingNamespaces(ctx context.Context, k *k8s.KubernetesAPI, objs []*unstructured.Unstructured) ([]*unstructured.Unstructured, []string, error) {
+	exists := map[string]bool{}
+	var existing []*unstructured.Unstructured
+	var missing []string
+	for _, obj := range objs {
+		ns := obj.GetNamespace()
+		if _, ok := exists[ns]; !ok {
+			_, err := k.DynamicClient.Resource(namespaceGVR).Get(ctx, ns, metav1.GetOptions{})
+			switch {
+			case err == nil:
+				exists[ns] = true
+			case kerrors.IsNotFound(err):
+				exists[ns] = false
+				missing = append(missing, ns)
+			default:
+				return nil, nil, fmt.Errorf("failed to get namespace %s: %s", ns, err)
+			}
+		}
+		if exists[ns] {
+			existing = append(existing, obj)
+		}
+	}
+	sort.Strings(missing)
+	return existing, missing, nil
+}
+
+// waitForCRD waits for the CRD to be established, so that its resources
+// can be created
+func waitForCRD(ctx context.Context, k *k8s.KubernetesAPI, name string, timeout time.Duration) error {
+	err := wait.PollImmediate(time.Second, timeout, func() (bool, error) {
+		crd, err := k.Apiextensions.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, name, metav1.GetOptions{})
+		if kerrors.IsNotFound(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		for _, condition := range crd.Status.Conditions {
+			if condition.Type == apiextv1.Established && condition.Status == apiextv1.ConditionTrue {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("CustomResourceDefinition/%s is not established: %s", name, err)
+	}
+	return nil
+}
+
+// waitForControlPlane waits for the rollout of the control plane
+// Deployments, as the ServiceProfiles can't be created until the sp-validator
+// webhook is serving when its failure policy is Fail
+func waitForControlPlane(ctx context.Context, k *k8s.KubernetesAPI, timeout time.Duration) error {
+	options := metav1.ListOptions{LabelSelector: fmt.Sprintf("%s=%s", k8s.ControllerNSLabel, controlPlaneNamespace)}
+	deployments, err := k.AppsV1().Deployments(controlPlaneNamespace).List(ctx, options)
+	if err != nil {
+		return fmt.Errorf("failed to list the control plane deployments: %s", err)
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	for _, d := range deployments.Items {
+		workload := k8s.Workload{Kind: k8s.Deployment, Namespace: d.Namespace, Name: d.Name}
+		if err := k.WaitForRollout(waitCtx, workload, rolloutPollInterval); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runRestoreChecks runs the control plane checks of 'linkerd check' against
+// the restored control plane
+func runRestoreChecks(timeout time.Duration) bool {
+	checks := []healthcheck.CategoryID{
+		healthcheck.KubernetesAPIChecks,
+		healthcheck.KubernetesVersionChecks,
+		healthcheck.LinkerdVersionChecks,
+		healthcheck.LinkerdConfigChecks,
+		healthcheck.LinkerdControlPlaneExistenceChecks,
+		healthcheck.LinkerdIdentity,
+		healthcheck.LinkerdWebhooksAndAPISvcTLS,
+		healthcheck.LinkerdWebhooksChecks,
+		healthcheck.LinkerdControlPlaneProxyChecks,
+		healthcheck.LinkerdControlPlaneVersionChecks,
+	}
+	hc := healthcheck.NewHealthChecker(checks, &healthcheck.Options{
+		ControlPlaneNamespace: controlPlaneNamespace,
+		CNINamespace:          cniNamespace,
+		KubeConfig:            kubeconfigPath,
+		KubeContext:           kubeContext,
+		Impersonate:           impersonate,
+		ImpersonateGroup:      impersonateGroup,
+		APIAddr:               apiAddr,
+		RetryDeadline:         time.Now().Add(timeout),
+	})
+
+	healthcheck.PrintCoreChecksHeader(os.Stdout)
+	return healthcheck.RunChecks(os.Stdout, os.Stderr, hc, tableOutput)
+}
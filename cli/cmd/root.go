@@ -26,6 +26,8 @@ const (
 	jsonOutput  = healthcheck.JSONOutput
 	tableOutput = healthcheck.TableOutput
 	shortOutput = healthcheck.ShortOutput
+	junitOutput = healthcheck.JUnitOutput
+	sarifOutput = healthcheck.SARIFOutput
 )
 
 var (
@@ -102,6 +104,7 @@ func init() {
 	RootCmd.PersistentFlags().StringVar(&apiAddr, "api-addr", "", "Override kubeconfig and communicate directly with the control plane at host:port (mostly for testing)")
 	RootCmd.PersistentFlags().BoolVar(&verbose, "verbose", false, "Turn on debug logging")
 	RootCmd.AddCommand(newCmdAlpha())
+	RootCmd.AddCommand(newCmdBackup())
 	RootCmd.AddCommand(newCmdCheck())
 	RootCmd.AddCommand(newCmdCompletion())
 	RootCmd.AddCommand(newCmdDiagnostics())
@@ -112,6 +115,7 @@ func init() {
 	RootCmd.AddCommand(newCmdInstallCNIPlugin())
 	RootCmd.AddCommand(newCmdProfile())
 	RootCmd.AddCommand(newCmdRepair())
+	RootCmd.AddCommand(newCmdRestore())
 	RootCmd.AddCommand(newCmdUninject())
 	RootCmd.AddCommand(newCmdUpgrade())
 	RootCmd.AddCommand(newCmdVersion())
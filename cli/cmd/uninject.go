@@ -7,7 +7,9 @@ import (
 
 	"github.com/linkerd/linkerd2/pkg/charts/linkerd2"
 	"github.com/linkerd/linkerd2/pkg/inject"
+	"github.com/linkerd/linkerd2/pkg/k8s"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 type resourceTransformerUninject struct {
@@ -27,13 +29,23 @@ func runUninjectSilentCmd(inputs []io.Reader, errWriter, outWriter io.Writer, va
 }
 
 func newCmdUninject() *cobra.Command {
+	var cluster bool
+	clusterOptions := newUninjectClusterOptions()
+
 	cmd := &cobra.Command{
-		Use:   "uninject [flags] CONFIG-FILE",
+		Use:   "uninject [flags] CONFIG-FILE | --cluster [TYPE/NAME...]",
 		Short: "Remove the Linkerd proxy from a Kubernetes config",
 		Long: `Remove the Linkerd proxy from a Kubernetes config.
 
 You can uninject resources contained in a single file, inside a folder and its
-sub-folders, or coming from stdin.`,
+sub-folders, or coming from stdin.
+
+With --cluster, the proxy is instead removed from the live workloads of the
+given namespaces: the inject annotation is removed from each namespace and from
+its meshed workloads, which are then restarted, and their new pods are verified
+to run without a proxy. With --all-namespaces, every namespace but the ones of
+the control plane and of its extensions is uninjected. When workloads are
+given, only those are uninjected, from a single namespace.`,
 		Example: `  # Uninject all the deployments in the default namespace.
   kubectl get deploy -o yaml | linkerd uninject - | kubectl apply -f -
 
@@ -41,8 +53,31 @@ sub-folders, or coming from stdin.`,
   curl http://url.to/yml | linkerd uninject - | kubectl apply -f -
 
   # Uninject all the resources inside a folder and its sub-folders.
-  linkerd uninject <folder> | kubectl apply -f -`,
+  linkerd uninject <folder> | kubectl apply -f -
+
+  # Remove the proxy from all the workloads of the emojivoto namespace.
+  linkerd uninject --cluster -n emojivoto
+
+  # Remove the proxy from the workloads of several namespaces.
+  linkerd uninject --cluster -n emojivoto,booksapp
+
+  # Remove the proxy from the workloads of all the namespaces.
+  linkerd uninject --cluster --all-namespaces
+
+  # Remove the proxy from a single deployment.
+  linkerd uninject --cluster -n emojivoto deploy/web`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateUninjectFlags(cmd.Flags(), cluster); err != nil {
+				return err
+			}
+
+			if cluster {
+				k8sAPI, err := k8s.NewAPI(kubeconfigPath, kubeContext, impersonate, impersonateGroup, 0)
+				if err != nil {
+					return err
+				}
+				return uninjectCluster(cmd.Context(), k8sAPI, clusterOptions, args, os.Stdout)
+			}
 
 			if len(args) < 1 {
 				return fmt.Errorf("please specify a kubernetes resource file")
@@ -59,9 +94,30 @@ sub-folders, or coming from stdin.`,
 		},
 	}
 
+	cmd.Flags().BoolVar(&cluster, "cluster", cluster, "Uninject the live workloads of a namespace instead of a Kubernetes config")
+	cmd.Flags().StringSliceVarP(&clusterOptions.namespaces, "namespace", "n", clusterOptions.namespaces, "Namespaces of the workloads to uninject, with --cluster; can be repeated or comma-separated (default: the current namespace)")
+	cmd.Flags().BoolVarP(&clusterOptions.allNamespaces, "all-namespaces", "A", clusterOptions.allNamespaces, "Uninject the workloads of all the namespaces but the ones of the control plane and its extensions, with --cluster")
+	cmd.Flags().DurationVar(&clusterOptions.timeout, "timeout", clusterOptions.timeout, "Maximum time to wait for each workload to be rolled out, with --cluster")
+
 	return cmd
 }
 
+// clusterUninjectFlags are the flags of `linkerd uninject` that only apply to
+// --cluster
+var clusterUninjectFlags = []string{"namespace", "all-namespaces", "timeout"}
+
+func validateUninjectFlags(flags *pflag.FlagSet, cluster bool) error {
+	if cluster {
+		return nil
+	}
+	for _, name := range clusterUninjectFlags {
+		if flags.Changed(name) {
+			return fmt.Errorf("--%s can only be used with --cluster", name)
+		}
+	}
+	return nil
+}
+
 func (rt resourceTransformerUninject) transform(bytes []byte) ([]byte, []inject.Report, error) {
 	conf := inject.NewResourceConfig(rt.values, inject.OriginWebhook)
 
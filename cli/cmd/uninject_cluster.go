@@ -0,0 +1,277 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	pkgcmd "github.com/linkerd/linkerd2/pkg/cmd"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type uninjectClusterOptions struct {
+	namespaces    []string
+	allNamespaces bool
+	timeout       time.Duration
+}
+
+func newUninjectClusterOptions() *uninjectClusterOptions {
+	return &uninjectClusterOptions{
+		namespaces:    []string{},
+		allNamespaces: false,
+		timeout:       5 * time.Minute,
+	}
+}
+
+func (options *uninjectClusterOptions) validate(args []string) error {
+	if options.allNamespaces && len(options.namespaces) > 0 {
+		return errors.New("--namespace and --all-namespaces can't be used together")
+	}
+	if len(args) > 0 && (options.allNamespaces || len(options.namespaces) > 1) {
+		return errors.New("workloads can only be given for a single namespace")
+	}
+	return nil
+}
+
+// uninjectCluster removes the proxy from the live workloads of the given
+// namespaces, or of all the namespaces but the ones of the control plane and
+// its extensions
+func uninjectCluster(ctx context.Context, k8sAPI *k8s.KubernetesAPI, options *uninjectClusterOptions, args []string, w io.Writer) error {
+	if err := options.validate(args); err != nil {
+		return err
+	}
+
+	namespaces, err := getUninjectNamespaces(ctx, k8sAPI, options)
+	if err != nil {
+		return err
+	}
+	for _, ns := range namespaces {
+		if err := uninjectNamespace(ctx, k8sAPI, ns, options.timeout, args, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getUninjectNamespaces returns the namespaces to uninject, sorted by name.
+// With --all-namespaces, the namespaces of the control plane and of the
+// extensions are left out, as uninjecting them would break the mesh.
+func getUninjectNamespaces(ctx context.Context, k8sAPI *k8s.KubernetesAPI, options *uninjectClusterOptions) ([]corev1.Namespace, error) {
+	var namespaces []corev1.Namespace
+	if options.allNamespaces {
+		list, err := k8sAPI.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, ns := range list.Items {
+			if _, ok := ns.Labels[k8s.LinkerdNamespaceLabel]; ok {
+				continue
+			}
+			if _, ok := ns.Labels[k8s.LinkerdExtensionLabel]; ok {
+				continue
+			}
+			namespaces = append(namespaces, ns)
+		}
+	} else {
+		names := options.namespaces
+		if len(names) == 0 {
+			names = []string{pkgcmd.GetDefaultNamespace(kubeconfigPath, kubeContext)}
+		}
+		for _, name := range names {
+			ns, err := k8sAPI.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			namespaces = append(namespaces, *ns)
+		}
+	}
+
+	sort.Slice(namespaces, func(i, j int) bool { return namespaces[i].Name < namespaces[j].Name })
+	return namespaces, nil
+}
+
+// uninjectNamespace removes the proxy from the live workloads of a namespace.
+// When no workloads are given, the inject annotation is removed from the
+// namespace and all its meshed workloads; otherwise only the given workloads
+// are uninjected, and they get the inject annotation set to disabled if the
+// namespace is still annotated for injection. The workloads are then rolled
+// out, and their pods are checked to no longer have a proxy.
+func uninjectNamespace(ctx context.Context, k8sAPI *k8s.KubernetesAPI, ns corev1.Namespace, timeout time.Duration, args []string, w io.Writer) error {
+	var err error
+
+	var workloads []k8s.Workload
+	if len(args) == 0 {
+		if _, ok := ns.Annotations[k8s.ProxyInjectAnnotation]; ok {
+			fmt.Fprintf(w, "Removing the %s annotation from namespace %s\n", k8s.ProxyInjectAnnotation, ns.Name)
+			patch := []byte(fmt.Sprintf(`{"metadata":{"annotations":{"%s":null}}}`, k8s.ProxyInjectAnnotation))
+			if _, err := k8sAPI.CoreV1().Namespaces().Patch(ctx, ns.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+				return err
+			}
+		}
+
+		workloads, err = getMeshedWorkloads(ctx, k8sAPI, ns.Name)
+		if err != nil {
+			return err
+		}
+	} else {
+		workloads, err = parseWorkloads(ns.Name, args)
+		if err != nil {
+			return err
+		}
+	}
+	if len(workloads) == 0 {
+		fmt.Fprintf(w, "No meshed workloads found in namespace %s\n", ns.Name)
+		return nil
+	}
+
+	// When only some workloads are uninjected from a namespace annotated for
+	// injection, removing their annotation isn't enough for the proxy
+	// injector to skip them
+	var injectValue *string
+	if len(args) > 0 && ns.Annotations[k8s.ProxyInjectAnnotation] != "" && ns.Annotations[k8s.ProxyInjectAnnotation] != k8s.ProxyInjectDisabled {
+		disabled := k8s.ProxyInjectDisabled
+		injectValue = &disabled
+	}
+
+	if err := uninjectWorkloads(ctx, k8sAPI, workloads, injectValue, timeout, w); err != nil {
+		return err
+	}
+
+	remaining, err := getRemainingProxies(ctx, k8sAPI, ns.Name, workloads, len(args) == 0)
+	if err != nil {
+		return err
+	}
+	if len(remaining) > 0 {
+		return fmt.Errorf("some pods still have a proxy:\n\t%s", strings.Join(remaining, "\n\t"))
+	}
+	fmt.Fprintf(w, "%s no proxies remain in the uninjected workloads of namespace %s\n", okStatus, ns.Name)
+
+	return nil
+}
+
+// uninjectWorkloads sets the inject annotation of the pod template of the
+// workloads to injectValue, or removes it if nil, and waits for their
+// rollouts to complete
+func uninjectWorkloads(ctx context.Context, k8sAPI *k8s.KubernetesAPI, workloads []k8s.Workload, injectValue *string, timeout time.Duration, w io.Writer) error {
+	for _, workload := range workloads {
+		fmt.Fprintf(w, "Restarting %s\n", workload)
+		annotations := map[string]*string{k8s.ProxyInjectAnnotation: injectValue}
+		if err := k8sAPI.PatchWorkloadTemplateAnnotations(ctx, workload, annotations); err != nil {
+			return fmt.Errorf("failed to uninject %s: %s", workload, err)
+		}
+	}
+
+	for _, workload := range workloads {
+		waitCtx, cancel := context.WithTimeout(ctx, timeout)
+		err := k8sAPI.WaitForRollout(waitCtx, workload, rolloutPollInterval)
+		cancel()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "%s %s is ready\n", okStatus, workload)
+	}
+	return nil
+}
+
+// getMeshedWorkloads returns the workloads of the namespace having pods with
+// a proxy, sorted by name
+func getMeshedWorkloads(ctx context.Context, k8sAPI *k8s.KubernetesAPI, namespace string) ([]k8s.Workload, error) {
+	pods, err := k8sAPI.GetPodsByNamespace(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[k8s.Workload]bool{}
+	var workloads []k8s.Workload
+	for _, pod := range pods {
+		if !hasProxy(pod) {
+			continue
+		}
+		workload, err := k8sAPI.GetPodWorkload(ctx, pod)
+		if err != nil {
+			return nil, err
+		}
+		if workload == nil || seen[*workload] {
+			continue
+		}
+		seen[*workload] = true
+		workloads = append(workloads, *workload)
+	}
+
+	sort.Slice(workloads, func(i, j int) bool {
+		return workloads[i].String() < workloads[j].String()
+	})
+	return workloads, nil
+}
+
+// parseWorkloads parses workloads given as TYPE/NAME arguments
+func parseWorkloads(namespace string, args []string) ([]k8s.Workload, error) {
+	workloads := make([]k8s.Workload, 0, len(args))
+	for _, arg := range args {
+		parts := strings.Split(arg, "/")
+		if len(parts) != 2 || parts[1] == "" {
+			return nil, fmt.Errorf("invalid workload %q: expected TYPE/NAME", arg)
+		}
+		kind, err := k8s.CanonicalResourceNameFromFriendlyName(parts[0])
+		if err != nil {
+			return nil, err
+		}
+		switch kind {
+		case k8s.Deployment, k8s.DaemonSet, k8s.StatefulSet:
+		default:
+			return nil, errors.New("only deployments, daemonsets and statefulsets can be uninjected from the cluster")
+		}
+		workloads = append(workloads, k8s.Workload{Kind: kind, Namespace: namespace, Name: parts[1]})
+	}
+	return workloads, nil
+}
+
+// getRemainingProxies returns the running pods of the namespace which still
+// have a proxy. Unless allPods is set, only the pods of the given workloads
+// are considered.
+func getRemainingProxies(ctx context.Context, k8sAPI *k8s.KubernetesAPI, namespace string, workloads []k8s.Workload, allPods bool) ([]string, error) {
+	uninjected := map[k8s.Workload]bool{}
+	for _, workload := range workloads {
+		uninjected[workload] = true
+	}
+
+	pods, err := k8sAPI.GetPodsByNamespace(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var remaining []string
+	for _, pod := range pods {
+		if pod.DeletionTimestamp != nil || !hasProxy(pod) {
+			continue
+		}
+		if !allPods {
+			workload, err := k8sAPI.GetPodWorkload(ctx, pod)
+			if err != nil {
+				return nil, err
+			}
+			if workload == nil || !uninjected[*workload] {
+				continue
+			}
+		}
+		remaining = append(remaining, fmt.Sprintf("%s/%s", pod.Namespace, pod.Name))
+	}
+	sort.Strings(remaining)
+	return remaining, nil
+}
+
+func hasProxy(pod corev1.Pod) bool {
+	for _, container := range pod.Spec.Containers {
+		if container.Name == k8s.ProxyContainerName {
+			return true
+		}
+	}
+	return false
+}
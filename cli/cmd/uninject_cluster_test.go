@@ -0,0 +1,274 @@
+package cmd
+
+import (
+	"context"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var uninjectClusterConfigs = []string{`
+apiVersion: apps/v1
+kind: ReplicaSet
+metadata:
+  name: web-5f86686c4d
+  namespace: emojivoto
+  ownerReferences:
+  - apiVersion: apps/v1
+    kind: Deployment
+    name: web
+    controller: true
+`, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: web-5f86686c4d-58nkl
+  namespace: emojivoto
+  ownerReferences:
+  - apiVersion: apps/v1
+    kind: ReplicaSet
+    name: web-5f86686c4d
+    controller: true
+spec:
+  containers:
+  - name: web-svc
+  - name: linkerd-proxy
+`, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: vote-bot-0
+  namespace: emojivoto
+  ownerReferences:
+  - apiVersion: apps/v1
+    kind: StatefulSet
+    name: vote-bot
+    controller: true
+spec:
+  containers:
+  - name: vote-bot
+`, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: emoji-0
+  namespace: emojivoto
+  ownerReferences:
+  - apiVersion: apps/v1
+    kind: StatefulSet
+    name: emoji
+    controller: true
+spec:
+  containers:
+  - name: emoji
+  - name: linkerd-proxy
+`,
+}
+
+func TestGetMeshedWorkloads(t *testing.T) {
+	k8sAPI, err := k8s.NewFakeAPI(uninjectClusterConfigs...)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	workloads, err := getMeshedWorkloads(context.Background(), k8sAPI, "emojivoto")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := []k8s.Workload{
+		{Kind: k8s.Deployment, Namespace: "emojivoto", Name: "web"},
+		{Kind: k8s.StatefulSet, Namespace: "emojivoto", Name: "emoji"},
+	}
+	if !reflect.DeepEqual(workloads, expected) {
+		t.Fatalf("Expected workloads %v, got %v", expected, workloads)
+	}
+}
+
+func TestUninjectWorkloads(t *testing.T) {
+	k8sAPI, err := k8s.NewFakeAPI(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  namespace: emojivoto
+status:
+  replicas: 1
+  updatedReplicas: 1
+  availableReplicas: 1
+`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	disabled := k8s.ProxyInjectDisabled
+	workloads := []k8s.Workload{{Kind: k8s.Deployment, Namespace: "emojivoto", Name: "web"}}
+	if err := uninjectWorkloads(context.Background(), k8sAPI, workloads, &disabled, time.Second, io.Discard); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	deploy, err := k8sAPI.AppsV1().Deployments("emojivoto").Get(context.Background(), "web", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if deploy.Spec.Template.Annotations[k8s.ProxyInjectAnnotation] != k8s.ProxyInjectDisabled {
+		t.Fatalf("Expected the injection to be disabled, got annotations %v", deploy.Spec.Template.Annotations)
+	}
+	if _, ok := deploy.Spec.Template.Annotations[k8s.RestartedAtAnnotation]; !ok {
+		t.Fatalf("Expected the deployment to be restarted, got annotations %v", deploy.Spec.Template.Annotations)
+	}
+
+	workloads = []k8s.Workload{{Kind: k8s.Pod, Namespace: "emojivoto", Name: "debug"}}
+	if err := uninjectWorkloads(context.Background(), k8sAPI, workloads, &disabled, time.Second, io.Discard); err == nil {
+		t.Fatal("Expected an error for a bare pod")
+	}
+}
+
+func TestGetRemainingProxies(t *testing.T) {
+	k8sAPI, err := k8s.NewFakeAPI(uninjectClusterConfigs...)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		workloads []k8s.Workload
+		allPods   bool
+		expected  []string
+	}{
+		{
+			allPods:  true,
+			expected: []string{"emojivoto/emoji-0", "emojivoto/web-5f86686c4d-58nkl"},
+		},
+		{
+			workloads: []k8s.Workload{{Kind: k8s.Deployment, Namespace: "emojivoto", Name: "web"}},
+			expected:  []string{"emojivoto/web-5f86686c4d-58nkl"},
+		},
+		{
+			workloads: []k8s.Workload{{Kind: k8s.StatefulSet, Namespace: "emojivoto", Name: "vote-bot"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		remaining, err := getRemainingProxies(context.Background(), k8sAPI, "emojivoto", tc.workloads, tc.allPods)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if !reflect.DeepEqual(remaining, tc.expected) {
+			t.Fatalf("Expected remaining proxies %v, got %v", tc.expected, remaining)
+		}
+	}
+}
+
+func TestParseWorkloads(t *testing.T) {
+	workloads, err := parseWorkloads("emojivoto", []string{"deploy/web", "sts/emoji"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	expected := []k8s.Workload{
+		{Kind: k8s.Deployment, Namespace: "emojivoto", Name: "web"},
+		{Kind: k8s.StatefulSet, Namespace: "emojivoto", Name: "emoji"},
+	}
+	if !reflect.DeepEqual(workloads, expected) {
+		t.Fatalf("Expected workloads %v, got %v", expected, workloads)
+	}
+
+	for _, arg := range []string{"web", "deploy/", "po/web"} {
+		if _, err := parseWorkloads("emojivoto", []string{arg}); err == nil {
+			t.Fatalf("Expected an error for %q", arg)
+		}
+	}
+}
+
+func TestGetUninjectNamespaces(t *testing.T) {
+	k8sAPI, err := k8s.NewFakeAPI(`
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: linkerd
+  labels:
+    linkerd.io/is-control-plane: "true"
+`, `
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: linkerd-viz
+  labels:
+    linkerd.io/extension: viz
+`, `
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: emojivoto
+`, `
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: booksapp
+`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		options  *uninjectClusterOptions
+		expected []string
+	}{
+		{
+			options:  &uninjectClusterOptions{namespaces: []string{"emojivoto", "booksapp"}},
+			expected: []string{"booksapp", "emojivoto"},
+		},
+		{
+			options:  &uninjectClusterOptions{allNamespaces: true},
+			expected: []string{"booksapp", "emojivoto"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		namespaces, err := getUninjectNamespaces(context.Background(), k8sAPI, tc.options)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		var names []string
+		for _, ns := range namespaces {
+			names = append(names, ns.Name)
+		}
+		if !reflect.DeepEqual(names, tc.expected) {
+			t.Fatalf("Expected namespaces %v, got %v", tc.expected, names)
+		}
+	}
+
+	if _, err := getUninjectNamespaces(context.Background(), k8sAPI, &uninjectClusterOptions{namespaces: []string{"missing"}}); err == nil {
+		t.Fatal("Expected an error for a missing namespace")
+	}
+}
+
+func TestUninjectClusterOptionsValidate(t *testing.T) {
+	testCases := []struct {
+		options *uninjectClusterOptions
+		args    []string
+		valid   bool
+	}{
+		{&uninjectClusterOptions{namespaces: []string{"emojivoto"}}, []string{"deploy/web"}, true},
+		{&uninjectClusterOptions{namespaces: []string{"emojivoto", "booksapp"}}, nil, true},
+		{&uninjectClusterOptions{allNamespaces: true}, nil, true},
+		{&uninjectClusterOptions{namespaces: []string{"emojivoto", "booksapp"}}, []string{"deploy/web"}, false},
+		{&uninjectClusterOptions{allNamespaces: true}, []string{"deploy/web"}, false},
+		{&uninjectClusterOptions{namespaces: []string{"emojivoto"}, allNamespaces: true}, nil, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.options.validate(tc.args)
+		if tc.valid && err != nil {
+			t.Fatalf("Unexpected error for %+v: %s", tc.options, err)
+		}
+		if !tc.valid && err == nil {
+			t.Fatalf("Expected an error for %+v with %v", tc.options, tc.args)
+		}
+	}
+}
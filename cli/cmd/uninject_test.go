@@ -121,3 +121,34 @@ func TestUninjectYAML(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateUninjectFlags(t *testing.T) {
+	testCases := []struct {
+		flags   map[string]string
+		cluster bool
+		err     string
+	}{
+		{map[string]string{"namespace": "emojivoto", "timeout": "1m"}, true, ""},
+		{map[string]string{"all-namespaces": "true"}, true, ""},
+		{map[string]string{}, false, ""},
+		{map[string]string{"namespace": "emojivoto"}, false, "--namespace can only be used with --cluster"},
+		{map[string]string{"all-namespaces": "true"}, false, "--all-namespaces can only be used with --cluster"},
+		{map[string]string{"timeout": "1m"}, false, "--timeout can only be used with --cluster"},
+	}
+
+	for _, tc := range testCases {
+		flags := newCmdUninject().Flags()
+		for name, value := range tc.flags {
+			if err := flags.Set(name, value); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		}
+		err := validateUninjectFlags(flags, tc.cluster)
+		if tc.err == "" && err != nil {
+			t.Fatalf("Unexpected error for %v: %s", tc.flags, err)
+		}
+		if tc.err != "" && (err == nil || err.Error() != tc.err) {
+			t.Fatalf("Expected error %q for %v, got %v", tc.err, tc.flags, err)
+		}
+	}
+}
@@ -1,30 +1,80 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"os"
+	"sort"
 	"strings"
+	"time"
 
+	jaeger "github.com/linkerd/linkerd2/jaeger/cmd"
+	multicluster "github.com/linkerd/linkerd2/multicluster/cmd"
 	pkgCmd "github.com/linkerd/linkerd2/pkg/cmd"
 	"github.com/linkerd/linkerd2/pkg/k8s"
+	mc "github.com/linkerd/linkerd2/pkg/multicluster"
+	viz "github.com/linkerd/linkerd2/viz/cmd"
 	"github.com/spf13/cobra"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 )
 
 const (
 	yamlSep = "---\n"
 )
 
+// extensionUninstallOrder lists the known extensions in the order they must
+// be uninstalled, the extensions depending on others coming first: jaeger and
+// multicluster rely on the metrics of viz. Unknown extensions are uninstalled
+// before all of these, as they may depend on any of them.
+var extensionUninstallOrder = []string{
+	multicluster.MulticlusterExtensionName, multicluster.MulticlusterLegacyExtension,
+	jaeger.JaegerExtensionName, jaeger.JaegerLegacyExtension,
+	viz.ExtensionName, viz.LegacyExtensionName,
+}
+
+type (
+	uninstallOptions struct {
+		force       bool
+		orchestrate bool
+		wait        time.Duration
+	}
+
+	// meshExtension is an extension installed in the cluster
+	meshExtension struct {
+		name      string
+		namespace string
+	}
+)
+
 func newCmdUninstall() *cobra.Command {
-	var force bool
+	options := uninstallOptions{
+		wait: 300 * time.Second,
+	}
+
 	cmd := &cobra.Command{
 		Use:   "uninstall",
 		Args:  cobra.NoArgs,
 		Short: "Output Kubernetes resources to uninstall Linkerd control plane",
 		Long: `Output Kubernetes resources to uninstall Linkerd control plane.
 
-This command provides all Kubernetes namespace-scoped and cluster-scoped resources (e.g services, deployments, RBACs, etc.) necessary to uninstall Linkerd control plane.`,
-		Example: ` linkerd uninstall | kubectl delete -f -`,
+This command provides all Kubernetes namespace-scoped and cluster-scoped resources (e.g services, deployments, RBACs, etc.) necessary to uninstall Linkerd control plane.
+
+Uninstalling the control plane breaks the identity and service discovery of the
+meshed workloads, so the command refuses to proceed while meshed workloads or
+extensions remain, unless --force is used.
+
+With --orchestrate, the command removes everything from the cluster itself: it
+uninjects the meshed deployments, statefulsets and daemonsets, waits for their
+pods to be replaced, uninstalls the extensions in dependency order, and then the
+control plane.`,
+		Example: `  # Output the resources to delete
+  linkerd uninstall | kubectl delete -f -
+
+  # Uninject the workloads, uninstall the extensions and the control plane
+  linkerd uninstall --orchestrate`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 
 			k8sAPI, err := k8s.NewAPI(kubeconfigPath, kubeContext, impersonate, impersonateGroup, 0)
@@ -32,54 +82,44 @@ This command provides all Kubernetes namespace-scoped and cluster-scoped resourc
 				return err
 			}
 
-			if !force {
+			extensions, err := fetchExtensions(cmd.Context(), k8sAPI)
+			if err != nil {
+				return err
+			}
+			workloads, pods, err := fetchMeshedWorkloads(cmd.Context(), k8sAPI, extensions)
+			if err != nil {
+				return err
+			}
 
-				var fail bool
-				// Retrieve any installed extensions
-				extensionNamespaces, err := k8sAPI.GetAllNamespacesWithExtensionLabel(cmd.Context())
+			if options.orchestrate {
+				err = orchestratedUninstall(cmd.Context(), k8sAPI, extensions, workloads, pods, options, os.Stdout)
 				if err != nil {
-					return err
+					fmt.Fprintf(os.Stderr, "%s %s\n", failStatus, err)
+					os.Exit(1)
 				}
+				return nil
+			}
 
-				// map of the namespace and the extension name
-				// Namespace is used as key so as to support custom namespace installs
-				extensions := make(map[string]string)
-				if len(extensionNamespaces) > 0 {
-					for _, extension := range extensionNamespaces {
-						extensions[extension.Name] = extension.Labels[k8s.LinkerdExtensionLabel]
-					}
-
-					// Retrieve all the extension names
-					extensionNames := make([]string, 0, len(extensions))
-					for _, v := range extensions {
-						extensionNames = append(extensionNames, fmt.Sprintf("* %s", v))
+			if !options.force && (len(extensions) > 0 || len(workloads) > 0 || len(pods) > 0) {
+				if len(extensions) > 0 {
+					var extensionNames []string
+					for _, extension := range extensions {
+						extensionNames = append(extensionNames, fmt.Sprintf("* %s (%s)", extension.name, extension.namespace))
 					}
-
 					fmt.Fprintln(os.Stderr, fmt.Sprintf("Please uninstall the following extensions before uninstalling the control-plane:\n\t%s", strings.Join(extensionNames, "\n\t")))
-					fail = true
-				}
-
-				podList, err := k8sAPI.CoreV1().Pods("").List(cmd.Context(), metav1.ListOptions{LabelSelector: k8s.ControllerNSLabel})
-				if err != nil {
-					return err
 				}
-
-				var injectedPods []string
-				for _, pod := range podList.Items {
-					// skip core control-plane namespace, and extension namespaces
-					if pod.Namespace != controlPlaneNamespace && extensions[pod.Namespace] == "" {
-						injectedPods = append(injectedPods, fmt.Sprintf("* %s", pod.Name))
+				if len(workloads) > 0 || len(pods) > 0 {
+					var workloadNames []string
+					for _, workload := range workloads {
+						workloadNames = append(workloadNames, fmt.Sprintf("* %s", workload))
 					}
+					for _, pod := range pods {
+						workloadNames = append(workloadNames, fmt.Sprintf("* %s", pod))
+					}
+					fmt.Fprintln(os.Stderr, fmt.Sprintf("Please uninject the following workloads before uninstalling the control-plane:\n\t%s", strings.Join(workloadNames, "\n\t")))
 				}
-
-				if len(injectedPods) > 0 {
-					fmt.Fprintln(os.Stderr, fmt.Sprintf("Please uninject the following pods before uninstalling the control-plane:\n\t%s", strings.Join(injectedPods, "\n\t")))
-					fail = true
-				}
-
-				if fail {
-					os.Exit(1)
-				}
+				fmt.Fprintln(os.Stderr, "Alternatively, use --orchestrate to remove them along with the control-plane")
+				os.Exit(1)
 			}
 
 			selector, err := pkgCmd.GetLabelSelector(k8s.ControllerNSLabel)
@@ -96,6 +136,165 @@ This command provides all Kubernetes namespace-scoped and cluster-scoped resourc
 		},
 	}
 
-	cmd.Flags().BoolVarP(&force, "force", "f", force, "Force uninstall even if there exist non-control-plane injected pods")
+	cmd.Flags().BoolVarP(&options.force, "force", "f", options.force, "Force uninstall even if there exist non-control-plane injected pods")
+	cmd.Flags().BoolVar(&options.orchestrate, "orchestrate", options.orchestrate, "Uninject the meshed workloads, uninstall the extensions and then the control plane directly from the cluster, instead of outputting the resources to delete")
+	cmd.Flags().DurationVar(&options.wait, "wait", options.wait, "With --orchestrate, maximum allowed time for each workload to be uninjected and for each extension to be removed")
 	return cmd
 }
+
+// fetchExtensions returns the installed extensions, in the order they must
+// be uninstalled
+func fetchExtensions(ctx context.Context, k8sAPI *k8s.KubernetesAPI) ([]meshExtension, error) {
+	namespaces, err := k8sAPI.GetAllNamespacesWithExtensionLabel(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	extensions := make([]meshExtension, len(namespaces))
+	for i, ns := range namespaces {
+		extensions[i] = meshExtension{name: ns.Labels[k8s.LinkerdExtensionLabel], namespace: ns.Name}
+	}
+	sortExtensions(extensions)
+	return extensions, nil
+}
+
+// sortExtensions sorts the extensions in the order they must be uninstalled
+func sortExtensions(extensions []meshExtension) {
+	rank := func(name string) int {
+		for i, known := range extensionUninstallOrder {
+			if name == known {
+				return i
+			}
+		}
+		return -1
+	}
+	sort.SliceStable(extensions, func(i, j int) bool {
+		ri, rj := rank(extensions[i].name), rank(extensions[j].name)
+		if ri != rj {
+			return ri < rj
+		}
+		return extensions[i].name < extensions[j].name
+	})
+}
+
+// fetchMeshedWorkloads returns the workloads with injected pods outside of
+// the control plane and extensions namespaces, along with the injected pods
+// which aren't owned by a deployment, statefulset or daemonset, and thus
+// can't be uninjected in place
+func fetchMeshedWorkloads(ctx context.Context, k8sAPI *k8s.KubernetesAPI, extensions []meshExtension) ([]k8s.Workload, []string, error) {
+	skipped := map[string]bool{controlPlaneNamespace: true}
+	for _, extension := range extensions {
+		skipped[extension.namespace] = true
+	}
+
+	podList, err := k8sAPI.CoreV1().Pods("").List(ctx, metav1.ListOptions{LabelSelector: k8s.ControllerNSLabel})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	seen := map[k8s.Workload]bool{}
+	var workloads []k8s.Workload
+	var pods []string
+	for _, pod := range podList.Items {
+		if skipped[pod.Namespace] {
+			continue
+		}
+		workload, err := k8sAPI.GetPodWorkload(ctx, pod)
+		if err != nil {
+			return nil, nil, err
+		}
+		if workload == nil {
+			pods = append(pods, fmt.Sprintf("%s/%s/%s", pod.Namespace, k8s.Pod, pod.Name))
+			continue
+		}
+		if seen[*workload] {
+			continue
+		}
+		seen[*workload] = true
+		workloads = append(workloads, *workload)
+	}
+
+	sort.Slice(workloads, func(i, j int) bool {
+		return workloads[i].String() < workloads[j].String()
+	})
+	sort.Strings(pods)
+	return workloads, pods, nil
+}
+
+// orchestratedUninstall uninjects the meshed workloads, waits for their pods
+// to be replaced, and then uninstalls the extensions and the control plane.
+// The meshed pods which can't be uninjected in place are left meshed with
+// --force.
+func orchestratedUninstall(ctx context.Context, k8sAPI *k8s.KubernetesAPI, extensions []meshExtension, workloads []k8s.Workload, pods []string, options uninstallOptions, w io.Writer) error {
+	// Everything which could prevent the uninstall from completing is checked
+	// before modifying anything
+	if len(pods) > 0 && !options.force {
+		return fmt.Errorf("the following pods can't be uninjected automatically, please uninject them first or use --force to leave them meshed:\n\t* %s", strings.Join(pods, "\n\t* "))
+	}
+	for _, extension := range extensions {
+		if extension.name != multicluster.MulticlusterExtensionName && extension.name != multicluster.MulticlusterLegacyExtension {
+			continue
+		}
+		links, err := mc.GetLinks(ctx, k8sAPI.DynamicClient)
+		if err != nil && !kerrors.IsNotFound(err) {
+			return err
+		}
+		if len(links) > 0 {
+			var names []string
+			for _, link := range links {
+				names = append(names, fmt.Sprintf("* %s", link.TargetClusterName))
+			}
+			return fmt.Errorf("please unlink the following clusters before uninstalling multicluster:\n\t%s", strings.Join(names, "\n\t"))
+		}
+	}
+
+	for _, pod := range pods {
+		fmt.Fprintf(w, "%s %s left meshed\n", warnStatus, pod)
+	}
+	// The injection is disabled rather than just not enabled, as the
+	// namespace may still be annotated for injection
+	disabled := k8s.ProxyInjectDisabled
+	if err := uninjectWorkloads(ctx, k8sAPI, workloads, &disabled, options.wait, w); err != nil {
+		return err
+	}
+
+	for _, extension := range extensions {
+		selector, err := pkgCmd.GetLabelSelector(k8s.LinkerdExtensionLabel, extension.name)
+		if err != nil {
+			return err
+		}
+		if err := pkgCmd.Delete(ctx, k8sAPI, selector, w); err != nil {
+			return fmt.Errorf("failed to uninstall the %s extension: %s", extension.name, err)
+		}
+		if err := waitForNamespaceDeletion(ctx, k8sAPI, extension.namespace, options.wait); err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "%s %s extension uninstalled\n", okStatus, extension.name)
+	}
+
+	selector, err := pkgCmd.GetLabelSelector(k8s.ControllerNSLabel)
+	if err != nil {
+		return err
+	}
+	if err := pkgCmd.Delete(ctx, k8sAPI, selector, w); err != nil {
+		return fmt.Errorf("failed to uninstall the control plane: %s", err)
+	}
+	fmt.Fprintf(w, "%s control plane uninstalled\n", okStatus)
+	return nil
+}
+
+// waitForNamespaceDeletion waits for the namespace, and thus all of its
+// resources, to be deleted
+func waitForNamespaceDeletion(ctx context.Context, k8sAPI *k8s.KubernetesAPI, namespace string, timeout time.Duration) error {
+	err := wait.PollImmediate(2*time.Second, timeout, func() (bool, error) {
+		_, err := k8sAPI.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+		if kerrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	})
+	if err != nil {
+		return fmt.Errorf("namespace %s is not deleted: %s", namespace, err)
+	}
+	return nil
+}
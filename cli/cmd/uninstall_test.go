@@ -0,0 +1,152 @@
+package cmd
+
+import (
+	"context"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSortExtensions(t *testing.T) {
+	extensions := []meshExtension{
+		{name: "viz", namespace: "linkerd-viz"},
+		{name: "jaeger", namespace: "linkerd-jaeger"},
+		{name: "buoyant", namespace: "buoyant-cloud"},
+		{name: "multicluster", namespace: "linkerd-multicluster"},
+	}
+	sortExtensions(extensions)
+
+	var names []string
+	for _, extension := range extensions {
+		names = append(names, extension.name)
+	}
+	expected := []string{"buoyant", "multicluster", "jaeger", "viz"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Fatalf("Expected the extensions to be sorted as %v, got %v", expected, names)
+	}
+}
+
+func uninstallTestAPI(t *testing.T) *k8s.KubernetesAPI {
+	t.Helper()
+
+	k8sAPI, err := k8s.NewFakeAPI(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  namespace: emojivoto
+`, `
+apiVersion: apps/v1
+kind: ReplicaSet
+metadata:
+  name: web-5f86686c4d
+  namespace: emojivoto
+  ownerReferences:
+  - apiVersion: apps/v1
+    kind: Deployment
+    name: web
+    uid: "1"
+    controller: true
+`, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: web-5f86686c4d-abcde
+  namespace: emojivoto
+  labels:
+    linkerd.io/control-plane-ns: linkerd
+  ownerReferences:
+  - apiVersion: apps/v1
+    kind: ReplicaSet
+    name: web-5f86686c4d
+    uid: "2"
+    controller: true
+`, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: web-5f86686c4d-fghij
+  namespace: emojivoto
+  labels:
+    linkerd.io/control-plane-ns: linkerd
+  ownerReferences:
+  - apiVersion: apps/v1
+    kind: ReplicaSet
+    name: web-5f86686c4d
+    uid: "2"
+    controller: true
+`, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: debug
+  namespace: emojivoto
+  labels:
+    linkerd.io/control-plane-ns: linkerd
+`, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: tap-6d9b8b7c9-klmno
+  namespace: linkerd-viz
+  labels:
+    linkerd.io/control-plane-ns: linkerd
+`, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: linkerd-destination-6d9b8b7c9-pqrst
+  namespace: linkerd
+  labels:
+    linkerd.io/control-plane-ns: linkerd
+`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	return k8sAPI
+}
+
+func TestFetchMeshedWorkloads(t *testing.T) {
+	k8sAPI := uninstallTestAPI(t)
+	extensions := []meshExtension{{name: "viz", namespace: "linkerd-viz"}}
+
+	workloads, pods, err := fetchMeshedWorkloads(context.Background(), k8sAPI, extensions)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	expected := []k8s.Workload{
+		{Kind: k8s.Deployment, Namespace: "emojivoto", Name: "web"},
+	}
+	if !reflect.DeepEqual(workloads, expected) {
+		t.Fatalf("Expected workloads %v, got %v", expected, workloads)
+	}
+	expectedPods := []string{"emojivoto/pod/debug"}
+	if !reflect.DeepEqual(pods, expectedPods) {
+		t.Fatalf("Expected pods %v, got %v", expectedPods, pods)
+	}
+}
+
+func TestOrchestratedUninstallRefusesUnsupportedWorkloads(t *testing.T) {
+	k8sAPI := uninstallTestAPI(t)
+	workloads, pods, err := fetchMeshedWorkloads(context.Background(), k8sAPI, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	err = orchestratedUninstall(context.Background(), k8sAPI, nil, workloads, pods, uninstallOptions{}, io.Discard)
+	if err == nil || !strings.Contains(err.Error(), "emojivoto/pod/debug") {
+		t.Fatalf("Expected an error about the pod which can't be uninjected, got %v", err)
+	}
+
+	deploy, err := k8sAPI.AppsV1().Deployments("emojivoto").Get(context.Background(), "web", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if _, ok := deploy.Spec.Template.Annotations[k8s.ProxyInjectAnnotation]; ok {
+		t.Fatal("Expected the deployment not to be modified")
+	}
+}
@@ -35,6 +35,7 @@ const (
 var (
 	manifests string
 	force     bool
+	showDiff  bool
 )
 
 /* The upgrade commands all follow the same flow:
@@ -164,6 +165,9 @@ A full list of configurable values can be found at https://www.github.com/linker
 		Example: `  # Default upgrade.
   linkerd upgrade | kubectl apply --prune -l linkerd.io/control-plane-ns=linkerd -f -
 
+  # Review the changes before upgrading.
+  linkerd upgrade --diff
+
   # Similar to install, upgrade may also be broken up into two stages, by user
   # privilege.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -188,6 +192,7 @@ A full list of configurable values can be found at https://www.github.com/linker
 
 	cmd.AddCommand(newCmdUpgradeConfig(values))
 	cmd.AddCommand(newCmdUpgradeControlPlane(values))
+	cmd.AddCommand(newCmdUpgradeDataPlane())
 
 	return cmd
 }
@@ -230,6 +235,10 @@ func makeUpgradeFlags() *pflag.FlagSet {
 		&force, "force", false,
 		"Force upgrade operation even when issuer certificate does not work with the trust anchors of all proxies",
 	)
+	upgradeFlags.BoolVar(
+		&showDiff, "diff", false,
+		"Instead of outputting the upgrade manifest, show how it differs from the installed resources and stored values",
+	)
 	return upgradeFlags
 }
 
@@ -245,6 +254,24 @@ func upgradeRunE(ctx context.Context, k *k8s.KubernetesAPI, flags []flag.Flag, s
 			fmt.Fprintf(os.Stderr, "\n%s %s\n\n", warnStatus, trustRootChangeMessage)
 		}
 	}
+
+	if showDiff {
+		getLive, err := newDynamicObjectGetter(k)
+		if err != nil {
+			return err
+		}
+		// the manifest of a single stage only holds part of the control
+		// plane, so the resources missing from it aren't deleted
+		var listLive liveObjectLister
+		if stage == "" {
+			listLive, err = newDynamicObjectLister(k, controlPlaneNamespace)
+			if err != nil {
+				return err
+			}
+		}
+		return diffUpgrade(ctx, getLive, listLive, &buf, os.Stdout)
+	}
+
 	if stage == configStage {
 		fmt.Fprintf(os.Stderr, "%s\n\n", controlPlaneMessage)
 	}
@@ -255,29 +282,10 @@ func upgradeRunE(ctx context.Context, k *k8s.KubernetesAPI, flags []flag.Flag, s
 }
 
 func upgrade(ctx context.Context, k *k8s.KubernetesAPI, flags []flag.Flag, stage string, options valuespkg.Options) (bytes.Buffer, error) {
-	values, err := loadStoredValues(ctx, k)
+	values, err := loadUpgradeValues(ctx, k)
 	if err != nil {
 		return bytes.Buffer{}, err
 	}
-	// If there is no linkerd-config-overrides secret, assume we are upgrading
-	// from a version of Linkerd prior to the introduction of this secret.  In
-	// this case we load the values from the legacy linkerd-config configmap.
-	if values == nil {
-		values, err = loadStoredValuesLegacy(ctx, k)
-		if err != nil {
-			return bytes.Buffer{}, err
-		}
-	}
-
-	// If values is still nil, then neither the linkerd-config-overrides secret
-	// nor the legacy values were found. This means either means that Linkerd
-	// was installed with Helm or that the installation needs to be repaired.
-	if values == nil {
-		return bytes.Buffer{}, errors.New(
-			`Could not find the Linkerd config. If Linkerd was installed with Helm, please
-use Helm to perform upgrades. If Linkerd was not installed with Helm, please use
-the 'linkerd repair' command to repair the Linkerd config`)
-	}
 
 	err = flag.ApplySetFlags(values, flags)
 	if err != nil {
@@ -313,6 +321,36 @@ the 'linkerd repair' command to repair the Linkerd config`)
 	return buf, nil
 }
 
+// loadUpgradeValues returns the values of the chart of this version, merged
+// with the values stored when Linkerd was installed or last upgraded
+func loadUpgradeValues(ctx context.Context, k *k8s.KubernetesAPI) (*charts.Values, error) {
+	values, err := loadStoredValues(ctx, k)
+	if err != nil {
+		return nil, err
+	}
+	// If there is no linkerd-config-overrides secret, assume we are upgrading
+	// from a version of Linkerd prior to the introduction of this secret.  In
+	// this case we load the values from the legacy linkerd-config configmap.
+	if values == nil {
+		values, err = loadStoredValuesLegacy(ctx, k)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// If values is still nil, then neither the linkerd-config-overrides secret
+	// nor the legacy values were found. This means either means that Linkerd
+	// was installed with Helm or that the installation needs to be repaired.
+	if values == nil {
+		return nil, errors.New(
+			`Could not find the Linkerd config. If Linkerd was installed with Helm, please
+use Helm to perform upgrades. If Linkerd was not installed with Helm, please use
+the 'linkerd repair' command to repair the Linkerd config`)
+	}
+
+	return values, nil
+}
+
 func loadStoredValues(ctx context.Context, k *k8s.KubernetesAPI) (*charts.Values, error) {
 	// Load the default values from the chart.
 	values, err := charts.NewValues()
@@ -0,0 +1,257 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/healthcheck"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/linkerd/linkerd2/pkg/version"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const rolloutPollInterval = 2 * time.Second
+
+type upgradeDataPlaneOptions struct {
+	namespace      string
+	namespaceOrder []string
+	concurrency    uint
+	timeout        time.Duration
+	dryRun         bool
+}
+
+// staleWorkload is a workload with pods running a proxy older than the
+// control plane
+type staleWorkload struct {
+	k8s.Workload
+	proxyVersion string
+}
+
+func newUpgradeDataPlaneOptions() *upgradeDataPlaneOptions {
+	return &upgradeDataPlaneOptions{
+		concurrency: 1,
+		timeout:     5 * time.Minute,
+	}
+}
+
+// newCmdUpgradeDataPlane is a subcommand for `linkerd upgrade data-plane`
+func newCmdUpgradeDataPlane() *cobra.Command {
+	options := newUpgradeDataPlaneOptions()
+
+	cmd := &cobra.Command{
+		Use:   "data-plane [flags]",
+		Args:  cobra.NoArgs,
+		Short: "Restart the workloads whose proxies lag the control plane version",
+		Long: `Restart the workloads whose proxies lag the control plane version.
+
+This command finds the Deployments, DaemonSets and StatefulSets having pods whose
+proxy is older than the control plane, and restarts them so the proxy injector
+injects the current proxy. Pods whose proxy version is pinned with the
+config.linkerd.io/proxy-version annotation are left alone, and so are proxies
+whose version can't be compared with the control plane version, e.g. when
+switching release channels. Workloads are restarted namespace by
+namespace, in batches of --concurrency workloads, waiting for each batch to be
+ready before restarting the next one. The upgrade stops at the first workload
+that fails to roll out.
+
+It should be run after "linkerd upgrade" has been applied.`,
+		Example: `  # Restart all the workloads with outdated proxies, one at a time.
+  linkerd upgrade data-plane
+
+  # Restart the workloads in the emojivoto namespace, three at a time.
+  linkerd upgrade data-plane -n emojivoto --concurrency 3
+
+  # Upgrade the staging namespace before any other namespace.
+  linkerd upgrade data-plane --namespace-order staging`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if options.concurrency == 0 {
+				return errors.New("--concurrency must be greater than 0")
+			}
+
+			k8sAPI, err := k8s.NewAPI(kubeconfigPath, kubeContext, impersonate, impersonateGroup, 0)
+			if err != nil {
+				return err
+			}
+
+			return upgradeDataPlane(cmd.Context(), k8sAPI, options, os.Stdout)
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.namespace, "namespace", "n", options.namespace, "Namespace of the workloads to upgrade (default: all namespaces)")
+	cmd.Flags().StringSliceVar(&options.namespaceOrder, "namespace-order", options.namespaceOrder, "Namespaces to upgrade first, in the given order; the remaining namespaces are upgraded afterwards in alphabetical order")
+	cmd.Flags().UintVar(&options.concurrency, "concurrency", options.concurrency, "Number of workloads restarted at the same time")
+	cmd.Flags().DurationVar(&options.timeout, "timeout", options.timeout, "Maximum time to wait for each workload to be rolled out")
+	cmd.Flags().BoolVar(&options.dryRun, "dry-run", options.dryRun, "Only list the workloads that would be restarted")
+
+	return cmd
+}
+
+func upgradeDataPlane(ctx context.Context, k8sAPI *k8s.KubernetesAPI, options *upgradeDataPlaneOptions, w io.Writer) error {
+	serverVersion, err := healthcheck.GetServerVersion(ctx, controlPlaneNamespace, k8sAPI)
+	if err != nil {
+		return err
+	}
+
+	workloads, err := getStaleWorkloads(ctx, k8sAPI, options.namespace, serverVersion, w)
+	if err != nil {
+		return err
+	}
+	if len(workloads) == 0 {
+		fmt.Fprintf(w, "No proxy is older than the control plane version %s\n", serverVersion)
+		return nil
+	}
+
+	batches := batchStaleWorkloads(workloads, options.namespaceOrder, options.concurrency)
+	for _, batch := range batches {
+		for _, workload := range batch {
+			fmt.Fprintf(w, "Restarting %s (proxy %s -> %s)\n", workload, workload.proxyVersion, serverVersion)
+		}
+		if options.dryRun {
+			continue
+		}
+
+		if err := restartBatch(ctx, k8sAPI, batch, options.timeout); err != nil {
+			return fmt.Errorf("%s; stopping the data plane upgrade", err)
+		}
+		for _, workload := range batch {
+			fmt.Fprintf(w, "%s %s is ready\n", okStatus, workload)
+		}
+	}
+
+	return nil
+}
+
+// getStaleWorkloads returns the workloads in the given namespace (or all
+// namespaces if empty) having meshed pods whose proxy is older than
+// serverVersion, writing warnings about the pods that can't be upgraded to w.
+// Control plane workloads are upgraded by `linkerd upgrade` and are excluded,
+// and so are pods that pin their proxy version.
+func getStaleWorkloads(ctx context.Context, k8sAPI *k8s.KubernetesAPI, namespace, serverVersion string, w io.Writer) ([]staleWorkload, error) {
+	selector := fmt.Sprintf("%s=%s", k8s.ControllerNSLabel, controlPlaneNamespace)
+	podList, err := k8sAPI.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[k8s.Workload]bool{}
+	var workloads []staleWorkload
+	for _, pod := range podList.Items {
+		if pod.Namespace == controlPlaneNamespace {
+			continue
+		}
+		if pinned, ok := pod.Annotations[k8s.ProxyVersionOverrideAnnotation]; ok {
+			fmt.Fprintf(w, "%s pod %s/%s pins proxy version %s with the %s annotation, skipping it\n", warnStatus, pod.Namespace, pod.Name, pinned, k8s.ProxyVersionOverrideAnnotation)
+			continue
+		}
+		proxyVersion := pod.Annotations[k8s.ProxyVersionAnnotation]
+		if proxyVersion == "" {
+			proxyVersion = k8s.GetProxyVersion(pod)
+		}
+		cmp, err := version.CompareChannelVersions(proxyVersion, serverVersion)
+		if err != nil {
+			if proxyVersion != serverVersion {
+				fmt.Fprintf(w, "%s pod %s/%s runs proxy %s which can't be compared with the control plane version, skipping it: %s\n", warnStatus, pod.Namespace, pod.Name, proxyVersion, err)
+			}
+			continue
+		}
+		if cmp >= 0 {
+			continue
+		}
+
+		workload, err := k8sAPI.GetPodWorkload(ctx, pod)
+		if err != nil {
+			return nil, err
+		}
+		if workload == nil {
+			fmt.Fprintf(w, "%s pod %s/%s runs proxy %s but isn't owned by a workload that can be restarted\n", warnStatus, pod.Namespace, pod.Name, proxyVersion)
+			continue
+		}
+		if seen[*workload] {
+			continue
+		}
+		seen[*workload] = true
+		workloads = append(workloads, staleWorkload{*workload, proxyVersion})
+	}
+
+	return workloads, nil
+}
+
+// batchStaleWorkloads splits the workloads into batches of at most
+// concurrency workloads, never mixing namespaces in a batch. The namespaces
+// in namespaceOrder come first, in that order, followed by the remaining
+// namespaces sorted alphabetically.
+func batchStaleWorkloads(workloads []staleWorkload, namespaceOrder []string, concurrency uint) [][]staleWorkload {
+	byNamespace := map[string][]staleWorkload{}
+	for _, workload := range workloads {
+		byNamespace[workload.Namespace] = append(byNamespace[workload.Namespace], workload)
+	}
+
+	var namespaces []string
+	ordered := map[string]bool{}
+	for _, ns := range namespaceOrder {
+		if _, ok := byNamespace[ns]; ok && !ordered[ns] {
+			namespaces = append(namespaces, ns)
+			ordered[ns] = true
+		}
+	}
+	var rest []string
+	for ns := range byNamespace {
+		if !ordered[ns] {
+			rest = append(rest, ns)
+		}
+	}
+	sort.Strings(rest)
+	namespaces = append(namespaces, rest...)
+
+	var batches [][]staleWorkload
+	for _, ns := range namespaces {
+		nsWorkloads := byNamespace[ns]
+		sort.Slice(nsWorkloads, func(i, j int) bool {
+			return nsWorkloads[i].String() < nsWorkloads[j].String()
+		})
+		for len(nsWorkloads) > 0 {
+			size := int(concurrency)
+			if size > len(nsWorkloads) {
+				size = len(nsWorkloads)
+			}
+			batches = append(batches, nsWorkloads[:size])
+			nsWorkloads = nsWorkloads[size:]
+		}
+	}
+	return batches
+}
+
+// restartBatch restarts all the workloads in the batch and waits for all of
+// them to be rolled out, returning the first error encountered
+func restartBatch(ctx context.Context, k8sAPI *k8s.KubernetesAPI, batch []staleWorkload, timeout time.Duration) error {
+	var wg sync.WaitGroup
+	errs := make(chan error, len(batch))
+	for _, workload := range batch {
+		workload := workload // pin
+		if err := k8sAPI.RestartWorkload(ctx, workload.Workload); err != nil {
+			errs <- fmt.Errorf("failed to restart %s: %s", workload, err)
+			break
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			waitCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			if err := k8sAPI.WaitForRollout(waitCtx, workload.Workload, rolloutPollInterval); err != nil {
+				errs <- err
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	return <-errs
+}
@@ -0,0 +1,208 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/linkerd/linkerd2/pkg/k8s"
+)
+
+func TestGetStaleWorkloads(t *testing.T) {
+	k8sConfigs := []string{`
+apiVersion: apps/v1
+kind: ReplicaSet
+metadata:
+  name: web-5f86686c4d
+  namespace: emojivoto
+  ownerReferences:
+  - apiVersion: apps/v1
+    kind: Deployment
+    name: web
+    controller: true
+`, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: web-5f86686c4d-58nkl
+  namespace: emojivoto
+  labels:
+    linkerd.io/control-plane-ns: linkerd
+  annotations:
+    linkerd.io/proxy-version: stable-2.10.0
+  ownerReferences:
+  - apiVersion: apps/v1
+    kind: ReplicaSet
+    name: web-5f86686c4d
+    controller: true
+`, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: web-5f86686c4d-x9zfd
+  namespace: emojivoto
+  labels:
+    linkerd.io/control-plane-ns: linkerd
+  annotations:
+    linkerd.io/proxy-version: stable-2.10.0
+  ownerReferences:
+  - apiVersion: apps/v1
+    kind: ReplicaSet
+    name: web-5f86686c4d
+    controller: true
+`, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: vote-bot-0
+  namespace: emojivoto
+  labels:
+    linkerd.io/control-plane-ns: linkerd
+  annotations:
+    linkerd.io/proxy-version: stable-2.10.2
+  ownerReferences:
+  - apiVersion: apps/v1
+    kind: StatefulSet
+    name: vote-bot
+    controller: true
+`, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: emoji-0
+  namespace: emojivoto
+  labels:
+    linkerd.io/control-plane-ns: linkerd
+  annotations:
+    linkerd.io/proxy-version: stable-2.11.0
+  ownerReferences:
+  - apiVersion: apps/v1
+    kind: StatefulSet
+    name: emoji
+    controller: true
+`, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: voting-0
+  namespace: emojivoto
+  labels:
+    linkerd.io/control-plane-ns: linkerd
+  annotations:
+    linkerd.io/proxy-version: stable-2.9.1
+    config.linkerd.io/proxy-version: stable-2.9.1
+  ownerReferences:
+  - apiVersion: apps/v1
+    kind: StatefulSet
+    name: voting
+    controller: true
+`, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: books-0
+  namespace: books
+  labels:
+    linkerd.io/control-plane-ns: linkerd
+  annotations:
+    linkerd.io/proxy-version: dev-0123abcd-jane
+  ownerReferences:
+  - apiVersion: apps/v1
+    kind: StatefulSet
+    name: books
+    controller: true
+`, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: linkerd-destination-0
+  namespace: linkerd
+  labels:
+    linkerd.io/control-plane-ns: linkerd
+  annotations:
+    linkerd.io/proxy-version: stable-2.10.0
+  ownerReferences:
+  - apiVersion: apps/v1
+    kind: StatefulSet
+    name: linkerd-destination
+    controller: true
+`,
+	}
+
+	k8sAPI, err := k8s.NewFakeAPI(k8sConfigs...)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	var warnings bytes.Buffer
+	workloads, err := getStaleWorkloads(context.Background(), k8sAPI, "", "stable-2.10.2", &warnings)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := []staleWorkload{
+		{k8s.Workload{Kind: k8s.Deployment, Namespace: "emojivoto", Name: "web"}, "stable-2.10.0"},
+	}
+	if !reflect.DeepEqual(workloads, expected) {
+		t.Fatalf("Expected workloads %v, got %v", expected, workloads)
+	}
+
+	for _, warning := range []string{
+		"pod books/books-0 runs proxy dev-0123abcd-jane which can't be compared with the control plane version",
+		"pod emojivoto/voting-0 pins proxy version stable-2.9.1 with the config.linkerd.io/proxy-version annotation",
+	} {
+		if !strings.Contains(warnings.String(), warning) {
+			t.Errorf("Expected warning %q, got:\n%s", warning, warnings.String())
+		}
+	}
+}
+
+func TestBatchStaleWorkloads(t *testing.T) {
+	workload := func(ns, name string) staleWorkload {
+		return staleWorkload{k8s.Workload{Kind: k8s.Deployment, Namespace: ns, Name: name}, "stable-2.10.0"}
+	}
+	workloads := []staleWorkload{
+		workload("emojivoto", "web"),
+		workload("books", "webapp"),
+		workload("emojivoto", "emoji"),
+		workload("staging", "web"),
+		workload("emojivoto", "voting"),
+	}
+
+	testCases := []struct {
+		namespaceOrder []string
+		concurrency    uint
+		expected       [][]staleWorkload
+	}{
+		{
+			concurrency: 1,
+			expected: [][]staleWorkload{
+				{workload("books", "webapp")},
+				{workload("emojivoto", "emoji")},
+				{workload("emojivoto", "voting")},
+				{workload("emojivoto", "web")},
+				{workload("staging", "web")},
+			},
+		},
+		{
+			namespaceOrder: []string{"staging", "missing", "emojivoto"},
+			concurrency:    2,
+			expected: [][]staleWorkload{
+				{workload("staging", "web")},
+				{workload("emojivoto", "emoji"), workload("emojivoto", "voting")},
+				{workload("emojivoto", "web")},
+				{workload("books", "webapp")},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		batches := batchStaleWorkloads(workloads, tc.namespaceOrder, tc.concurrency)
+		if !reflect.DeepEqual(batches, tc.expected) {
+			t.Fatalf("Expected batches %v, got %v", tc.expected, batches)
+		}
+	}
+}
@@ -0,0 +1,623 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	yamlDecoder "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	configOverridesSecretName = "linkerd-config-overrides"
+	lastAppliedAnnotation     = "kubectl.kubernetes.io/last-applied-configuration"
+)
+
+type changeType string
+
+const (
+	fieldAdded   changeType = "+"
+	fieldRemoved changeType = "-"
+	fieldChanged changeType = "~"
+)
+
+// fieldChange is a change of a single field of a resource or of the values
+type fieldChange struct {
+	change   changeType
+	path     string
+	oldValue interface{}
+	newValue interface{}
+}
+
+// resourceDiff holds the changes the upgrade makes to a single resource
+type resourceDiff struct {
+	kind      string
+	namespace string
+	name      string
+	created   bool
+	deleted   bool
+	changes   []fieldChange
+	// hidden counts the changes which aren't shown because they're expected
+	// to change on every upgrade, like generated certificates
+	hidden int
+	// sensitive resources get their changed values redacted
+	sensitive bool
+}
+
+// liveObjectGetter returns the live version of the given object, or nil if it
+// doesn't exist
+type liveObjectGetter func(ctx context.Context, obj *unstructured.Unstructured) (*unstructured.Unstructured, error)
+
+// liveObjectLister returns the live objects of the given kind which are part
+// of the control plane, i.e. labelled with its namespace
+type liveObjectLister func(ctx context.Context, kind controlPlaneKind) ([]*unstructured.Unstructured, error)
+
+// controlPlaneKind is a kind of resource the control plane is made of
+type controlPlaneKind struct {
+	schema.GroupVersionKind
+	namespaced bool
+}
+
+// controlPlaneKinds are the kinds of the resources of the control plane
+// manifest, which are listed to find the live resources the upgrade removes
+var controlPlaneKinds = []controlPlaneKind{
+	{schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, true},
+	{schema.GroupVersionKind{Version: "v1", Kind: "Secret"}, true},
+	{schema.GroupVersionKind{Version: "v1", Kind: "Service"}, true},
+	{schema.GroupVersionKind{Version: "v1", Kind: "ServiceAccount"}, true},
+	{schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, true},
+	{schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "DaemonSet"}, true},
+	{schema.GroupVersionKind{Group: "batch", Version: "v1beta1", Kind: "CronJob"}, true},
+	{schema.GroupVersionKind{Group: "policy", Version: "v1beta1", Kind: "PodDisruptionBudget"}, true},
+	{schema.GroupVersionKind{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "Role"}, true},
+	{schema.GroupVersionKind{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "RoleBinding"}, true},
+	{schema.GroupVersionKind{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRole"}, false},
+	{schema.GroupVersionKind{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRoleBinding"}, false},
+	{schema.GroupVersionKind{Group: "policy", Version: "v1beta1", Kind: "PodSecurityPolicy"}, false},
+	{schema.GroupVersionKind{Group: "admissionregistration.k8s.io", Version: "v1", Kind: "MutatingWebhookConfiguration"}, false},
+	{schema.GroupVersionKind{Group: "admissionregistration.k8s.io", Version: "v1", Kind: "ValidatingWebhookConfiguration"}, false},
+	{schema.GroupVersionKind{Group: "apiregistration.k8s.io", Version: "v1", Kind: "APIService"}, false},
+}
+
+// noisyFields are the fields which change on every upgrade without any change
+// in the configuration, because they hold generated certificates, checksums
+// of those or timestamps, keyed by the kind of the resources they're in
+var noisyFields = map[string][]string{
+	"Secret": {
+		"data.tls.crt", "data.tls.key", "data.ca.crt",
+	},
+	"MutatingWebhookConfiguration": {
+		"webhooks[*].clientConfig.caBundle",
+	},
+	"ValidatingWebhookConfiguration": {
+		"webhooks[*].clientConfig.caBundle",
+	},
+	"APIService": {
+		"spec.caBundle",
+	},
+	"Deployment": {
+		"spec.template.metadata.annotations.checksum/config",
+	},
+	"CronJob": {
+		"spec.schedule",
+	},
+}
+
+// ignoredMetadata are the metadata fields managed by the API server, which are
+// never part of the rendered manifests
+var ignoredMetadata = []string{
+	"creationTimestamp", "generation", "managedFields", "resourceVersion", "selfLink", "uid",
+}
+
+// newDynamicObjectGetter returns a liveObjectGetter fetching the objects
+// through the dynamic client of k
+func newDynamicObjectGetter(k *k8s.KubernetesAPI) (liveObjectGetter, error) {
+	if k.DynamicClient == nil {
+		return nil, errors.New("--diff requires access to the cluster and can't be used with --from-manifests")
+	}
+	return func(ctx context.Context, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+		resource, _ := meta.UnsafeGuessKindToResource(obj.GroupVersionKind())
+		live, err := k.DynamicClient.Resource(resource).Namespace(obj.GetNamespace()).Get(ctx, obj.GetName(), metav1.GetOptions{})
+		if kerrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return live, err
+	}, nil
+}
+
+// newDynamicObjectLister returns a liveObjectLister listing the objects of the
+// control plane installed in namespace through the dynamic client of k
+func newDynamicObjectLister(k *k8s.KubernetesAPI, namespace string) (liveObjectLister, error) {
+	if k.DynamicClient == nil {
+		return nil, errors.New("--diff requires access to the cluster and can't be used with --from-manifests")
+	}
+	return func(ctx context.Context, kind controlPlaneKind) ([]*unstructured.Unstructured, error) {
+		resource, _ := meta.UnsafeGuessKindToResource(kind.GroupVersionKind)
+		ns := ""
+		if kind.namespaced {
+			ns = namespace
+		}
+		options := metav1.ListOptions{LabelSelector: fmt.Sprintf("%s=%s", k8s.ControllerNSLabel, namespace)}
+		list, err := k.DynamicClient.Resource(resource).Namespace(ns).List(ctx, options)
+		if kerrors.IsNotFound(err) {
+			// the kind isn't served by the cluster
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		objs := make([]*unstructured.Unstructured, len(list.Items))
+		for i := range list.Items {
+			objs[i] = &list.Items[i]
+		}
+		return objs, nil
+	}, nil
+}
+
+// diffUpgrade compares the resources of the upgrade manifest with their live
+// version and writes the changes, grouped by resource, to w. The values
+// stored in the linkerd-config-overrides secret are compared as values rather
+// than as secret data. When listLive is set, the live resources of the
+// control plane missing from the manifest are reported as deleted, as they're
+// pruned by `kubectl apply --prune`.
+func diffUpgrade(ctx context.Context, getLive liveObjectGetter, listLive liveObjectLister, manifest io.Reader, w io.Writer) error {
+	objs, err := readManifestObjects(manifest)
+	if err != nil {
+		return err
+	}
+
+	var diffs []*resourceDiff
+	unchanged := 0
+	for _, obj := range objs {
+		live, err := getLive(ctx, obj)
+		if err != nil {
+			return fmt.Errorf("failed to get %s/%s: %s", obj.GetKind(), obj.GetName(), err)
+		}
+		diff, err := diffObject(obj, live)
+		if err != nil {
+			return err
+		}
+		if !diff.created && len(diff.changes) == 0 {
+			unchanged++
+			continue
+		}
+		diffs = append(diffs, diff)
+	}
+
+	if listLive != nil {
+		deleted, err := deletedObjects(ctx, listLive, objs)
+		if err != nil {
+			return err
+		}
+		diffs = append(diffs, deleted...)
+	}
+
+	created, changed, deleted := 0, 0, 0
+	for _, diff := range diffs {
+		writeResourceDiff(w, diff)
+		switch {
+		case diff.created:
+			created++
+		case diff.deleted:
+			deleted++
+		default:
+			changed++
+		}
+	}
+	fmt.Fprintf(w, "%d resources to create, %d to change, %d to delete, %d unchanged\n", created, changed, deleted, unchanged)
+	return nil
+}
+
+// deletedObjects returns the live resources of the control plane which aren't
+// part of the manifest objs anymore. The resources owned by another one, like
+// the pods of the control plane, are left out, as they're not applied.
+func deletedObjects(ctx context.Context, listLive liveObjectLister, objs []*unstructured.Unstructured) ([]*resourceDiff, error) {
+	inManifest := map[string]bool{}
+	for _, obj := range objs {
+		inManifest[objectKey(obj)] = true
+	}
+
+	var deleted []*resourceDiff
+	for _, kind := range controlPlaneKinds {
+		live, err := listLive(ctx, kind)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s: %s", kind.Kind, err)
+		}
+		var diffs []*resourceDiff
+		for _, obj := range live {
+			if len(obj.GetOwnerReferences()) > 0 || inManifest[objectKey(obj)] {
+				continue
+			}
+			diffs = append(diffs, &resourceDiff{
+				kind:      obj.GetKind(),
+				namespace: obj.GetNamespace(),
+				name:      obj.GetName(),
+				deleted:   true,
+			})
+		}
+		sort.Slice(diffs, func(i, j int) bool {
+			if diffs[i].namespace != diffs[j].namespace {
+				return diffs[i].namespace < diffs[j].namespace
+			}
+			return diffs[i].name < diffs[j].name
+		})
+		deleted = append(deleted, diffs...)
+	}
+	return deleted, nil
+}
+
+// objectKey identifies an object by its kind, namespace and name; the API
+// version is left out as the live objects may be served in another version
+// than the one of the manifest
+func objectKey(obj *unstructured.Unstructured) string {
+	return fmt.Sprintf("%s/%s/%s/%s", obj.GroupVersionKind().Group, obj.GetKind(), obj.GetNamespace(), obj.GetName())
+}
+
+// diffObject returns the changes applying obj would make to its live version
+func diffObject(obj, live *unstructured.Unstructured) (*resourceDiff, error) {
+	diff := &resourceDiff{
+		kind:      obj.GetKind(),
+		namespace: obj.GetNamespace(),
+		name:      obj.GetName(),
+		sensitive: obj.GetKind() == "Secret",
+	}
+	if live == nil {
+		diff.created = true
+		return diff, nil
+	}
+
+	desired, err := normalize(obj.Object)
+	if err != nil {
+		return nil, err
+	}
+	current, err := normalize(live.Object)
+	if err != nil {
+		return nil, err
+	}
+	removeIgnoredFields(current)
+
+	if obj.GetKind() == "Secret" && obj.GetName() == configOverridesSecretName {
+		return diffConfigOverrides(diff, obj, live)
+	}
+
+	// the values of the linkerd-config ConfigMap are diffed key by key
+	// rather than as a single string
+	desiredValues := popConfigValues(desired)
+	currentValues := popConfigValues(current)
+
+	var changes []fieldChange
+	changes = append(changes, diffFields("", current, desired)...)
+	changes = append(changes, removedFields(live, desired)...)
+	valuesChanges, err := diffConfigValues(currentValues, desiredValues)
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff the values of ConfigMap/%s: %s", diff.name, err)
+	}
+	changes = append(changes, valuesChanges...)
+	sort.Slice(changes, func(i, j int) bool { return changes[i].path < changes[j].path })
+
+	for _, change := range changes {
+		if isNoisyField(diff.kind, change.path) {
+			diff.hidden++
+			continue
+		}
+		diff.changes = append(diff.changes, change)
+	}
+	return diff, nil
+}
+
+// diffConfigOverrides compares the values stored in the live
+// linkerd-config-overrides secret with the ones in the upgraded secret
+func diffConfigOverrides(diff *resourceDiff, obj, live *unstructured.Unstructured) (*resourceDiff, error) {
+	var desiredSecret, liveSecret corev1.Secret
+	if err := fromUnstructured(obj, &desiredSecret); err != nil {
+		return nil, err
+	}
+	if err := fromUnstructured(live, &liveSecret); err != nil {
+		return nil, err
+	}
+
+	var desired, current map[string]interface{}
+	if err := yaml.Unmarshal(desiredSecret.Data[configOverridesSecretName], &desired); err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(liveSecret.Data[configOverridesSecretName], &current); err != nil {
+		return nil, err
+	}
+
+	diff.changes = diffValues("", current, desired)
+	sort.Slice(diff.changes, func(i, j int) bool { return diff.changes[i].path < diff.changes[j].path })
+	// only the values holding private keys are redacted
+	diff.sensitive = false
+	return diff, nil
+}
+
+// popConfigValues removes the values from the data of the linkerd-config
+// ConfigMap obj and returns them, or returns an empty string if obj is another
+// object
+func popConfigValues(obj map[string]interface{}) string {
+	if obj["kind"] != "ConfigMap" {
+		return ""
+	}
+	metadata, ok := obj["metadata"].(map[string]interface{})
+	if !ok || metadata["name"] != k8s.ConfigConfigMapName {
+		return ""
+	}
+	data, ok := obj["data"].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	values, _ := data["values"].(string)
+	delete(data, "values")
+	return values
+}
+
+// diffConfigValues compares the values YAML documents of the live and the
+// upgraded linkerd-config ConfigMaps
+func diffConfigValues(current, desired string) ([]fieldChange, error) {
+	var currentValues, desiredValues map[string]interface{}
+	if err := yaml.Unmarshal([]byte(current), &currentValues); err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal([]byte(desired), &desiredValues); err != nil {
+		return nil, err
+	}
+	return diffValues("data.values", currentValues, desiredValues), nil
+}
+
+// diffValues returns the changes between two sets of values. As the values
+// are replaced as a whole, the ones missing from desired are reported as
+// removed.
+func diffValues(path string, current, desired map[string]interface{}) []fieldChange {
+	changes := diffFields(path, current, desired)
+	for _, change := range diffFields(path, desired, current) {
+		if change.change == fieldAdded {
+			changes = append(changes, fieldChange{change: fieldRemoved, path: change.path, oldValue: change.newValue})
+		}
+	}
+	return changes
+}
+
+// diffFields returns the changes to the fields set in desired, compared to
+// current. Fields which aren't set in desired are left as they are by
+// kubectl apply, so they're not reported.
+func diffFields(path string, current, desired interface{}) []fieldChange {
+	switch d := desired.(type) {
+	case map[string]interface{}:
+		c, ok := current.(map[string]interface{})
+		if !ok {
+			if current == nil && len(d) == 0 {
+				return nil
+			}
+			if current == nil {
+				return []fieldChange{{change: fieldAdded, path: path, newValue: desired}}
+			}
+			return []fieldChange{{change: fieldChanged, path: path, oldValue: current, newValue: desired}}
+		}
+		var changes []fieldChange
+		for key, value := range d {
+			changes = append(changes, diffFields(joinFieldPath(path, key), c[key], value)...)
+		}
+		return changes
+	case []interface{}:
+		c, ok := current.([]interface{})
+		if !ok {
+			if current == nil && len(d) == 0 {
+				return nil
+			}
+			if current == nil {
+				return []fieldChange{{change: fieldAdded, path: path, newValue: desired}}
+			}
+			return []fieldChange{{change: fieldChanged, path: path, oldValue: current, newValue: desired}}
+		}
+		var changes []fieldChange
+		for i, value := range d {
+			itemPath := fmt.Sprintf("%s[%d]", path, i)
+			if i >= len(c) {
+				changes = append(changes, fieldChange{change: fieldAdded, path: itemPath, newValue: value})
+				continue
+			}
+			changes = append(changes, diffFields(itemPath, c[i], value)...)
+		}
+		for i := len(d); i < len(c); i++ {
+			changes = append(changes, fieldChange{change: fieldRemoved, path: fmt.Sprintf("%s[%d]", path, i), oldValue: c[i]})
+		}
+		return changes
+	case nil:
+		return nil
+	default:
+		if current == nil {
+			return []fieldChange{{change: fieldAdded, path: path, newValue: desired}}
+		}
+		if fmt.Sprint(current) != fmt.Sprint(desired) {
+			return []fieldChange{{change: fieldChanged, path: path, oldValue: current, newValue: desired}}
+		}
+		return nil
+	}
+}
+
+// removedFields returns the fields which were set by the last `kubectl apply`
+// of the live object but aren't set anymore in desired, and are thus removed
+// by applying the upgrade
+func removedFields(live *unstructured.Unstructured, desired map[string]interface{}) []fieldChange {
+	lastApplied, ok := live.GetAnnotations()[lastAppliedAnnotation]
+	if !ok {
+		return nil
+	}
+	var applied map[string]interface{}
+	if err := json.Unmarshal([]byte(lastApplied), &applied); err != nil {
+		return nil
+	}
+
+	popConfigValues(applied)
+
+	var changes []fieldChange
+	for _, change := range diffFields("", desired, applied) {
+		if change.change == fieldAdded {
+			changes = append(changes, fieldChange{change: fieldRemoved, path: change.path, oldValue: change.newValue})
+		}
+	}
+	return changes
+}
+
+func writeResourceDiff(w io.Writer, diff *resourceDiff) {
+	name := diff.name
+	if diff.namespace != "" {
+		name = fmt.Sprintf("%s/%s", diff.namespace, diff.name)
+	}
+	if diff.created {
+		fmt.Fprintf(w, "+ %s %s (created)\n\n", diff.kind, name)
+		return
+	}
+	if diff.deleted {
+		fmt.Fprintf(w, "- %s %s (deleted)\n\n", diff.kind, name)
+		return
+	}
+
+	fmt.Fprintf(w, "~ %s %s\n", diff.kind, name)
+	for _, change := range diff.changes {
+		redact := diff.sensitive || strings.HasSuffix(change.path, "keyPEM")
+		switch change.change {
+		case fieldAdded:
+			fmt.Fprintf(w, "    + %s: %s\n", change.path, formatValue(change.newValue, redact))
+		case fieldRemoved:
+			fmt.Fprintf(w, "    - %s: %s\n", change.path, formatValue(change.oldValue, redact))
+		case fieldChanged:
+			fmt.Fprintf(w, "    ~ %s: %s -> %s\n", change.path, formatValue(change.oldValue, redact), formatValue(change.newValue, redact))
+		}
+	}
+	if diff.hidden > 0 {
+		fmt.Fprintf(w, "    (%d changes to generated certificates, checksums and schedules hidden)\n", diff.hidden)
+	}
+	fmt.Fprintln(w)
+}
+
+func formatValue(value interface{}, redact bool) string {
+	if redact {
+		return "(redacted)"
+	}
+	switch v := value.(type) {
+	case map[string]interface{}, []interface{}:
+		out, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(out)
+	case string:
+		if strings.Contains(v, "\n") {
+			return fmt.Sprintf("%q", v)
+		}
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// isNoisyField returns true if the field at the given path of a resource of
+// the given kind is one of the noisyFields
+func isNoisyField(kind, path string) bool {
+	for _, pattern := range noisyFields[kind] {
+		if matchFieldPath(pattern, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchFieldPath matches a field path against a pattern where [*] matches any
+// list index
+func matchFieldPath(pattern, path string) bool {
+	for {
+		i := strings.Index(pattern, "[*]")
+		if i < 0 {
+			return pattern == path
+		}
+		if !strings.HasPrefix(path, pattern[:i+1]) {
+			return false
+		}
+		end := strings.Index(path[i:], "]")
+		if end < 0 {
+			return false
+		}
+		pattern = pattern[i+3:]
+		path = path[i+end+1:]
+	}
+}
+
+func removeIgnoredFields(obj map[string]interface{}) {
+	delete(obj, "status")
+	metadata, ok := obj["metadata"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	for _, field := range ignoredMetadata {
+		delete(metadata, field)
+	}
+	if annotations, ok := metadata["annotations"].(map[string]interface{}); ok {
+		delete(annotations, lastAppliedAnnotation)
+	}
+}
+
+func joinFieldPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return fmt.Sprintf("%s.%s", path, key)
+}
+
+// normalize round-trips obj through JSON, so that the numbers of the objects
+// read from YAML and from the API server have the same type
+func normalize(obj map[string]interface{}) (map[string]interface{}, error) {
+	bytes, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var normalized map[string]interface{}
+	err = json.Unmarshal(bytes, &normalized)
+	return normalized, err
+}
+
+func fromUnstructured(obj *unstructured.Unstructured, into interface{}) error {
+	bytes, err := json.Marshal(obj.Object)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bytes, into)
+}
+
+// readManifestObjects reads all the objects of a YAML manifest stream
+func readManifestObjects(manifest io.Reader) ([]*unstructured.Unstructured, error) {
+	var objs []*unstructured.Unstructured
+	yamlReader := yamlDecoder.NewYAMLReader(bufio.NewReader(manifest))
+	for {
+		objYAML, err := yamlReader.Read()
+		if err == io.EOF {
+			return objs, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error reading upgrade manifest: %v", err)
+		}
+
+		objMap := map[string]interface{}{}
+		if err := yaml.Unmarshal(objYAML, &objMap); err != nil {
+			return nil, fmt.Errorf("error unmarshaling yaml object %s: %v", objYAML, err)
+		}
+		if len(objMap) == 0 {
+			// Ignore header blocks with only comments
+			continue
+		}
+		objs = append(objs, &unstructured.Unstructured{Object: objMap})
+	}
+}
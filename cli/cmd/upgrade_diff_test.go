@@ -0,0 +1,252 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const liveObjects = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: linkerd-destination
+  namespace: linkerd
+  uid: 9e2a7a4d-0b2b-4d5e-8f6a-123456789abc
+  resourceVersion: "1234"
+  annotations:
+    linkerd.io/created-by: linkerd/cli stable-2.10.2
+    kubectl.kubernetes.io/last-applied-configuration: '{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"linkerd-destination","namespace":"linkerd","annotations":{"linkerd.io/created-by":"linkerd/cli stable-2.10.2","linkerd.io/removed":"true"}}}'
+spec:
+  replicas: 1
+  progressDeadlineSeconds: 600
+  template:
+    metadata:
+      annotations:
+        checksum/config: abc
+    spec:
+      containers:
+      - name: destination
+        image: cr.l5d.io/linkerd/controller:stable-2.10.2
+        args:
+        - -log-level=info
+        - -enable-endpoint-slices=false
+        terminationMessagePath: /dev/termination-log
+status:
+  replicas: 1
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: linkerd-proxy-injector-k8s-tls
+  namespace: linkerd
+type: kubernetes.io/tls
+data:
+  tls.crt: b2xkLWNydA==
+  tls.key: b2xkLWtleQ==
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: linkerd-dst
+  namespace: linkerd
+spec:
+  clusterIP: 10.0.0.10
+  ports:
+  - name: grpc
+    port: 8086
+    protocol: TCP
+    targetPort: 8086
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: linkerd-config-overrides
+  namespace: linkerd
+data:
+  linkerd-config-overrides: Y29udHJvbGxlckxvZ0xldmVsOiBkZWJ1Zwpwcm94eToKICBsb2dMZXZlbDogZGVidWcKICBpbWFnZToKICAgIHZlcnNpb246IHN0YWJsZS0yLjEwLjIK
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: linkerd-config
+  namespace: linkerd
+data:
+  values: |
+    clusterDomain: cluster.local
+    controllerLogLevel: info
+    proxy:
+      logLevel: warn,linkerd=info
+      image:
+        version: stable-2.10.2
+    enablePodAntiAffinity: false
+`
+
+const upgradeManifest = `
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: linkerd-destination
+  namespace: linkerd
+  annotations:
+    linkerd.io/created-by: linkerd/cli stable-2.11.0
+spec:
+  replicas: 1
+  template:
+    metadata:
+      annotations:
+        checksum/config: def
+    spec:
+      containers:
+      - name: destination
+        image: cr.l5d.io/linkerd/controller:stable-2.11.0
+        args:
+        - -log-level=info
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: linkerd-proxy-injector-k8s-tls
+  namespace: linkerd
+type: kubernetes.io/tls
+data:
+  tls.crt: bmV3LWNydA==
+  tls.key: bmV3LWtleQ==
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: linkerd-dst
+  namespace: linkerd
+spec:
+  ports:
+  - name: grpc
+    port: 8086
+    targetPort: 8086
+---
+apiVersion: policy/v1beta1
+kind: PodDisruptionBudget
+metadata:
+  name: linkerd-destination
+  namespace: linkerd
+spec:
+  maxUnavailable: 1
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: linkerd-config-overrides
+  namespace: linkerd
+data:
+  linkerd-config-overrides: Y29udHJvbGxlckxvZ0xldmVsOiBpbmZvCnByb3h5OgogIGxvZ0xldmVsOiBkZWJ1ZwpjbHVzdGVyRG9tYWluOiBleGFtcGxlLmNvbQo=
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: linkerd-config
+  namespace: linkerd
+data:
+  values: |
+    clusterDomain: cluster.local
+    controllerLogLevel: info
+    proxy:
+      logLevel: warn,linkerd=info
+      image:
+        version: stable-2.11.0
+    policyController:
+      logLevel: info
+`
+
+func TestDiffUpgrade(t *testing.T) {
+	live, err := readManifestObjects(strings.NewReader(liveObjects))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	getLive := func(_ context.Context, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+		for _, l := range live {
+			if l.GetKind() == obj.GetKind() && l.GetNamespace() == obj.GetNamespace() && l.GetName() == obj.GetName() {
+				return l, nil
+			}
+		}
+		return nil, nil
+	}
+
+	// the live objects of the control plane, as listed by label, which
+	// include a service account removed from the manifest
+	labelled, err := readManifestObjects(strings.NewReader(`
+apiVersion: v1
+kind: Service
+metadata:
+  name: linkerd-dst
+  namespace: linkerd
+  labels:
+    linkerd.io/control-plane-ns: linkerd
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: linkerd-sp-validator
+  namespace: linkerd
+  labels:
+    linkerd.io/control-plane-ns: linkerd
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: linkerd-destination-token-x7zqd
+  namespace: linkerd
+  labels:
+    linkerd.io/control-plane-ns: linkerd
+  ownerReferences:
+  - apiVersion: v1
+    kind: ServiceAccount
+    name: linkerd-destination
+`))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	listLive := func(_ context.Context, kind controlPlaneKind) ([]*unstructured.Unstructured, error) {
+		var objs []*unstructured.Unstructured
+		for _, l := range labelled {
+			if l.GroupVersionKind() == kind.GroupVersionKind {
+				objs = append(objs, l)
+			}
+		}
+		return objs, nil
+	}
+
+	var buf bytes.Buffer
+	err = diffUpgrade(context.Background(), getLive, listLive, strings.NewReader(upgradeManifest), &buf)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	testDataDiffer.DiffTestdata(t, "upgrade_diff.golden", buf.String())
+}
+
+func TestMatchFieldPath(t *testing.T) {
+	testCases := []struct {
+		pattern  string
+		path     string
+		expected bool
+	}{
+		{"spec.caBundle", "spec.caBundle", true},
+		{"spec.caBundle", "spec.caBundles", false},
+		{"webhooks[*].clientConfig.caBundle", "webhooks[0].clientConfig.caBundle", true},
+		{"webhooks[*].clientConfig.caBundle", "webhooks[12].clientConfig.caBundle", true},
+		{"webhooks[*].clientConfig.caBundle", "webhooks[0].clientConfig.url", false},
+		{"webhooks[*].clientConfig.caBundle", "webhooks.clientConfig.caBundle", false},
+	}
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(fmt.Sprintf("%s/%s", tc.pattern, tc.path), func(t *testing.T) {
+			if matchFieldPath(tc.pattern, tc.path) != tc.expected {
+				t.Fatalf("Expected match to be %t", tc.expected)
+			}
+		})
+	}
+}
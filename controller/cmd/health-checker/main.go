@@ -0,0 +1,123 @@
+package healthchecker
+
+import (
+	"context"
+	"flag"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/linkerd/linkerd2/controller/healthchecker"
+	"github.com/linkerd/linkerd2/pkg/admin"
+	"github.com/linkerd/linkerd2/pkg/flags"
+	"github.com/linkerd/linkerd2/pkg/healthcheck"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/linkerd/linkerd2/pkg/version"
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+const (
+	componentName   = "linkerd-health-checker"
+	defaultInterval = time.Minute
+)
+
+// defaultCategories are the categories of `linkerd check` that can run from
+// within the cluster. The webhooks checks require port-forwarding and the
+// version checks require internet access, so they're left out.
+var defaultCategories = []healthcheck.CategoryID{
+	healthcheck.KubernetesAPIChecks,
+	healthcheck.KubernetesVersionChecks,
+	healthcheck.LinkerdControlPlaneExistenceChecks,
+	healthcheck.LinkerdConfigChecks,
+	healthcheck.LinkerdIdentity,
+	healthcheck.LinkerdWebhooksAndAPISvcTLS,
+	healthcheck.LinkerdControlPlaneProxyChecks,
+	healthcheck.LinkerdDataPlaneChecks,
+}
+
+// Main executes the health-checker subcommand
+func Main(args []string) {
+	cmd := flag.NewFlagSet("health-checker", flag.ExitOnError)
+
+	adminAddr := cmd.String("admin-addr", ":9990", "address of HTTP admin server")
+	kubeConfigPath := cmd.String("kubeconfig", "", "path to kube config")
+	controllerNamespace := cmd.String("controller-namespace", "linkerd", "namespace in which Linkerd is installed")
+	dataPlaneNamespace := cmd.String("data-plane-namespace", "", "namespace of the data plane checks (default: all namespaces)")
+	interval := cmd.Duration("check-interval", defaultInterval, "interval between two runs of the checks")
+	categories := cmd.String("categories", joinCategories(defaultCategories), "comma-separated list of the check categories to run")
+
+	flags.ConfigureAndParse(cmd, args)
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+
+	k8sAPI, err := k8s.NewAPI(*kubeConfigPath, "", "", []string{}, 0)
+	if err != nil {
+		log.Fatalf("Failed to initialize k8s API: %s", err)
+	}
+
+	deployment, err := k8sAPI.AppsV1().Deployments(*controllerNamespace).Get(context.Background(), componentName, metav1.GetOptions{})
+	if err != nil {
+		log.Fatalf("Failed to construct k8s event recorder: %s", err)
+	}
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{
+		Interface: k8sAPI.CoreV1().Events(""),
+	})
+	recorder := eventBroadcaster.NewRecorder(scheme.Scheme, v1.EventSource{Component: componentName})
+	recordEvent := func(eventType, reason, message string) {
+		recorder.Event(deployment, eventType, reason, message)
+	}
+
+	// Without internet access, the proxies are expected to run the version
+	// of the control plane rather than the latest release
+	latestVersions, err := version.NewChannels(version.Version)
+	if err != nil {
+		log.Warnf("Failed to parse the control plane version: %s", err)
+	}
+
+	categoryIDs := splitCategories(*categories)
+	newRunner := func() healthcheck.Runner {
+		hc := healthcheck.NewHealthChecker(categoryIDs, &healthcheck.Options{
+			ControlPlaneNamespace: *controllerNamespace,
+			DataPlaneNamespace:    *dataPlaneNamespace,
+			KubeConfig:            *kubeConfigPath,
+		})
+		hc.LatestVersions = latestVersions
+		return hc
+	}
+
+	go admin.StartServer(*adminAddr)
+
+	done := make(chan struct{})
+	go healthchecker.New(newRunner, recordEvent).Run(*interval, done)
+
+	<-stop
+	log.Info("shutting down health checker")
+	close(done)
+}
+
+func joinCategories(categories []healthcheck.CategoryID) string {
+	ids := make([]string, len(categories))
+	for i, category := range categories {
+		ids[i] = string(category)
+	}
+	return strings.Join(ids, ",")
+}
+
+func splitCategories(categories string) []healthcheck.CategoryID {
+	var ids []healthcheck.CategoryID
+	for _, category := range strings.Split(categories, ",") {
+		if category = strings.TrimSpace(category); category != "" {
+			ids = append(ids, healthcheck.CategoryID(category))
+		}
+	}
+	return ids
+}
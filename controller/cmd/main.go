@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"github.com/linkerd/linkerd2/controller/cmd/destination"
+	healthchecker "github.com/linkerd/linkerd2/controller/cmd/health-checker"
 	"github.com/linkerd/linkerd2/controller/cmd/heartbeat"
 	"github.com/linkerd/linkerd2/controller/cmd/identity"
 	proxyinjector "github.com/linkerd/linkerd2/controller/cmd/proxy-injector"
@@ -21,6 +22,8 @@ func main() {
 	switch os.Args[1] {
 	case "destination":
 		destination.Main(os.Args[2:])
+	case "health-checker":
+		healthchecker.Main(os.Args[2:])
 	case "heartbeat":
 		heartbeat.Main(os.Args[2:])
 	case "identity":
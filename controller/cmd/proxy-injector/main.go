@@ -8,7 +8,10 @@ import (
 	"github.com/linkerd/linkerd2/controller/k8s"
 	injector "github.com/linkerd/linkerd2/controller/proxy-injector"
 	"github.com/linkerd/linkerd2/controller/webhook"
+	"github.com/linkerd/linkerd2/pkg/config"
 	"github.com/linkerd/linkerd2/pkg/flags"
+	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
+	log "github.com/sirupsen/logrus"
 )
 
 // Main executes the proxy-injector subcommand
@@ -19,10 +22,20 @@ func Main(args []string) {
 	kubeconfig := cmd.String("kubeconfig", "", "path to kubeconfig")
 	flags.ConfigureAndParse(cmd, args)
 
-	webhook.Launch(
+	// the policy handler is built once, unlike the injection handler which
+	// reads the values on each request
+	valuesConfig, err := config.Values(pkgK8s.MountPathValuesConfig)
+	if err != nil {
+		log.Fatalf("failed to load the values of the control plane: %s", err)
+	}
+
+	webhook.LaunchWithHandlers(
 		context.Background(),
 		[]k8s.APIResource{k8s.NS, k8s.Deploy, k8s.RC, k8s.RS, k8s.Job, k8s.DS, k8s.SS, k8s.Pod, k8s.CJ},
-		injector.Inject,
+		webhook.Handlers{
+			"/":                 injector.Inject,
+			injector.PolicyPath: injector.NewAdmitPolicy(valuesConfig),
+		},
 		"linkerd-proxy-injector",
 		*metricsAddr,
 		*addr,
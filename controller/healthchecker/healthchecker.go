@@ -0,0 +1,207 @@
+package healthchecker
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/healthcheck"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Status is the outcome of a check
+type Status string
+
+// Possible check outcomes
+const (
+	StatusPass Status = "pass"
+	StatusWarn Status = "warn"
+	StatusFail Status = "fail"
+)
+
+// Event reasons emitted when the status of a check changes
+const (
+	CheckPassedEventReason  = "HealthCheckPassed"
+	CheckWarningEventReason = "HealthCheckWarning"
+	CheckFailedEventReason  = "HealthCheckFailed"
+)
+
+const (
+	labelCategory = "category"
+	labelCheck    = "check"
+	labelStatus   = "status"
+)
+
+var (
+	statuses = []Status{StatusPass, StatusWarn, StatusFail}
+
+	checkStatus = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "linkerd_check_status",
+		Help: "The status of each check of the last run; 1 for the current status and 0 for the other ones.",
+	}, []string{labelCategory, labelCheck, labelStatus})
+
+	checkDuration = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "linkerd_check_duration_seconds",
+		Help: "The time spent running each check in the last run.",
+	}, []string{labelCategory, labelCheck})
+
+	runDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "linkerd_check_run_duration_seconds",
+		Help:    "A histogram of the time spent running all the checks.",
+		Buckets: prometheus.ExponentialBuckets(0.5, 2, 10),
+	})
+
+	runTimestamp = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "linkerd_check_last_run_timestamp_seconds",
+		Help: "The time at which the last run of the checks completed.",
+	})
+)
+
+// RecordEventFunc records a Kubernetes event with the given type, reason and
+// message
+type RecordEventFunc func(eventType, reason, message string)
+
+type checkKey struct {
+	category healthcheck.CategoryID
+	check    string
+}
+
+type checkState struct {
+	status  Status
+	message string
+}
+
+// HealthChecker periodically runs the checks of a healthcheck.Runner,
+// exporting their results as Prometheus metrics and recording a Kubernetes
+// event whenever the status of a check changes.
+type HealthChecker struct {
+	newRunner   func() healthcheck.Runner
+	recordEvent RecordEventFunc
+	states      map[checkKey]checkState
+	log         *log.Entry
+}
+
+// New returns a HealthChecker running the checks of the runners returned by
+// newRunner. A new runner is built on every run, so that no state is carried
+// over between runs.
+func New(newRunner func() healthcheck.Runner, recordEvent RecordEventFunc) *HealthChecker {
+	return &HealthChecker{
+		newRunner:   newRunner,
+		recordEvent: recordEvent,
+		states:      map[checkKey]checkState{},
+		log:         log.WithField("component", "health-checker"),
+	}
+}
+
+// Run runs the checks every interval until stop is closed
+func (hc *HealthChecker) Run(interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		hc.RunOnce()
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce runs all the checks once, updating the metrics and recording
+// events for the checks whose status changed since the previous run
+func (hc *HealthChecker) RunOnce() {
+	start := time.Now()
+	results := map[checkKey]*healthcheck.CheckResult{}
+	var order []checkKey
+	hc.newRunner().RunChecks(func(result *healthcheck.CheckResult) {
+		if result.Retry {
+			return
+		}
+		key := checkKey{result.Category, checkName(result.Description)}
+		if _, ok := results[key]; !ok {
+			order = append(order, key)
+		}
+		results[key] = result
+	})
+	runDuration.Observe(time.Since(start).Seconds())
+	runTimestamp.SetToCurrentTime()
+
+	for _, key := range order {
+		result := results[key]
+		state := checkState{status: resultStatus(result)}
+		if result.Err != nil {
+			state.message = result.Err.Error()
+		}
+
+		for _, status := range statuses {
+			value := 0.0
+			if status == state.status {
+				value = 1
+			}
+			checkStatus.WithLabelValues(string(key.category), key.check, string(status)).Set(value)
+		}
+		checkDuration.WithLabelValues(string(key.category), key.check).Set(result.Duration.Seconds())
+
+		prev, seen := hc.states[key]
+		hc.states[key] = state
+		if (seen && prev.status != state.status) || (!seen && state.status != StatusPass) {
+			hc.recordTransition(key, result, state)
+		}
+	}
+
+	// Checks that didn't run this time, e.g. because a fatal check failed
+	// before them, no longer have a known status
+	for key := range hc.states {
+		if _, ok := results[key]; ok {
+			continue
+		}
+		for _, status := range statuses {
+			checkStatus.DeleteLabelValues(string(key.category), key.check, string(status))
+		}
+		checkDuration.DeleteLabelValues(string(key.category), key.check)
+		delete(hc.states, key)
+	}
+}
+
+func (hc *HealthChecker) recordTransition(key checkKey, result *healthcheck.CheckResult, state checkState) {
+	message := fmt.Sprintf("[%s] %s", key.category, key.check)
+	if state.message != "" {
+		message = fmt.Sprintf("%s: %s", message, state.message)
+		if result.HintURL != "" {
+			message = fmt.Sprintf("%s\nsee %s for hints", message, result.HintURL)
+		}
+	}
+
+	switch state.status {
+	case StatusPass:
+		hc.log.Infof("check passed: %s", message)
+		hc.recordEvent(corev1.EventTypeNormal, CheckPassedEventReason, message)
+	case StatusWarn:
+		hc.log.Warnf("check warning: %s", message)
+		hc.recordEvent(corev1.EventTypeWarning, CheckWarningEventReason, message)
+	case StatusFail:
+		hc.log.Errorf("check failed: %s", message)
+		hc.recordEvent(corev1.EventTypeWarning, CheckFailedEventReason, message)
+	}
+}
+
+func resultStatus(result *healthcheck.CheckResult) Status {
+	switch {
+	case result.Err == nil:
+		return StatusPass
+	case result.Warning:
+		return StatusWarn
+	default:
+		return StatusFail
+	}
+}
+
+// checkName strips the details appended to the description of verbose
+// successes, so that the metric labels are stable
+func checkName(description string) string {
+	return strings.SplitN(description, "\n", 2)[0]
+}
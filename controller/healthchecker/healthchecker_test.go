@@ -0,0 +1,103 @@
+package healthchecker
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/healthcheck"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type event struct {
+	eventType string
+	reason    string
+	message   string
+}
+
+func TestRunOnce(t *testing.T) {
+	var results []healthcheck.CheckResult
+	newRunner := func() healthcheck.Runner {
+		return healthcheck.CheckResults{Results: results}
+	}
+	var events []event
+	recordEvent := func(eventType, reason, message string) {
+		events = append(events, event{eventType, reason, message})
+	}
+	hc := New(newRunner, recordEvent)
+
+	pass := healthcheck.CheckResult{Category: "linkerd-config", Description: "control plane Namespace exists", Duration: 2 * time.Second}
+	warn := healthcheck.CheckResult{Category: "linkerd-data-plane", Description: "data plane is up-to-date", Warning: true, Err: errors.New("some proxies are not running the current version")}
+	fail := healthcheck.CheckResult{Category: "linkerd-data-plane", Description: "data plane proxies are ready", Err: errors.New("no running pods")}
+	retry := healthcheck.CheckResult{Category: "linkerd-data-plane", Description: "data plane proxies are ready", Retry: true, Err: errors.New("waiting for check to complete")}
+
+	testCases := []struct {
+		results []healthcheck.CheckResult
+		events  []event
+		status  map[string]Status
+	}{
+		{
+			// only the failing checks are reported on the first run
+			results: []healthcheck.CheckResult{pass, warn, retry, fail},
+			events: []event{
+				{"Warning", CheckWarningEventReason, "[linkerd-data-plane] data plane is up-to-date: some proxies are not running the current version"},
+				{"Warning", CheckFailedEventReason, "[linkerd-data-plane] data plane proxies are ready: no running pods"},
+			},
+			status: map[string]Status{
+				pass.Description: StatusPass,
+				warn.Description: StatusWarn,
+				fail.Description: StatusFail,
+			},
+		},
+		{
+			// unchanged statuses aren't reported again
+			results: []healthcheck.CheckResult{pass, warn, fail},
+			status: map[string]Status{
+				pass.Description: StatusPass,
+				warn.Description: StatusWarn,
+				fail.Description: StatusFail,
+			},
+		},
+		{
+			results: []healthcheck.CheckResult{pass, {Category: warn.Category, Description: warn.Description}},
+			events: []event{
+				{"Normal", CheckPassedEventReason, "[linkerd-data-plane] data plane is up-to-date"},
+			},
+			status: map[string]Status{
+				pass.Description: StatusPass,
+				warn.Description: StatusPass,
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		tc := tc // pin
+		results = tc.results
+		events = nil
+		hc.RunOnce()
+
+		if !reflect.DeepEqual(events, tc.events) {
+			t.Fatalf("run %d: expected events %v, got %v", i, tc.events, events)
+		}
+		for _, result := range tc.results {
+			for _, status := range statuses {
+				expected := 0.0
+				if tc.status[result.Description] == status {
+					expected = 1
+				}
+				actual := testutil.ToFloat64(checkStatus.WithLabelValues(string(result.Category), result.Description, string(status)))
+				if actual != expected {
+					t.Fatalf("run %d: expected %s status of %q to be %f, got %f", i, status, result.Description, expected, actual)
+				}
+			}
+		}
+		if len(hc.states) != len(tc.status) {
+			t.Fatalf("run %d: expected %d checks to be tracked, got %d", i, len(tc.status), len(hc.states))
+		}
+	}
+
+	if duration := testutil.ToFloat64(checkDuration.WithLabelValues("linkerd-config", pass.Description)); duration != 2 {
+		t.Fatalf("Expected a duration of 2s, got %f", duration)
+	}
+}
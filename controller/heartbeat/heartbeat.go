@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	pkgK8s "github.com/linkerd/linkerd2/controller/k8s"
@@ -137,13 +138,10 @@ func PromValues(promAPI promv1.API, controlPlaneNamespace string) url.Values {
 			ns:   "linkerd",
 		},
 	} {
-		// as of k8s 1.16 cadvisor labels container names with just `container`
-		containerLabelsPre16 := getLabelSet(container, "container_name")
-		containerLabelsPost16 := getLabelSet(container, "container")
+		selectors := CadvisorSelectors(string(container.name), string(container.ns))
 
 		// max-mem
-		query = fmt.Sprintf("max(container_memory_working_set_bytes%s or container_memory_working_set_bytes%s)",
-			containerLabelsPre16, containerLabelsPost16)
+		query = MemoryQuery("max", "", selectors)
 		value, err = promQuery(promAPI, query, 0)
 		if err != nil {
 			log.Errorf("Prometheus query failed: %s", err)
@@ -153,9 +151,7 @@ func PromValues(promAPI promv1.API, controlPlaneNamespace string) url.Values {
 		}
 
 		// p95-cpu
-		query = fmt.Sprintf("max(quantile_over_time(0.95,rate(container_cpu_usage_seconds_total%s[5m])[24h:5m]) "+
-			"or quantile_over_time(0.95,rate(container_cpu_usage_seconds_total%s[5m])[24h:5m]))",
-			containerLabelsPre16, containerLabelsPost16)
+		query = CPUQuantileQuery("max", 0.95, "24h", selectors)
 		value, err = promQuery(promAPI, query, 3)
 		if err != nil {
 			log.Errorf("Prometheus query failed: %s", err)
@@ -168,15 +164,50 @@ func PromValues(promAPI promv1.API, controlPlaneNamespace string) url.Values {
 	return v
 }
 
-func getLabelSet(container containerMeta, containerKey model.LabelName) model.LabelSet {
-	containerLabels := model.LabelSet{
-		"job":        "kubernetes-nodes-cadvisor",
-		containerKey: container.name,
+// CadvisorSelectors returns the selectors of the cadvisor metrics of the
+// containers with the given name, restricted to the given namespace unless
+// it's empty. As of k8s 1.16 cadvisor labels container names with just
+// `container` instead of `container_name`, so there's a selector for each.
+func CadvisorSelectors(container, namespace string) []model.LabelSet {
+	selectors := make([]model.LabelSet, 0, 2)
+	for _, containerKey := range []model.LabelName{"container_name", "container"} {
+		labels := model.LabelSet{
+			"job":        "kubernetes-nodes-cadvisor",
+			containerKey: model.LabelValue(container),
+		}
+		if namespace != "" {
+			labels["namespace"] = model.LabelValue(namespace)
+		}
+		selectors = append(selectors, labels)
 	}
-	if container.ns != "" {
-		containerLabels["namespace"] = container.ns
+	return selectors
+}
+
+// MemoryQuery returns the query of the memory working set of the containers
+// matching the cadvisor selectors, aggregated with aggregation, e.g. "max".
+// When window is set, the maximum over the window is used instead of the
+// current value.
+func MemoryQuery(aggregation, window string, selectors []model.LabelSet) string {
+	queries := make([]string, len(selectors))
+	for i, selector := range selectors {
+		if window == "" {
+			queries[i] = fmt.Sprintf("container_memory_working_set_bytes%s", selector)
+		} else {
+			queries[i] = fmt.Sprintf("max_over_time(container_memory_working_set_bytes%s[%s])", selector, window)
+		}
+	}
+	return fmt.Sprintf("%s(%s)", aggregation, strings.Join(queries, " or "))
+}
+
+// CPUQuantileQuery returns the query of the given quantile of the CPU usage of
+// the containers matching the cadvisor selectors over the window, aggregated
+// with aggregation, e.g. "max"
+func CPUQuantileQuery(aggregation string, quantile float64, window string, selectors []model.LabelSet) string {
+	queries := make([]string, len(selectors))
+	for i, selector := range selectors {
+		queries[i] = fmt.Sprintf("quantile_over_time(%g,rate(container_cpu_usage_seconds_total%s[5m])[%s:5m])", quantile, selector, window)
 	}
-	return containerLabels
+	return fmt.Sprintf("%s(%s)", aggregation, strings.Join(queries, " or "))
 }
 
 func promQuery(promAPI promv1.API, query string, precision int) (string, error) {
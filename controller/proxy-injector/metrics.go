@@ -20,6 +20,7 @@ const (
 var (
 	requestLabels  = []string{labelOwnerKind, labelNamespace, labelAnnotationAt}
 	responseLabels = []string{labelOwnerKind, labelNamespace, labelSkip, labelReason, labelAnnotationAt}
+	skipLabels     = []string{labelOwnerKind, labelNamespace, labelReason}
 
 	proxyInjectionAdmissionRequests = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "proxy_inject_admission_requests_total",
@@ -30,6 +31,11 @@ var (
 		Name: "proxy_inject_admission_responses_total",
 		Help: "A counter for number of admission responses from proxy injector.",
 	}, append(responseLabels, validLabelNames(inject.ProxyAnnotations)...))
+
+	proxyInjectionSkips = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "proxy_inject_skipped_total",
+		Help: "A counter for number of pods skipped by the proxy injector, by skip reason.",
+	}, skipLabels)
 )
 
 func admissionRequestLabels(ownerKind, namespace, annotationAt string, configLabels prometheus.Labels) prometheus.Labels {
@@ -48,6 +54,16 @@ func admissionResponseLabels(owner, namespace, skip, reason, annotationAt string
 	return configLabels
 }
 
+// skipReasonLabels returns the labels for the proxyInjectionSkips counter.
+// reason must be one of the keys of inject.Reasons.
+func skipReasonLabels(ownerKind, namespace, reason string) prometheus.Labels {
+	return prometheus.Labels{
+		labelOwnerKind: ownerKind,
+		labelNamespace: namespace,
+		labelReason:    reason,
+	}
+}
+
 func configToPrometheusLabels(conf *inject.ResourceConfig) prometheus.Labels {
 	labels := conf.GetOverriddenConfiguration()
 	promLabels := map[string]string{}
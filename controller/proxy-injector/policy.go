@@ -0,0 +1,131 @@
+package injector
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/linkerd/linkerd2/controller/k8s"
+	l5dcharts "github.com/linkerd/linkerd2/pkg/charts/linkerd2"
+	"github.com/linkerd/linkerd2/pkg/inject"
+	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
+	log "github.com/sirupsen/logrus"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+// PolicyPath is the path on which the proxy-injector serves the injection
+// policy validating webhook
+const PolicyPath = "/validate-injection-policy"
+
+// NewAdmitPolicy returns the handler of the injection policy validating
+// webhook, which checks the pods against the values the control plane was
+// installed with. It denies pods created in namespaces labelled with
+// pkgK8s.MeshRequiredLabel unless they have been injected with the proxy or
+// carry the pkgK8s.MeshExemptAnnotation annotation.
+func NewAdmitPolicy(valuesConfig *l5dcharts.Values) func(
+	context.Context,
+	*k8s.API,
+	*admissionv1beta1.AdmissionRequest,
+	record.EventRecorder,
+) (*admissionv1beta1.AdmissionResponse, error) {
+	return func(
+		_ context.Context,
+		api *k8s.API,
+		request *admissionv1beta1.AdmissionRequest,
+		_ record.EventRecorder,
+	) (*admissionv1beta1.AdmissionResponse, error) {
+		return admitPolicy(api, valuesConfig, request)
+	}
+}
+
+func admitPolicy(
+	api *k8s.API,
+	valuesConfig *l5dcharts.Values,
+	request *admissionv1beta1.AdmissionRequest,
+) (*admissionv1beta1.AdmissionResponse, error) {
+	admissionResponse := &admissionv1beta1.AdmissionResponse{
+		UID:     request.UID,
+		Allowed: true,
+	}
+	if strings.ToLower(request.Kind.Kind) != pkgK8s.Pod {
+		return admissionResponse, nil
+	}
+
+	namespace, err := api.NS().Lister().Get(request.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if namespace.GetLabels()[pkgK8s.MeshRequiredLabel] != "true" {
+		return admissionResponse, nil
+	}
+
+	denied, message, err := checkPolicy(valuesConfig, namespace.GetAnnotations(), request)
+	if err != nil {
+		return nil, err
+	}
+	if denied {
+		log.Infof("denied pod in namespace %s: %s", request.Namespace, message)
+		admissionResponse.Allowed = false
+		admissionResponse.Result = &metav1.Status{Message: message, Code: 403}
+	}
+	return admissionResponse, nil
+}
+
+// checkPolicy returns true along with a message listing the reasons why the
+// pod in the request isn't meshed, when it should be denied admission.
+func checkPolicy(
+	valuesConfig *l5dcharts.Values,
+	nsAnnotations map[string]string,
+	request *admissionv1beta1.AdmissionRequest,
+) (bool, string, error) {
+	resourceConfig := inject.NewResourceConfig(valuesConfig, inject.OriginWebhook).
+		WithNsAnnotations(nsAnnotations).
+		WithKind(request.Kind.Kind)
+	report, err := resourceConfig.ParseMetaAndYAML(request.Object.Raw)
+	if err != nil {
+		return false, "", err
+	}
+
+	// By the time validating webhooks are called the proxy-injector has
+	// already mutated the pod, so injected pods have a proxy container.
+	if report.Sidecar {
+		return false, "", nil
+	}
+	if report.MeshExempt {
+		log.Infof("%s is exempt from the injection policy", report.ResName())
+		return false, "", nil
+	}
+
+	return true, fmt.Sprintf(
+		"%s is not meshed but namespace %s requires it (%s=true): %s",
+		report.ResName(), request.Namespace, pkgK8s.MeshRequiredLabel, strings.Join(policyReasons(report), ", ")), nil
+}
+
+// policyReasons returns the human readable reasons why a report's resource
+// wasn't injected, built from Report.ThrowInjectError and the inject skip
+// reasons.
+func policyReasons(report *inject.Report) []string {
+	var reasons []string
+	seen := map[string]bool{}
+	add := func(reason string) {
+		if reason != "" && !seen[reason] {
+			seen[reason] = true
+			reasons = append(reasons, reason)
+		}
+	}
+
+	_, skipReasons := report.Injectable()
+	for _, reason := range skipReasons {
+		add(inject.Reasons[reason])
+	}
+	for _, err := range report.ThrowInjectError() {
+		add(err.Error())
+	}
+
+	if len(reasons) == 0 {
+		reasons = append(reasons, "the proxy was not injected")
+	}
+	return reasons
+}
@@ -0,0 +1,160 @@
+package injector
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/linkerd/linkerd2/controller/k8s"
+	"github.com/linkerd/linkerd2/controller/proxy-injector/fake"
+	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
+)
+
+func TestCheckPolicy(t *testing.T) {
+	factory := fake.NewFactory(filepath.Join("fake", "data"))
+
+	var testCases = []struct {
+		name            string
+		filename        string
+		nsAnnotations   map[string]string
+		expectedDenied  bool
+		expectedMessage string
+	}{
+		{
+			name:          "injected pod",
+			filename:      "pod-with-injected-proxy.yaml",
+			nsAnnotations: map[string]string{},
+		},
+		{
+			name:          "exempt pod",
+			filename:      "pod-mesh-exempt.yaml",
+			nsAnnotations: map[string]string{},
+		},
+		{
+			name:            "pod without inject annotation",
+			filename:        "pod-policy-not-injected.yaml",
+			nsAnnotations:   map[string]string{},
+			expectedDenied:  true,
+			expectedMessage: "pod/nginx is not meshed but namespace linkerd requires it (linkerd.io/mesh-required=true): neither the namespace nor the pod have the annotation \"linkerd.io/inject:enabled\"",
+		},
+		{
+			name:            "pod on host network",
+			filename:        "pod-host-network.yaml",
+			nsAnnotations:   map[string]string{pkgK8s.ProxyInjectAnnotation: pkgK8s.ProxyInjectEnabled},
+			expectedDenied:  true,
+			expectedMessage: "pod/nginx is not meshed but namespace linkerd requires it (linkerd.io/mesh-required=true): hostNetwork is enabled",
+		},
+		{
+			name:            "pod without service account token",
+			filename:        "pod-automount-disabled.yaml",
+			nsAnnotations:   map[string]string{},
+			expectedDenied:  true,
+			expectedMessage: "pod/nginx is not meshed but namespace linkerd requires it (linkerd.io/mesh-required=true): automountServiceAccountToken set to \"false\"",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase // pin
+		t.Run(testCase.name, func(t *testing.T) {
+			pod, err := factory.FileContents(testCase.filename)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			denied, message, err := checkPolicy(values, testCase.nsAnnotations, getFakePodReq(pod))
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if denied != testCase.expectedDenied {
+				t.Fatalf("Expected denied to be %t, got %t (%s)", testCase.expectedDenied, denied, message)
+			}
+			if message != testCase.expectedMessage {
+				t.Fatalf("Expected message:\n%s\nActual:\n%s", testCase.expectedMessage, message)
+			}
+		})
+	}
+}
+
+func TestAdmitPolicy(t *testing.T) {
+	api, err := k8s.NewFakeAPI(`
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: required
+  labels:
+    linkerd.io/mesh-required: "true"
+`, `
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: optional
+`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	api.Sync(nil)
+
+	factory := fake.NewFactory(filepath.Join("fake", "data"))
+	admit := NewAdmitPolicy(values)
+
+	var testCases = []struct {
+		name            string
+		namespace       string
+		filename        string
+		kind            string
+		expectedAllowed bool
+	}{
+		{
+			name:            "not injected pod in a mesh-required namespace",
+			namespace:       "required",
+			filename:        "pod-inject-empty.yaml",
+			kind:            "Pod",
+			expectedAllowed: false,
+		},
+		{
+			name:            "injected pod in a mesh-required namespace",
+			namespace:       "required",
+			filename:        "pod-with-injected-proxy.yaml",
+			kind:            "Pod",
+			expectedAllowed: true,
+		},
+		{
+			name:            "not injected pod in another namespace",
+			namespace:       "optional",
+			filename:        "pod-inject-empty.yaml",
+			kind:            "Pod",
+			expectedAllowed: true,
+		},
+		{
+			name:            "service in a mesh-required namespace",
+			namespace:       "required",
+			filename:        "service-without-opaque-ports.yaml",
+			kind:            "Service",
+			expectedAllowed: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase // pin
+		t.Run(testCase.name, func(t *testing.T) {
+			obj, err := factory.FileContents(testCase.filename)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			request := getFakePodReq(obj)
+			request.Kind.Kind = testCase.kind
+			request.Namespace = testCase.namespace
+
+			response, err := admit(context.Background(), api, request, nil)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if response.Allowed != testCase.expectedAllowed {
+				t.Fatalf("Expected allowed to be %t, got %t (%v)", testCase.expectedAllowed, response.Allowed, response.Result)
+			}
+			if !response.Allowed && response.Result.Code != 403 {
+				t.Fatalf("Expected a 403 status, got %d", response.Result.Code)
+			}
+		})
+	}
+}
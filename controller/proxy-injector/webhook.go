@@ -19,8 +19,8 @@ import (
 )
 
 const (
-	eventTypeSkipped  = "InjectionSkipped"
-	eventTypeInjected = "Injected"
+	eventTypeSkipped  = pkgK8s.InjectionSkippedEventReason
+	eventTypeInjected = pkgK8s.InjectedEventReason
 )
 
 // Inject returns an AdmissionResponse containing the patch, if any, to apply
@@ -73,8 +73,13 @@ func Inject(
 		ownerKind = strings.ToLower(ownerRef.Kind)
 	}
 
+	// dry-run requests, like the synthetic ones sent by `linkerd check`,
+	// aren't counted
+	dryRun := request.DryRun != nil && *request.DryRun
 	configLabels := configToPrometheusLabels(resourceConfig)
-	proxyInjectionAdmissionRequests.With(admissionRequestLabels(ownerKind, request.Namespace, report.InjectAnnotationAt, configLabels)).Inc()
+	if !dryRun {
+		proxyInjectionAdmissionRequests.With(admissionRequestLabels(ownerKind, request.Namespace, report.InjectAnnotationAt, configLabels)).Inc()
+	}
 
 	// If the resource is injectable then admit it after creating a patch that
 	// adds the proxy-init and proxy containers.
@@ -94,7 +99,9 @@ func Inject(
 		}
 		log.Infof("injection patch generated for: %s", report.ResName())
 		log.Debugf("injection patch: %s", patchJSON)
-		proxyInjectionAdmissionResponses.With(admissionResponseLabels(ownerKind, request.Namespace, "false", "", report.InjectAnnotationAt, configLabels)).Inc()
+		if !dryRun {
+			proxyInjectionAdmissionResponses.With(admissionResponseLabels(ownerKind, request.Namespace, "false", "", report.InjectAnnotationAt, configLabels)).Inc()
+		}
 		patchType := admissionv1beta1.PatchTypeJSONPatch
 		return &admissionv1beta1.AdmissionResponse{
 			UID:       request.UID,
@@ -114,7 +121,9 @@ func Inject(
 		}
 		log.Infof("annotation patch generated for: %s", report.ResName())
 		log.Debugf("annotation patch: %s", patchJSON)
-		proxyInjectionAdmissionResponses.With(admissionResponseLabels(ownerKind, request.Namespace, "false", "", report.InjectAnnotationAt, configLabels)).Inc()
+		if !dryRun {
+			proxyInjectionAdmissionResponses.With(admissionResponseLabels(ownerKind, request.Namespace, "false", "", report.InjectAnnotationAt, configLabels)).Inc()
+		}
 		patchType := admissionv1beta1.PatchTypeJSONPatch
 		return &admissionv1beta1.AdmissionResponse{
 			UID:       request.UID,
@@ -135,10 +144,18 @@ func Inject(
 		// removing the initial comma, space
 		readableReasons = readableReasons[2:]
 		if parent != nil {
-			recorder.Eventf(*parent, v1.EventTypeNormal, eventTypeSkipped, "Linkerd sidecar proxy injection skipped: %s", readableReasons)
+			// The reason keys are attached to the event so that they can be
+			// summarized by `linkerd check --proxy`.
+			annotations := map[string]string{pkgK8s.ProxyInjectSkipReasonsAnnotation: metricReasons}
+			recorder.AnnotatedEventf(*parent, annotations, v1.EventTypeNormal, eventTypeSkipped, "Linkerd sidecar proxy injection skipped: %s", readableReasons)
 		}
 		log.Infof("skipped %s: %s", report.ResName(), readableReasons)
-		proxyInjectionAdmissionResponses.With(admissionResponseLabels(ownerKind, request.Namespace, "true", metricReasons, report.InjectAnnotationAt, configLabels)).Inc()
+		if !dryRun {
+			proxyInjectionAdmissionResponses.With(admissionResponseLabels(ownerKind, request.Namespace, "true", metricReasons, report.InjectAnnotationAt, configLabels)).Inc()
+			for _, reason := range reasons {
+				proxyInjectionSkips.With(skipReasonLabels(ownerKind, request.Namespace, reason)).Inc()
+			}
+		}
 		return &admissionv1beta1.AdmissionResponse{
 			UID:     request.UID,
 			Allowed: true,
@@ -22,6 +22,20 @@ func Launch(
 	metricsAddr string,
 	addr string,
 	kubeconfig string,
+) {
+	LaunchWithHandlers(ctx, APIResources, Handlers{"/": handler}, component, metricsAddr, addr, kubeconfig)
+}
+
+// LaunchWithHandlers sets up and starts the webhook and metrics servers,
+// serving a different Handler on each of the given paths
+func LaunchWithHandlers(
+	ctx context.Context,
+	APIResources []k8s.APIResource,
+	handlers Handlers,
+	component,
+	metricsAddr string,
+	addr string,
+	kubeconfig string,
 ) {
 	stop := make(chan os.Signal, 1)
 	defer close(stop)
@@ -32,7 +46,7 @@ func Launch(
 		log.Fatalf("failed to initialize Kubernetes API: %s", err)
 	}
 
-	s, err := NewServer(ctx, k8sAPI, addr, pkgk8s.MountPathTLSBase, handler, component)
+	s, err := NewServer(ctx, k8sAPI, addr, pkgk8s.MountPathTLSBase, handlers, component)
 	if err != nil {
 		log.Fatalf("failed to initialize the webhook server: %s", err)
 	}
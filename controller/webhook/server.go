@@ -6,10 +6,10 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
+	"path/filepath"
 	"sync/atomic"
 
 	"github.com/linkerd/linkerd2/controller/k8s"
-	pkgk8s "github.com/linkerd/linkerd2/pkg/k8s"
 	pkgTls "github.com/linkerd/linkerd2/pkg/tls"
 	"github.com/sirupsen/logrus"
 	log "github.com/sirupsen/logrus"
@@ -22,6 +22,11 @@ import (
 	"sigs.k8s.io/yaml"
 )
 
+const (
+	tlsCrtFileName = "tls.crt"
+	tlsKeyFileName = "tls.key"
+)
+
 // Handler is the signature for the functions that ultimately deal with
 // the admission request
 type Handler func(
@@ -31,11 +36,16 @@ type Handler func(
 	record.EventRecorder,
 ) (*admissionv1beta1.AdmissionResponse, error)
 
+// Handlers maps the URL paths served by a webhook Server to the Handler
+// dealing with the admission requests sent to them. The "/" entry handles
+// any path not explicitly listed.
+type Handlers map[string]Handler
+
 // Server describes the https server implementing the webhook
 type Server struct {
 	*http.Server
 	api       *k8s.API
-	handler   Handler
+	handlers  Handlers
 	certValue *atomic.Value
 	recorder  record.EventRecorder
 }
@@ -45,13 +55,13 @@ func NewServer(
 	ctx context.Context,
 	api *k8s.API,
 	addr, certPath string,
-	handler Handler,
+	handlers Handlers,
 	component string,
 ) (*Server, error) {
 	updateEvent := make(chan struct{})
 	errEvent := make(chan error)
 	watcher := pkgTls.NewFsCredsWatcher(certPath, updateEvent, errEvent).
-		WithFilePaths(pkgk8s.MountPathTLSCrtPEM, pkgk8s.MountPathTLSKeyPEM)
+		WithFilePaths(filepath.Join(certPath, tlsCrtFileName), filepath.Join(certPath, tlsKeyFileName))
 	go func() {
 		if err := watcher.StartWatching(ctx); err != nil {
 			log.Fatalf("Failed to start creds watcher: %s", err)
@@ -71,13 +81,13 @@ func NewServer(
 	})
 	recorder := eventBroadcaster.NewRecorder(scheme.Scheme, v1.EventSource{Component: component})
 
-	s := getConfiguredServer(server, api, handler, recorder)
+	s := getConfiguredServer(server, api, handlers, recorder)
 	if err := watcher.UpdateCert(s.certValue); err != nil {
 		log.Fatalf("Failed to initialized certificate: %s", err)
 	}
 
 	log := logrus.WithFields(logrus.Fields{
-		"component": "proxy-injector",
+		"component": component,
 		"addr":      addr,
 	})
 
@@ -89,11 +99,11 @@ func NewServer(
 func getConfiguredServer(
 	httpServer *http.Server,
 	api *k8s.API,
-	handler Handler,
+	handlers Handlers,
 	recorder record.EventRecorder,
 ) *Server {
 	var emptyCert atomic.Value
-	s := &Server{httpServer, api, handler, &emptyCert, recorder}
+	s := &Server{httpServer, api, handlers, &emptyCert, recorder}
 	s.Handler = http.HandlerFunc(s.serve)
 	httpServer.TLSConfig.GetCertificate = s.getCertificate
 	return s
@@ -133,7 +143,16 @@ func (s *Server) serve(res http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	response := s.processReq(req.Context(), data)
+	handler, ok := s.handlers[req.URL.Path]
+	if !ok {
+		handler, ok = s.handlers["/"]
+	}
+	if !ok {
+		http.NotFound(res, req)
+		return
+	}
+
+	response := s.processReq(req.Context(), handler, data)
 	responseJSON, err := json.Marshal(response)
 	if err != nil {
 		http.Error(res, err.Error(), http.StatusInternalServerError)
@@ -146,7 +165,7 @@ func (s *Server) serve(res http.ResponseWriter, req *http.Request) {
 	}
 }
 
-func (s *Server) processReq(ctx context.Context, data []byte) *admissionv1beta1.AdmissionReview {
+func (s *Server) processReq(ctx context.Context, handler Handler, data []byte) *admissionv1beta1.AdmissionReview {
 	admissionReview, err := decode(data)
 	if err != nil {
 		log.Errorf("failed to decode data. Reason: %s", err)
@@ -162,7 +181,14 @@ func (s *Server) processReq(ctx context.Context, data []byte) *admissionv1beta1.
 	log.Infof("received admission review request %s", admissionReview.Request.UID)
 	log.Debugf("admission request: %+v", admissionReview.Request)
 
-	admissionResponse, err := s.handler(ctx, s.api, admissionReview.Request, s.recorder)
+	// dry-run requests, like the synthetic ones sent by `linkerd check`, must
+	// have no side effects, so their events are discarded
+	recorder := s.recorder
+	if isDryRun(admissionReview.Request) {
+		recorder = &record.FakeRecorder{}
+	}
+
+	admissionResponse, err := handler(ctx, s.api, admissionReview.Request, recorder)
 	if err != nil {
 		log.Error("failed to run webhook handler. Reason: ", err)
 		admissionReview.Response = &admissionv1beta1.AdmissionResponse{
@@ -189,3 +215,9 @@ func decode(data []byte) (*admissionv1beta1.AdmissionReview, error) {
 	err := yaml.Unmarshal(data, &admissionReview)
 	return &admissionReview, err
 }
+
+// isDryRun returns true if the admission request is a dry run, in which case
+// the webhooks must not have side effects
+func isDryRun(request *admissionv1beta1.AdmissionRequest) bool {
+	return request.DryRun != nil && *request.DryRun
+}
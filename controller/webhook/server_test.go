@@ -4,13 +4,24 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 	"time"
 
 	"github.com/linkerd/linkerd2/controller/k8s"
+	pkgTls "github.com/linkerd/linkerd2/pkg/tls"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 )
 
 var mockHTTPServer = &http.Server{
@@ -41,6 +52,79 @@ func TestServe(t *testing.T) {
 	})
 }
 
+func TestServeHandlers(t *testing.T) {
+	k8sAPI, err := k8s.NewFakeAPI()
+	if err != nil {
+		panic(err)
+	}
+	handlerFor := func(name string) Handler {
+		return func(
+			_ context.Context,
+			_ *k8s.API,
+			request *admissionv1beta1.AdmissionRequest,
+			_ record.EventRecorder,
+		) (*admissionv1beta1.AdmissionResponse, error) {
+			return &admissionv1beta1.AdmissionResponse{
+				UID:    request.UID,
+				Result: &metav1.Status{Message: name},
+			}, nil
+		}
+	}
+	testServer := getConfiguredServer(mockHTTPServer, k8sAPI, Handlers{
+		"/":         handlerFor("root"),
+		"/validate": handlerFor("validate"),
+	}, nil)
+
+	for path, expected := range map[string]string{
+		"/":         "root",
+		"/unknown":  "root",
+		"/validate": "validate",
+	} {
+		in := bytes.NewReader([]byte(`{"request":{"uid":"test"}}`))
+		request := httptest.NewRequest(http.MethodPost, path, in)
+		recorder := httptest.NewRecorder()
+		testServer.serve(recorder, request)
+
+		var review admissionv1beta1.AdmissionReview
+		if err := json.Unmarshal(recorder.Body.Bytes(), &review); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if review.Response.Result.Message != expected {
+			t.Errorf("Expected path %s to be served by the %q handler, got %q", path, expected, review.Response.Result.Message)
+		}
+	}
+}
+
+func TestServeDryRun(t *testing.T) {
+	k8sAPI, err := k8s.NewFakeAPI()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	recorder := record.NewFakeRecorder(10)
+	handler := func(
+		_ context.Context,
+		_ *k8s.API,
+		request *admissionv1beta1.AdmissionRequest,
+		recorder record.EventRecorder,
+	) (*admissionv1beta1.AdmissionResponse, error) {
+		recorder.Event(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: request.Name}}, "Normal", "Injected", "test")
+		return &admissionv1beta1.AdmissionResponse{UID: request.UID, Allowed: true}, nil
+	}
+	testServer := getConfiguredServer(mockHTTPServer, k8sAPI, Handlers{"/": handler}, recorder)
+
+	for _, body := range []string{
+		`{"request":{"uid":"dry-run","name":"dry-run","dryRun":true}}`,
+		`{"request":{"uid":"test","name":"test"}}`,
+	} {
+		request := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader([]byte(body)))
+		testServer.serve(httptest.NewRecorder(), request)
+	}
+
+	if len(recorder.Events) != 1 {
+		t.Fatalf("Expected only the event of the non dry-run request to be recorded, got %d events", len(recorder.Events))
+	}
+}
+
 func TestShutdown(t *testing.T) {
 	testServer := getConfiguredServer(mockHTTPServer, nil, nil, nil)
 
@@ -58,3 +142,84 @@ func TestShutdown(t *testing.T) {
 		t.Fatal("Unexpected error: ", err)
 	}
 }
+
+func TestCertificateReload(t *testing.T) {
+	ca, err := pkgTls.GenerateRootCAWithDefaults("test-ca")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	// writeCreds mimics how the kubelet atomically updates a secret volume: the
+	// files are written into a new timestamped directory, and the "..data"
+	// symlink is then swapped to point to it
+	certPath := t.TempDir()
+	writeCreds := func(version string) *pkgTls.Cred {
+		cred, err := ca.GenerateEndEntityCred("linkerd-proxy-injector.linkerd.svc")
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		dir := filepath.Join(certPath, "..tls_"+version)
+		if err := os.Mkdir(dir, 0755); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, tlsCrtFileName), []byte(cred.Crt.EncodeCertificatePEM()), 0600); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, tlsKeyFileName), []byte(cred.EncodePrivateKeyPEM()), 0600); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		tmpLink := filepath.Join(certPath, "..data_tmp")
+		if err := os.Symlink(filepath.Base(dir), tmpLink); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if err := os.Rename(tmpLink, filepath.Join(certPath, "..data")); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		return cred
+	}
+
+	initial := writeCreds("1")
+	for _, file := range []string{tlsCrtFileName, tlsKeyFileName} {
+		if err := os.Symlink(filepath.Join("..data", file), filepath.Join(certPath, file)); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	}
+
+	k8sAPI, err := k8s.NewFakeAPI()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	testServer, err := NewServer(ctx, k8sAPI, ":0", certPath, nil, "test")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	servedSerial := func() string {
+		cert, err := testServer.getCertificate(nil)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		leaf, err := x509.ParseCertificate(cert.Certificate[0])
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		return leaf.SerialNumber.String()
+	}
+
+	if serial := servedSerial(); serial != initial.Crt.Certificate.SerialNumber.String() {
+		t.Fatalf("Expected the initial certificate to be served, got serial %s", serial)
+	}
+
+	// The watcher starts asynchronously, so the credentials are rotated until
+	// a reload is observed
+	for i := 2; i < 50; i++ {
+		rotated := writeCreds(fmt.Sprintf("%d", i))
+		time.Sleep(100 * time.Millisecond)
+		if servedSerial() == rotated.Crt.Certificate.SerialNumber.String() {
+			return
+		}
+	}
+	t.Fatal("Expected the rotated certificate to be served")
+}
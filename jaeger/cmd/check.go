@@ -36,6 +36,8 @@ type checkOptions struct {
 	output    string
 	proxy     bool
 	namespace string
+	include   []string
+	exclude   []string
 }
 
 func jaegerCategory(hc *healthcheck.HealthChecker) *healthcheck.Category {
@@ -44,6 +46,7 @@ func jaegerCategory(hc *healthcheck.HealthChecker) *healthcheck.Category {
 
 	checkers = append(checkers,
 		*healthcheck.NewChecker("linkerd-jaeger extension Namespace exists").
+			WithID("linkerd-jaeger-extension-namespace-exists").
 			WithHintAnchor("l5d-jaeger-ns-exists").
 			Fatal().
 			WithCheck(func(ctx context.Context) error {
@@ -58,6 +61,7 @@ func jaegerCategory(hc *healthcheck.HealthChecker) *healthcheck.Category {
 
 	checkers = append(checkers,
 		*healthcheck.NewChecker("collector and jaeger service account exists").
+			WithID("collector-and-jaeger-service-account-exists").
 			WithHintAnchor("l5d-jaeger-sc-exists").
 			Fatal().
 			Warning().
@@ -68,6 +72,7 @@ func jaegerCategory(hc *healthcheck.HealthChecker) *healthcheck.Category {
 
 	checkers = append(checkers,
 		*healthcheck.NewChecker("collector config map exists").
+			WithID("collector-config-map-exists").
 			WithHintAnchor("l5d-jaeger-oc-cm-exists").
 			Warning().
 			WithCheck(func(ctx context.Context) error {
@@ -81,6 +86,7 @@ func jaegerCategory(hc *healthcheck.HealthChecker) *healthcheck.Category {
 
 	checkers = append(checkers,
 		*healthcheck.NewChecker("jaeger extension pods are injected").
+			WithID("jaeger-extension-pods-are-injected").
 			WithHintAnchor("l5d-jaeger-pods-injection").
 			Warning().
 			WithCheck(func(ctx context.Context) error {
@@ -94,6 +100,7 @@ func jaegerCategory(hc *healthcheck.HealthChecker) *healthcheck.Category {
 
 	checkers = append(checkers,
 		*healthcheck.NewChecker("jaeger extension pods are running").
+			WithID("jaeger-extension-pods-are-running").
 			WithHintAnchor("l5d-jaeger-pods-running").
 			Fatal().
 			WithRetryDeadline(hc.RetryDeadline).
@@ -116,6 +123,7 @@ func jaegerCategory(hc *healthcheck.HealthChecker) *healthcheck.Category {
 
 	checkers = append(checkers,
 		*healthcheck.NewChecker("jaeger extension proxies are healthy").
+			WithID("jaeger-extension-proxies-are-healthy").
 			WithHintAnchor("l5d-jaeger-proxy-healthy").
 			Fatal().
 			WithRetryDeadline(hc.RetryDeadline).
@@ -126,6 +134,7 @@ func jaegerCategory(hc *healthcheck.HealthChecker) *healthcheck.Category {
 
 	checkers = append(checkers,
 		*healthcheck.NewChecker("jaeger extension proxies are up-to-date").
+			WithID("jaeger-extension-proxies-are-up-to-date").
 			WithHintAnchor("l5d-jaeger-proxy-cp-version").
 			Warning().
 			WithCheck(func(ctx context.Context) error {
@@ -153,6 +162,7 @@ func jaegerCategory(hc *healthcheck.HealthChecker) *healthcheck.Category {
 
 	checkers = append(checkers,
 		*healthcheck.NewChecker("jaeger extension proxies and cli versions match").
+			WithID("jaeger-extension-proxies-and-cli-versions-match").
 			WithHintAnchor("l5d-jaeger-proxy-cli-version").
 			Warning().
 			WithCheck(func(ctx context.Context) error {
@@ -175,10 +185,12 @@ func newCheckOptions() *checkOptions {
 }
 
 func (options *checkOptions) validate() error {
-	if options.output != healthcheck.TableOutput && options.output != healthcheck.JSONOutput {
-		return fmt.Errorf("Invalid output type '%s'. Supported output types are: %s, %s", options.output, healthcheck.JSONOutput, healthcheck.TableOutput)
+	switch options.output {
+	case healthcheck.TableOutput, healthcheck.JSONOutput, healthcheck.JUnitOutput, healthcheck.SARIFOutput:
+	default:
+		return fmt.Errorf("Invalid output type '%s'. Supported output types are: %s, %s, %s, %s", options.output, healthcheck.JSONOutput, healthcheck.TableOutput, healthcheck.JUnitOutput, healthcheck.SARIFOutput)
 	}
-	return nil
+	return healthcheck.ValidatePatterns(append(options.include, options.exclude...))
 }
 
 // NewCmdCheck generates a new cobra command for the jaeger extension.
@@ -201,8 +213,9 @@ code.`,
 		},
 	}
 
-	cmd.Flags().StringVarP(&options.output, "output", "o", options.output, "Output format. One of: basic, json")
+	cmd.Flags().StringVarP(&options.output, "output", "o", options.output, "Output format. One of: basic, json, junit, sarif")
 	cmd.Flags().DurationVar(&options.wait, "wait", options.wait, "Maximum allowed time for all tests to pass")
+	pkgcmd.AddCheckSelectionFlags(cmd.Flags(), &options.include, &options.exclude)
 	cmd.Flags().BoolVar(&options.proxy, "proxy", options.proxy, "Also run data-plane checks, to determine if the data plane is healthy")
 	cmd.Flags().StringVarP(&options.namespace, "namespace", "n", options.namespace, "Namespace to use for --proxy checks (default: all namespaces)")
 
@@ -228,6 +241,8 @@ func configureAndRunChecks(wout io.Writer, werr io.Writer, options *checkOptions
 		ImpersonateGroup:      impersonateGroup,
 		APIAddr:               apiAddr,
 		RetryDeadline:         time.Now().Add(options.wait),
+		Include:               options.include,
+		Exclude:               options.exclude,
 		DataPlaneNamespace:    options.namespace,
 	})
 
@@ -44,8 +44,10 @@ const (
 )
 
 type checkOptions struct {
-	wait   time.Duration
-	output string
+	wait    time.Duration
+	output  string
+	include []string
+	exclude []string
 }
 
 func newCheckOptions() *checkOptions {
@@ -56,10 +58,12 @@ func newCheckOptions() *checkOptions {
 }
 
 func (options *checkOptions) validate() error {
-	if options.output != healthcheck.TableOutput && options.output != healthcheck.JSONOutput {
-		return fmt.Errorf("Invalid output type '%s'. Supported output types are: %s, %s", options.output, healthcheck.JSONOutput, healthcheck.TableOutput)
+	switch options.output {
+	case healthcheck.TableOutput, healthcheck.JSONOutput, healthcheck.JUnitOutput, healthcheck.SARIFOutput:
+	default:
+		return fmt.Errorf("Invalid output type '%s'. Supported output types are: %s, %s, %s, %s", options.output, healthcheck.JSONOutput, healthcheck.TableOutput, healthcheck.JUnitOutput, healthcheck.SARIFOutput)
 	}
-	return nil
+	return healthcheck.ValidatePatterns(append(options.include, options.exclude...))
 }
 
 type healthChecker struct {
@@ -101,8 +105,9 @@ non-zero exit code.`,
 			return configureAndRunChecks(stdout, stderr, options)
 		},
 	}
-	cmd.Flags().StringVarP(&options.output, "output", "o", options.output, "Output format. One of: basic, json")
+	cmd.Flags().StringVarP(&options.output, "output", "o", options.output, "Output format. One of: basic, json, junit, sarif")
 	cmd.Flags().DurationVar(&options.wait, "wait", options.wait, "Maximum allowed time for all tests to pass")
+	pkgcmd.AddCheckSelectionFlags(cmd.Flags(), &options.include, &options.exclude)
 	cmd.Flags().Bool("proxy", false, "")
 	cmd.Flags().MarkHidden("proxy")
 	cmd.Flags().StringP("namespace", "n", "", "")
@@ -132,6 +137,8 @@ func configureAndRunChecks(wout io.Writer, werr io.Writer, options *checkOptions
 		ImpersonateGroup:      impersonateGroup,
 		APIAddr:               apiAddr,
 		RetryDeadline:         time.Now().Add(options.wait),
+		Include:               options.include,
+		Exclude:               options.exclude,
 	})
 
 	err = linkerdHC.InitializeKubeAPIClient()
@@ -162,20 +169,24 @@ func multiclusterCategory(hc *healthChecker) *healthcheck.Category {
 	checkers := []healthcheck.Checker{}
 	checkers = append(checkers,
 		*healthcheck.NewChecker("Link CRD exists").
+			WithID("link-crd-exists").
 			WithHintAnchor("l5d-multicluster-link-crd-exists").
 			Fatal().
 			WithCheck(func(ctx context.Context) error { return hc.checkLinkCRD(ctx) }))
 	checkers = append(checkers,
 		*healthcheck.NewChecker("Link resources are valid").
+			WithID("link-resources-are-valid").
 			WithHintAnchor("l5d-multicluster-links-are-valid").
 			Fatal().
 			WithCheck(func(ctx context.Context) error { return hc.checkLinks(ctx) }))
 	checkers = append(checkers,
 		*healthcheck.NewChecker("remote cluster access credentials are valid").
+			WithID("remote-cluster-access-credentials-are-valid").
 			WithHintAnchor("l5d-smc-target-clusters-access").
 			WithCheck(func(ctx context.Context) error { return hc.checkRemoteClusterConnectivity(ctx) }))
 	checkers = append(checkers,
 		*healthcheck.NewChecker("clusters share trust anchors").
+			WithID("clusters-share-trust-anchors").
 			WithHintAnchor("l5d-multicluster-clusters-share-anchors").
 			WithCheck(func(ctx context.Context) error {
 				localAnchors, err := tls.DecodePEMCertificates(hc.LinkerdConfig().IdentityTrustAnchorsPEM)
@@ -186,12 +197,14 @@ func multiclusterCategory(hc *healthChecker) *healthcheck.Category {
 			}))
 	checkers = append(checkers,
 		*healthcheck.NewChecker("service mirror controller has required permissions").
+			WithID("service-mirror-controller-has-required-permissions").
 			WithHintAnchor("l5d-multicluster-source-rbac-correct").
 			WithCheck(func(ctx context.Context) error {
 				return hc.checkServiceMirrorLocalRBAC(ctx)
 			}))
 	checkers = append(checkers,
 		*healthcheck.NewChecker("service mirror controllers are running").
+			WithID("service-mirror-controllers-are-running").
 			WithHintAnchor("l5d-multicluster-service-mirror-running").
 			WithRetryDeadline(hc.RetryDeadline).
 			SurfaceErrorOnRetry().
@@ -200,18 +213,21 @@ func multiclusterCategory(hc *healthChecker) *healthcheck.Category {
 			}))
 	checkers = append(checkers,
 		*healthcheck.NewChecker("all gateway mirrors are healthy").
+			WithID("all-gateway-mirrors-are-healthy").
 			WithHintAnchor("l5d-multicluster-gateways-endpoints").
 			WithCheck(func(ctx context.Context) error {
 				return hc.checkIfGatewayMirrorsHaveEndpoints(ctx)
 			}))
 	checkers = append(checkers,
 		*healthcheck.NewChecker("all mirror services have endpoints").
+			WithID("all-mirror-services-have-endpoints").
 			WithHintAnchor("l5d-multicluster-services-endpoints").
 			WithCheck(func(ctx context.Context) error {
 				return hc.checkIfMirrorServicesHaveEndpoints(ctx)
 			}))
 	checkers = append(checkers,
 		*healthcheck.NewChecker("all mirror services are part of a Link").
+			WithID("all-mirror-services-are-part-of-a-link").
 			WithHintAnchor("l5d-multicluster-orphaned-services").
 			Warning().
 			WithCheck(func(ctx context.Context) error {
@@ -220,6 +236,7 @@ func multiclusterCategory(hc *healthChecker) *healthcheck.Category {
 
 	checkers = append(checkers,
 		*healthcheck.NewChecker("multicluster extension proxies are healthy").
+			WithID("multicluster-extension-proxies-are-healthy").
 			WithHintAnchor("l5d-multicluster-proxy-healthy").
 			Fatal().
 			WithRetryDeadline(hc.RetryDeadline).
@@ -236,6 +253,7 @@ func multiclusterCategory(hc *healthChecker) *healthcheck.Category {
 
 	checkers = append(checkers,
 		*healthcheck.NewChecker("multicluster extension proxies are up-to-date").
+			WithID("multicluster-extension-proxies-are-up-to-date").
 			WithHintAnchor("l5d-multicluster-proxy-cp-version").
 			Warning().
 			WithCheck(func(ctx context.Context) error {
@@ -268,6 +286,7 @@ func multiclusterCategory(hc *healthChecker) *healthcheck.Category {
 
 	checkers = append(checkers,
 		*healthcheck.NewChecker("multicluster extension proxies and cli versions match").
+			WithID("multicluster-extension-proxies-and-cli-versions-match").
 			WithHintAnchor("l5d-multicluster-proxy-cli-version").
 			Warning().
 			WithCheck(func(ctx context.Context) error {
@@ -0,0 +1,24 @@
+// +build ignore
+
+package main
+
+import (
+	"io/ioutil"
+
+	"github.com/linkerd/linkerd2/pkg/charts/linkerd2"
+	log "github.com/sirupsen/logrus"
+)
+
+// schemaPath is the path of the schema of the linkerd2 chart, relative to
+// this package
+const schemaPath = "../../../charts/linkerd2/values.schema.json"
+
+func main() {
+	schema, err := linkerd2.ValuesSchema()
+	if err != nil {
+		log.Fatalln(err)
+	}
+	if err := ioutil.WriteFile(schemaPath, append(schema, '\n'), 0644); err != nil {
+		log.Fatalln(err)
+	}
+}
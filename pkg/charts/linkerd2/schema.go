@@ -0,0 +1,123 @@
+//go:generate go run gen_schema.go
+
+package linkerd2
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"helm.sh/helm/v3/pkg/chartutil"
+)
+
+// fieldEnums lists the values allowed for the fields with the given JSON name,
+// wherever they are in the Values. The empty string is allowed, as it means
+// the default value is used.
+var fieldEnums = map[string][]interface{}{
+	"imagePullPolicy":     {"", "Always", "IfNotPresent", "Never"},
+	"pullPolicy":          {"", "Always", "IfNotPresent", "Never"},
+	"controllerLogFormat": {"", "plain", "json"},
+	"logFormat":           {"", "plain", "json"},
+	"scheme":              {"", "linkerd.io/tls", "kubernetes.io/tls"},
+}
+
+// chartValues are the top-level values of the chart which aren't part of the
+// Values type: the global values and the values of the partials subchart,
+// which Helm adds when rendering the chart, and the values only read by the
+// Helm templates or by the values-ha.yaml anchors
+var chartValues = map[string]reflect.Type{
+	chartutil.GlobalKey:    reflect.TypeOf(map[string]interface{}{}),
+	"partials":             reflect.TypeOf(map[string]interface{}{}),
+	"controllerResources":  reflect.TypeOf(Resources{}),
+	"spValidatorResources": reflect.TypeOf(Resources{}),
+}
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// ValuesSchema returns the JSON schema of the Values of the linkerd2 chart.
+// It's generated from the Values type, so that it's always in sync with the
+// values the chart supports, and it's committed as the values.schema.json of
+// the chart by `go generate`, for Helm to validate the values as well.
+func ValuesSchema() ([]byte, error) {
+	schema := typeSchema(reflect.TypeOf(Values{}))
+	properties := schema["properties"].(map[string]interface{})
+	for key, t := range chartValues {
+		properties[key] = typeSchema(t)
+	}
+	return json.MarshalIndent(schema, "", "  ")
+}
+
+// ValidateValues validates the given values, e.g. the ones provided through
+// the --values and --set flags, against the ValuesSchema. The returned error
+// lists the path of every invalid field.
+func ValidateValues(values map[string]interface{}) error {
+	schema, err := ValuesSchema()
+	if err != nil {
+		return err
+	}
+	if err := chartutil.ValidateAgainstSingleSchema(values, schema); err != nil {
+		return fmt.Errorf("values don't meet the specifications of the schema:\n%s", strings.TrimSpace(err.Error()))
+	}
+	return nil
+}
+
+// typeSchema returns the JSON schema of the values which can be unmarshaled
+// into the type t. Null is always accepted, as it means the default value is
+// used.
+func typeSchema(t reflect.Type) map[string]interface{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	// Types with a custom serialization, e.g. time.Time, can't be described
+	// from their fields
+	if t.Implements(jsonMarshalerType) || reflect.PtrTo(t).Implements(jsonMarshalerType) ||
+		t.Implements(textMarshalerType) || reflect.PtrTo(t).Implements(textMarshalerType) {
+		return map[string]interface{}{}
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		properties := map[string]interface{}{}
+		for name, field := range jsonFields(t) {
+			schema := typeSchema(field)
+			if enum, ok := fieldEnums[name]; ok {
+				schema["enum"] = append(append([]interface{}{}, enum...), nil)
+			}
+			properties[name] = schema
+		}
+		return map[string]interface{}{
+			"type":                 []string{"object", "null"},
+			"properties":           properties,
+			"additionalProperties": false,
+		}
+	case reflect.Map:
+		return map[string]interface{}{
+			"type":                 []string{"object", "null"},
+			"additionalProperties": typeSchema(t.Elem()),
+		}
+	case reflect.Slice, reflect.Array:
+		return map[string]interface{}{
+			"type":  []string{"array", "null"},
+			"items": typeSchema(t.Elem()),
+		}
+	case reflect.String:
+		// Numbers are accepted as well, as --set parses them into numbers
+		// even for string fields, e.g. --set proxy.opaquePorts=3306
+		return map[string]interface{}{"type": []string{"string", "integer", "number", "null"}}
+	case reflect.Bool:
+		return map[string]interface{}{"type": []string{"boolean", "null"}}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": []string{"integer", "null"}}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": []string{"number", "null"}}
+	default:
+		return map[string]interface{}{}
+	}
+}
@@ -0,0 +1,150 @@
+package linkerd2
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"sigs.k8s.io/yaml"
+)
+
+func TestValuesSchemaFile(t *testing.T) {
+	t.Run("Is up to date", func(t *testing.T) {
+		schema, err := ValuesSchema()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v\n", err)
+		}
+		committed, err := ioutil.ReadFile("../../../charts/linkerd2/values.schema.json")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v\n", err)
+		}
+		if string(committed) != string(schema)+"\n" {
+			t.Fatal("charts/linkerd2/values.schema.json is out of date, run `go generate ./pkg/charts/linkerd2` to update it")
+		}
+	})
+
+	t.Run("Accepts the values of the chart rendered by Helm", func(t *testing.T) {
+		chart, err := loader.LoadDir("../../../charts/linkerd2")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v\n", err)
+		}
+		if chart.Schema == nil {
+			t.Fatal("Expected the chart to have a values schema")
+		}
+		for _, valuesFile := range []string{"values.yaml", "values-ha.yaml"} {
+			values, err := chartutil.ReadValuesFile("../../../charts/linkerd2/" + valuesFile)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v\n", err)
+			}
+			_, err = chartutil.ToRenderValues(chart, values, chartutil.ReleaseOptions{Name: "linkerd", Namespace: "linkerd"}, nil)
+			if err != nil {
+				t.Fatalf("Unexpected error with %s: %v\n", valuesFile, err)
+			}
+		}
+	})
+}
+
+func TestValidateValues(t *testing.T) {
+	t.Run("Accepts the default values", func(t *testing.T) {
+		for _, ha := range []bool{false, true} {
+			values, err := readDefaults(ha)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v\n", err)
+			}
+			valuesMap, err := values.ToMap()
+			if err != nil {
+				t.Fatalf("Unexpected error: %v\n", err)
+			}
+			if err := ValidateValues(valuesMap); err != nil {
+				t.Fatalf("Unexpected error: %v\n", err)
+			}
+		}
+	})
+
+	testCases := []struct {
+		name     string
+		values   string
+		expected []string
+	}{
+		{
+			name: "valid values",
+			values: `
+controllerReplicas: 3
+proxy:
+  logFormat: json
+  opaquePorts: 3306
+  image:
+    pullPolicy: IfNotPresent
+podAnnotations:
+  foo: bar
+nodeSelector: null
+`,
+		},
+		{
+			name: "unknown fields",
+			values: `
+controllerReplica: 3
+proxy:
+  image:
+    tag: foo
+`,
+			expected: []string{
+				"(root): Additional property controllerReplica is not allowed",
+				"proxy.image: Additional property tag is not allowed",
+			},
+		},
+		{
+			name: "invalid types",
+			values: `
+controllerReplicas: three
+proxy:
+  logLevel:
+    linkerd: debug
+podAnnotations:
+  foo:
+  - bar
+`,
+			expected: []string{
+				"controllerReplicas: Invalid type. Expected: [integer,null], given: string",
+				"proxy.logLevel: Invalid type. Expected: [string,integer,number,null], given: object",
+				"podAnnotations.foo: Invalid type. Expected: [string,integer,number,null], given: array",
+			},
+		},
+		{
+			name: "invalid enum value",
+			values: `
+proxy:
+  logFormat: xml
+`,
+			expected: []string{"proxy.logFormat: proxy.logFormat must be one of the following"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			var values map[string]interface{}
+			if err := yaml.Unmarshal([]byte(tc.values), &values); err != nil {
+				t.Fatalf("Unexpected error: %v\n", err)
+			}
+
+			err := ValidateValues(values)
+			if len(tc.expected) == 0 {
+				if err != nil {
+					t.Fatalf("Unexpected error: %v\n", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("Expected an error, got nothing")
+			}
+			for _, expected := range tc.expected {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("Expected error to contain %q, got %q", expected, err)
+				}
+			}
+		})
+	}
+}
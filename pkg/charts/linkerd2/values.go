@@ -3,6 +3,9 @@ package linkerd2
 import (
 	"errors"
 	"fmt"
+	"reflect"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/imdario/mergo"
@@ -66,12 +69,14 @@ type (
 		DebugContainer   *DebugContainer   `json:"debugContainer"`
 		ProxyInjector    *ProxyInjector    `json:"proxyInjector"`
 		ProfileValidator *ProfileValidator `json:"profileValidator"`
+		HealthChecker    *HealthChecker    `json:"healthChecker"`
 		NodeSelector     map[string]string `json:"nodeSelector"`
 		Tolerations      []interface{}     `json:"tolerations"`
 		Stage            string            `json:"stage"`
 
 		DestinationResources   *Resources `json:"destinationResources"`
 		HeartbeatResources     *Resources `json:"heartbeatResources"`
+		HealthCheckerResources *Resources `json:"healthCheckerResources"`
 		IdentityResources      *Resources `json:"identityResources"`
 		ProxyInjectorResources *Resources `json:"proxyInjectorResources"`
 
@@ -188,7 +193,8 @@ type (
 	// ProxyInjector has all the proxy injector's Helm variables
 	ProxyInjector struct {
 		*TLS
-		NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector"`
+		NamespaceSelector      *metav1.LabelSelector `json:"namespaceSelector"`
+		EnforceInjectionPolicy bool                  `json:"enforceInjectionPolicy"`
 	}
 
 	// ProfileValidator has all the profile validator's Helm variables
@@ -197,6 +203,13 @@ type (
 		NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector"`
 	}
 
+	// HealthChecker has all the health checker's Helm variables
+	HealthChecker struct {
+		Enabled            bool   `json:"enabled"`
+		Interval           string `json:"interval"`
+		DataPlaneNamespace string `json:"dataPlaneNamespace"`
+	}
+
 	// TLS has a pair of PEM-encoded key and certificate variables used in the
 	// Helm templates
 	TLS struct {
@@ -324,3 +337,88 @@ func (v *Values) String() string {
 	bytes, _ := yaml.Marshal(v)
 	return string(bytes)
 }
+
+// UnknownFields returns the paths of the fields in the given YAML values which
+// aren't part of the Values type, e.g. because they were removed from the
+// chart after being set with a previous version
+func UnknownFields(rawValues []byte) ([]string, error) {
+	var values map[string]interface{}
+	if err := yaml.Unmarshal(rawValues, &values); err != nil {
+		return nil, err
+	}
+	unknown := unknownFields("", values, reflect.TypeOf(Values{}))
+	sort.Strings(unknown)
+	return unknown, nil
+}
+
+func unknownFields(path string, value interface{}, t reflect.Type) []string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	var unknown []string
+	switch v := value.(type) {
+	case map[string]interface{}:
+		switch t.Kind() {
+		case reflect.Struct:
+			fields := jsonFields(t)
+			for key, val := range v {
+				fieldPath := joinPath(path, key)
+				field, ok := fields[key]
+				if !ok {
+					unknown = append(unknown, fieldPath)
+					continue
+				}
+				unknown = append(unknown, unknownFields(fieldPath, val, field)...)
+			}
+		case reflect.Map:
+			for key, val := range v {
+				unknown = append(unknown, unknownFields(joinPath(path, key), val, t.Elem())...)
+			}
+		}
+	case []interface{}:
+		if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+			for i, val := range v {
+				unknown = append(unknown, unknownFields(fmt.Sprintf("%s[%d]", path, i), val, t.Elem())...)
+			}
+		}
+	}
+	return unknown
+}
+
+// jsonFields returns the types of the fields of the struct type t, keyed by
+// their JSON name, including the fields of its embedded structs
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" && field.Anonymous {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				for name, typ := range jsonFields(embedded) {
+					fields[name] = typ
+				}
+				continue
+			}
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field.Type
+	}
+	return fields
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return fmt.Sprintf("%s.%s", path, key)
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
 
 	"github.com/linkerd/linkerd2/pkg/version"
 )
@@ -127,6 +128,7 @@ func TestNewValues(t *testing.T) {
 
 		ProxyInjector:    &ProxyInjector{TLS: &TLS{}, NamespaceSelector: namespaceSelector},
 		ProfileValidator: &ProfileValidator{TLS: &TLS{}, NamespaceSelector: namespaceSelector},
+		HealthChecker:    &HealthChecker{Interval: "1m"},
 	}
 
 	// pin the versions to ensure consistent test result.
@@ -199,3 +201,63 @@ func TestNewValues(t *testing.T) {
 		}
 	})
 }
+
+func TestUnknownFields(t *testing.T) {
+	defaults, err := NewValues()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v\n", err)
+	}
+	rawDefaults, err := yaml.Marshal(defaults)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v\n", err)
+	}
+
+	testCases := []struct {
+		name     string
+		values   string
+		expected []string
+	}{
+		{
+			name:   "defaults",
+			values: string(rawDefaults),
+		},
+		{
+			name: "known fields",
+			values: `
+proxy:
+  logLevel: debug
+podAnnotations:
+  foo: bar
+imagePullSecrets:
+- name: foo
+`,
+		},
+		{
+			name: "removed fields",
+			values: `
+removedFlag: true
+proxy:
+  logLevel: debug
+  removed: true
+proxyInit:
+  image:
+    pullPolicy: Always
+    tag: foo
+`,
+			expected: []string{"proxy.removed", "proxyInit.image.tag", "removedFlag"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			unknown, err := UnknownFields([]byte(tc.values))
+			if err != nil {
+				t.Fatalf("Unexpected error: %v\n", err)
+			}
+			if !reflect.DeepEqual(tc.expected, unknown) {
+				t.Errorf("Expected unknown fields %v, got %v", tc.expected, unknown)
+			}
+		})
+	}
+}
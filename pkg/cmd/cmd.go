@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
@@ -11,7 +12,9 @@ import (
 	"github.com/linkerd/linkerd2/pkg/k8s/resource"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
@@ -61,6 +64,30 @@ func Uninstall(ctx context.Context, k8sAPI *k8s.KubernetesAPI, selector string)
 	return nil
 }
 
+// Delete deletes the resources matching the given selector from the cluster,
+// instead of rendering them like Uninstall does, and writes the deleted
+// resources to w
+func Delete(ctx context.Context, k8sAPI *k8s.KubernetesAPI, selector string, w io.Writer) error {
+	resources, err := resource.FetchKubernetesResources(ctx, k8sAPI,
+		metav1.ListOptions{LabelSelector: selector},
+	)
+	if err != nil {
+		return err
+	}
+
+	if len(resources) == 0 {
+		return errors.New("No resources found to uninstall")
+	}
+	for _, r := range resources {
+		err := r.Delete(ctx, k8sAPI)
+		if err != nil && !kerrors.IsNotFound(err) {
+			return fmt.Errorf("error deleting %s/%s: %v", strings.ToLower(r.Kind), r.Name, err)
+		}
+		fmt.Fprintf(w, "%s/%s deleted\n", strings.ToLower(r.Kind), r.Name)
+	}
+	return nil
+}
+
 // ConfigureNamespaceFlagCompletion sets up resource-aware completion for command
 // flags that accept a namespace name
 func ConfigureNamespaceFlagCompletion(
@@ -95,10 +122,17 @@ func ConfigureNamespaceFlagCompletion(
 func ConfigureOutputFlagCompletion(cmd *cobra.Command) {
 	cmd.RegisterFlagCompletionFunc("output",
 		func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-			return []string{"basic", "json", "short", "table"}, cobra.ShellCompDirectiveDefault
+			return []string{"basic", "json", "short", "table", "junit", "sarif"}, cobra.ShellCompDirectiveDefault
 		})
 }
 
+// AddCheckSelectionFlags adds the --include and --exclude flags, selecting
+// the checks run by a check command
+func AddCheckSelectionFlags(flags *pflag.FlagSet, include, exclude *[]string) {
+	flags.StringSliceVar(include, "include", *include, "Only run the checks matching these category IDs or check IDs, which can be shell patterns (e.g. linkerd-identity/*-valid); the check IDs are part of the json output")
+	flags.StringSliceVar(exclude, "exclude", *exclude, "Skip the checks matching these category IDs or check IDs, which can be shell patterns")
+}
+
 // ConfigureKubeContextFlagCompletion sets up resource-aware completion for command
 // flags based off of a kubeconfig
 func ConfigureKubeContextFlagCompletion(cmd *cobra.Command, kubeconfigPath string) {
@@ -77,6 +77,16 @@ const (
 	// to determine if a control plane is already installed.
 	LinkerdPreInstallGlobalResourcesChecks CategoryID = "pre-linkerd-global-resources"
 
+	// LinkerdPreUpgradeChecks adds checks to validate that the control plane
+	// can be upgraded to the version of the CLI, by comparing the upgrade
+	// manifest with the installed resources: the ServiceProfile CRD must be
+	// compatible, the stored values must still be supported, the issuer
+	// certificate must be valid and the proxies must be within the supported
+	// version skew. These checks run as part of `linkerd check --pre --upgrade`,
+	// and are dependent on the output of KubernetesAPIChecks, so those checks
+	// must be added first.
+	LinkerdPreUpgradeChecks CategoryID = "pre-linkerd-upgrade"
+
 	// LinkerdConfigChecks enabled by `linkerd check config`
 
 	// LinkerdConfigChecks adds a series of checks to validate that the Linkerd
@@ -96,6 +106,13 @@ const (
 	// that of the for the injector and sp webhooks and the tap api svc
 	LinkerdWebhooksAndAPISvcTLS CategoryID = "linkerd-webhooks-and-apisvc-tls"
 
+	// LinkerdWebhooksChecks sends a synthetic AdmissionReview to each of the
+	// webhooks of the control plane and of the installed extensions, checking
+	// that they respond in a timely manner and that their caBundle matches
+	// their serving certificate. As it port-forwards to each webhook, it only
+	// runs when requested with `linkerd check --webhooks`
+	LinkerdWebhooksChecks CategoryID = "linkerd-webhooks"
+
 	// LinkerdIdentityDataPlane checks that integrity of the mTLS
 	// certificates that the proxies are configured with and tries to
 	// report useful information with respect to whether the configuration
@@ -271,6 +288,12 @@ func (e *VerboseSuccess) Error() string {
 
 // Checker is a smallest unit performing a single check
 type Checker struct {
+	// id identifies the check within its category; prefixed by the category
+	// ID, it's used to select checks with the --include and --exclude flags,
+	// so it shouldn't change across versions (default: derived from the
+	// description)
+	id string
+
 	// description is the short description that's printed to the command line
 	// when the check is executed
 	description string
@@ -310,6 +333,12 @@ func NewChecker(description string) *Checker {
 	}
 }
 
+// WithID returns a checker with the given ID
+func (c *Checker) WithID(id string) *Checker {
+	c.id = id
+	return c
+}
+
 // WithHintAnchor returns a checker with the given hint anchor
 func (c *Checker) WithHintAnchor(hint string) *Checker {
 	c.hintAnchor = hint
@@ -350,12 +379,16 @@ func (c *Checker) WithCheck(check func(context.Context) error) *Checker {
 // Note there exists an analogous user-facing type, `cmd.check`, for output via
 // `linkerd check -o json`.
 type CheckResult struct {
+	// ID is the ID of the check, prefixed by its category ID
+	ID          string `json:"-"`
 	Category    CategoryID
 	Description string
 	HintURL     string
 	Retry       bool
 	Warning     bool
 	Err         error
+	// Duration is the time spent running the check, excluding retries
+	Duration time.Duration `json:"-"`
 }
 
 // CheckObserver receives the results of each check.
@@ -401,6 +434,11 @@ type Options struct {
 	RetryDeadline         time.Time
 	CNIEnabled            bool
 	InstallManifest       string
+	UpgradeManifest       string
+	// Include and Exclude select the checks to run by category ID or by
+	// check ID, see CheckSelected
+	Include []string
+	Exclude []string
 }
 
 // HealthChecker encapsulates all health check checkers, and clients required to
@@ -420,6 +458,8 @@ type HealthChecker struct {
 	issuerCert       *tls.Cred
 	trustAnchors     []*x509.Certificate
 	cniDaemonSet     *appsv1.DaemonSet
+	webhookProbes    []webhookProbe
+	proxyVersions    []ProxyVersionCount
 }
 
 // Runner is implemented by any health-checkers that can be triggered with RunChecks()
@@ -507,6 +547,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 			KubernetesAPIChecks,
 			[]Checker{
 				{
+					id:          "can-initialize-the-client",
 					description: "can initialize the client",
 					hintAnchor:  "k8s-api",
 					fatal:       true,
@@ -516,6 +557,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "can-query-the-kubernetes-api",
 					description: "can query the Kubernetes API",
 					hintAnchor:  "k8s-api",
 					fatal:       true,
@@ -531,6 +573,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 			KubernetesVersionChecks,
 			[]Checker{
 				{
+					id:          "is-running-the-minimum-kubernetes-api-version",
 					description: "is running the minimum Kubernetes API version",
 					hintAnchor:  "k8s-version",
 					check: func(context.Context) error {
@@ -538,6 +581,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "is-running-the-minimum-kubectl-version",
 					description: "is running the minimum kubectl version",
 					hintAnchor:  "kubectl-version",
 					check: func(context.Context) error {
@@ -551,6 +595,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 			LinkerdPreInstallChecks,
 			[]Checker{
 				{
+					id:          "control-plane-namespace-does-not-already-exist",
 					description: "control plane namespace does not already exist",
 					hintAnchor:  "pre-ns",
 					check: func(ctx context.Context) error {
@@ -558,6 +603,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "can-create-non-namespaced-resources",
 					description: "can create non-namespaced resources",
 					hintAnchor:  "pre-k8s-cluster-k8s",
 					check: func(ctx context.Context) error {
@@ -565,6 +611,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "can-create-serviceaccounts",
 					description: "can create ServiceAccounts",
 					hintAnchor:  "pre-k8s",
 					check: func(ctx context.Context) error {
@@ -572,6 +619,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "can-create-services",
 					description: "can create Services",
 					hintAnchor:  "pre-k8s",
 					check: func(ctx context.Context) error {
@@ -579,6 +627,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "can-create-deployments",
 					description: "can create Deployments",
 					hintAnchor:  "pre-k8s",
 					check: func(ctx context.Context) error {
@@ -586,6 +635,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "can-create-cronjobs",
 					description: "can create CronJobs",
 					hintAnchor:  "pre-k8s",
 					check: func(ctx context.Context) error {
@@ -593,6 +643,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "can-create-configmaps",
 					description: "can create ConfigMaps",
 					hintAnchor:  "pre-k8s",
 					check: func(ctx context.Context) error {
@@ -600,6 +651,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "can-create-secrets",
 					description: "can create Secrets",
 					hintAnchor:  "pre-k8s",
 					check: func(ctx context.Context) error {
@@ -607,6 +659,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "can-read-secrets",
 					description: "can read Secrets",
 					hintAnchor:  "pre-k8s",
 					check: func(ctx context.Context) error {
@@ -614,6 +667,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "can-read-extension-apiserver-authentication-configmap",
 					description: "can read extension-apiserver-authentication configmap",
 					hintAnchor:  "pre-k8s",
 					check: func(ctx context.Context) error {
@@ -621,6 +675,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "no-clock-skew-detected",
 					description: "no clock skew detected",
 					hintAnchor:  "pre-k8s-clock-skew",
 					warning:     true,
@@ -635,6 +690,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 			LinkerdPreInstallCapabilityChecks,
 			[]Checker{
 				{
+					id:          "has-net-admin-capability",
 					description: "has NET_ADMIN capability",
 					hintAnchor:  "pre-k8s-cluster-net-admin",
 					warning:     true,
@@ -643,6 +699,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "has-net-raw-capability",
 					description: "has NET_RAW capability",
 					hintAnchor:  "pre-k8s-cluster-net-raw",
 					warning:     true,
@@ -657,6 +714,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 			LinkerdPreInstallGlobalResourcesChecks,
 			[]Checker{
 				{
+					id:          "no-clusterroles-exist",
 					description: "no ClusterRoles exist",
 					hintAnchor:  "pre-l5d-existence",
 					check: func(ctx context.Context) error {
@@ -664,6 +722,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "no-clusterrolebindings-exist",
 					description: "no ClusterRoleBindings exist",
 					hintAnchor:  "pre-l5d-existence",
 					check: func(ctx context.Context) error {
@@ -671,6 +730,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "no-customresourcedefinitions-exist",
 					description: "no CustomResourceDefinitions exist",
 					hintAnchor:  "pre-l5d-existence",
 					check: func(ctx context.Context) error {
@@ -678,6 +738,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "no-mutatingwebhookconfigurations-exist",
 					description: "no MutatingWebhookConfigurations exist",
 					hintAnchor:  "pre-l5d-existence",
 					check: func(ctx context.Context) error {
@@ -685,6 +746,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "no-validatingwebhookconfigurations-exist",
 					description: "no ValidatingWebhookConfigurations exist",
 					hintAnchor:  "pre-l5d-existence",
 					check: func(ctx context.Context) error {
@@ -692,6 +754,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "no-podsecuritypolicies-exist",
 					description: "no PodSecurityPolicies exist",
 					hintAnchor:  "pre-l5d-existence",
 					check: func(ctx context.Context) error {
@@ -701,10 +764,50 @@ func (hc *HealthChecker) allCategories() []*Category {
 			},
 			false,
 		),
+		NewCategory(
+			LinkerdPreUpgradeChecks,
+			[]Checker{
+				{
+					id:          "serviceprofile-crd-is-compatible",
+					description: "ServiceProfile CRD is compatible",
+					hintAnchor:  "pre-l5d-upgrade-crds",
+					check: func(ctx context.Context) error {
+						return hc.checkServiceProfileCRDCompatible(ctx)
+					},
+				},
+				{
+					id:          "no-removed-values-in-linkerd-config-overrides",
+					description: "no removed values in linkerd-config-overrides",
+					hintAnchor:  "pre-l5d-upgrade-removed-values",
+					warning:     true,
+					check: func(ctx context.Context) error {
+						return hc.checkConfigOverridesRemovedValues(ctx)
+					},
+				},
+				{
+					id:          "issuer-cert-is-valid-for-the-upgrade",
+					description: "issuer certificate is valid for the upgrade",
+					hintAnchor:  "pre-l5d-upgrade-issuer-cert",
+					check: func(ctx context.Context) error {
+						return hc.checkUpgradeIssuerCert(ctx)
+					},
+				},
+				{
+					id:          "proxies-are-within-the-supported-version-skew",
+					description: "proxies are within the supported version skew",
+					hintAnchor:  "pre-l5d-upgrade-proxy-skew",
+					check: func(ctx context.Context) error {
+						return hc.checkProxyVersionSkew(ctx)
+					},
+				},
+			},
+			false,
+		),
 		NewCategory(
 			LinkerdControlPlaneExistenceChecks,
 			[]Checker{
 				{
+					id:          "linkerd-config-config-map-exists",
 					description: "'linkerd-config' config map exists",
 					hintAnchor:  "l5d-existence-linkerd-config",
 					fatal:       true,
@@ -714,6 +817,16 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "linkerd-config-values-are-valid",
+					description: "'linkerd-config' values are valid",
+					hintAnchor:  "l5d-existence-linkerd-config-values",
+					warning:     true,
+					check: func(ctx context.Context) error {
+						return hc.checkLinkerdConfigValues(ctx)
+					},
+				},
+				{
+					id:          "heartbeat-serviceaccount-exist",
 					description: "heartbeat ServiceAccount exist",
 					hintAnchor:  "l5d-existence-sa",
 					fatal:       true,
@@ -725,6 +838,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:            "control-plane-replica-sets-are-ready",
 					description:   "control plane replica sets are ready",
 					hintAnchor:    "l5d-existence-replicasets",
 					retryDeadline: hc.RetryDeadline,
@@ -738,6 +852,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:                  "no-unschedulable-pods",
 					description:         "no unschedulable pods",
 					hintAnchor:          "l5d-existence-unschedulable-pods",
 					retryDeadline:       hc.RetryDeadline,
@@ -754,6 +869,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:                  "control-plane-pods-are-ready",
 					description:         "control plane pods are ready",
 					hintAnchor:          "l5d-api-control-ready",
 					retryDeadline:       hc.RetryDeadline,
@@ -769,6 +885,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "cluster-networks-contains-all-node-podcidrs",
 					description: "cluster networks contains all node podCIDRs",
 					hintAnchor:  "l5d-cluster-networks-cidr",
 					check: func(ctx context.Context) error {
@@ -788,6 +905,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 			LinkerdConfigChecks,
 			[]Checker{
 				{
+					id:          "control-plane-namespace-exists",
 					description: "control plane Namespace exists",
 					hintAnchor:  "l5d-existence-ns",
 					fatal:       true,
@@ -796,6 +914,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "control-plane-clusterroles-exist",
 					description: "control plane ClusterRoles exist",
 					hintAnchor:  "l5d-existence-cr",
 					fatal:       true,
@@ -804,6 +923,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "control-plane-clusterrolebindings-exist",
 					description: "control plane ClusterRoleBindings exist",
 					hintAnchor:  "l5d-existence-crb",
 					fatal:       true,
@@ -812,6 +932,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "control-plane-serviceaccounts-exist",
 					description: "control plane ServiceAccounts exist",
 					hintAnchor:  "l5d-existence-sa",
 					fatal:       true,
@@ -820,6 +941,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "control-plane-customresourcedefinitions-exist",
 					description: "control plane CustomResourceDefinitions exist",
 					hintAnchor:  "l5d-existence-crd",
 					fatal:       true,
@@ -828,6 +950,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "control-plane-mutatingwebhookconfigurations-exist",
 					description: "control plane MutatingWebhookConfigurations exist",
 					hintAnchor:  "l5d-existence-mwc",
 					fatal:       true,
@@ -836,6 +959,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "control-plane-validatingwebhookconfigurations-exist",
 					description: "control plane ValidatingWebhookConfigurations exist",
 					hintAnchor:  "l5d-existence-vwc",
 					fatal:       true,
@@ -844,6 +968,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "control-plane-podsecuritypolicies-exist",
 					description: "control plane PodSecurityPolicies exist",
 					hintAnchor:  "l5d-existence-psp",
 					fatal:       true,
@@ -858,6 +983,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 			LinkerdCNIPluginChecks,
 			[]Checker{
 				{
+					id:          "cni-plugin-configmap-exists",
 					description: "cni plugin ConfigMap exists",
 					hintAnchor:  "cni-plugin-cm-exists",
 					fatal:       true,
@@ -870,6 +996,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "cni-plugin-podsecuritypolicy-exists",
 					description: "cni plugin PodSecurityPolicy exists",
 					hintAnchor:  "cni-plugin-psp-exists",
 					fatal:       true,
@@ -886,6 +1013,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "cni-plugin-clusterrole-exists",
 					description: "cni plugin ClusterRole exists",
 					hintAnchor:  "cni-plugin-cr-exists",
 					fatal:       true,
@@ -901,6 +1029,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "cni-plugin-clusterrolebinding-exists",
 					description: "cni plugin ClusterRoleBinding exists",
 					hintAnchor:  "cni-plugin-crb-exists",
 					fatal:       true,
@@ -916,6 +1045,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "cni-plugin-role-exists",
 					description: "cni plugin Role exists",
 					hintAnchor:  "cni-plugin-r-exists",
 					fatal:       true,
@@ -931,6 +1061,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "cni-plugin-rolebinding-exists",
 					description: "cni plugin RoleBinding exists",
 					hintAnchor:  "cni-plugin-rb-exists",
 					fatal:       true,
@@ -946,6 +1077,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "cni-plugin-serviceaccount-exists",
 					description: "cni plugin ServiceAccount exists",
 					hintAnchor:  "cni-plugin-sa-exists",
 					fatal:       true,
@@ -961,6 +1093,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "cni-plugin-daemonset-exists",
 					description: "cni plugin DaemonSet exists",
 					hintAnchor:  "cni-plugin-ds-exists",
 					fatal:       true,
@@ -976,6 +1109,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:                  "cni-plugin-pod-is-running-on-all-nodes",
 					description:         "cni plugin pod is running on all nodes",
 					hintAnchor:          "cni-plugin-ready",
 					retryDeadline:       hc.RetryDeadline,
@@ -1004,6 +1138,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 			LinkerdIdentity,
 			[]Checker{
 				{
+					id:          "certificate-config-is-valid",
 					description: "certificate config is valid",
 					hintAnchor:  "l5d-identity-cert-config-valid",
 					fatal:       true,
@@ -1013,6 +1148,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "trust-anchors-are-using-supported-crypto-algorithm",
 					description: "trust anchors are using supported crypto algorithm",
 					hintAnchor:  "l5d-identity-trustAnchors-use-supported-crypto",
 					fatal:       true,
@@ -1030,6 +1166,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "trust-anchors-are-within-their-validity-period",
 					description: "trust anchors are within their validity period",
 					hintAnchor:  "l5d-identity-trustAnchors-are-time-valid",
 					fatal:       true,
@@ -1048,6 +1185,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "trust-anchors-are-valid-for-at-least-60-days",
 					description: "trust anchors are valid for at least 60 days",
 					hintAnchor:  "l5d-identity-trustAnchors-not-expiring-soon",
 					warning:     true,
@@ -1065,6 +1203,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "issuer-cert-is-using-supported-crypto-algorithm",
 					description: "issuer cert is using supported crypto algorithm",
 					hintAnchor:  "l5d-identity-issuer-cert-uses-supported-crypto",
 					fatal:       true,
@@ -1076,6 +1215,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "issuer-cert-is-within-its-validity-period",
 					description: "issuer cert is within its validity period",
 					hintAnchor:  "l5d-identity-issuer-cert-is-time-valid",
 					fatal:       true,
@@ -1087,6 +1227,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "issuer-cert-is-valid-for-at-least-60-days",
 					description: "issuer cert is valid for at least 60 days",
 					warning:     true,
 					hintAnchor:  "l5d-identity-issuer-cert-not-expiring-soon",
@@ -1098,6 +1239,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "issuer-cert-is-issued-by-the-trust-anchor",
 					description: "issuer cert is issued by the trust anchor",
 					hintAnchor:  "l5d-identity-issuer-cert-issued-by-trust-anchor",
 					check: func(ctx context.Context) error {
@@ -1111,6 +1253,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 			LinkerdWebhooksAndAPISvcTLS,
 			[]Checker{
 				{
+					id:          "proxy-injector-webhook-has-valid-cert",
 					description: "proxy-injector webhook has valid cert",
 					hintAnchor:  "l5d-proxy-injector-webhook-cert-valid",
 					fatal:       true,
@@ -1132,6 +1275,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "proxy-injector-cert-is-valid-for-at-least-60-days",
 					description: "proxy-injector cert is valid for at least 60 days",
 					warning:     true,
 					hintAnchor:  "l5d-proxy-injector-webhook-cert-not-expiring-soon",
@@ -1148,6 +1292,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "sp-validator-webhook-has-valid-cert",
 					description: "sp-validator webhook has valid cert",
 					hintAnchor:  "l5d-sp-validator-webhook-cert-valid",
 					fatal:       true,
@@ -1168,6 +1313,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "sp-validator-cert-is-valid-for-at-least-60-days",
 					description: "sp-validator cert is valid for at least 60 days",
 					warning:     true,
 					hintAnchor:  "l5d-sp-validator-webhook-cert-not-expiring-soon",
@@ -1186,10 +1332,54 @@ func (hc *HealthChecker) allCategories() []*Category {
 			},
 			false,
 		),
+		NewCategory(
+			LinkerdWebhooksChecks,
+			[]Checker{
+				{
+					id:          "webhooks-respond-to-admission-reviews",
+					description: "webhooks respond to admission reviews",
+					hintAnchor:  "l5d-webhooks-respond",
+					check: func(ctx context.Context) error {
+						probes, err := hc.getWebhookProbes(ctx)
+						if err != nil {
+							return err
+						}
+						return checkWebhookResponses(probes)
+					},
+				},
+				{
+					id:          "webhooks-cabundles-match-their-serving-certificates",
+					description: "webhooks caBundles match their serving certificates",
+					hintAnchor:  "l5d-webhooks-cabundle",
+					check: func(ctx context.Context) error {
+						probes, err := hc.getWebhookProbes(ctx)
+						if err != nil {
+							return err
+						}
+						return checkWebhookCABundles(probes)
+					},
+				},
+				{
+					id:          "webhooks-respond-in-a-timely-manner",
+					description: "webhooks respond in a timely manner",
+					hintAnchor:  "l5d-webhooks-latency",
+					warning:     true,
+					check: func(ctx context.Context) error {
+						probes, err := hc.getWebhookProbes(ctx)
+						if err != nil {
+							return err
+						}
+						return checkWebhookLatencies(probes)
+					},
+				},
+			},
+			false,
+		),
 		NewCategory(
 			LinkerdIdentityDataPlane,
 			[]Checker{
 				{
+					id:          "data-plane-proxies-certificate-match-ca",
 					description: "data plane proxies certificate match CA",
 					hintAnchor:  "l5d-identity-data-plane-proxies-certs-match-ca",
 					warning:     true,
@@ -1204,6 +1394,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 			LinkerdVersionChecks,
 			[]Checker{
 				{
+					id:          "can-determine-the-latest-version",
 					description: "can determine the latest version",
 					hintAnchor:  "l5d-version-latest",
 					warning:     true,
@@ -1221,6 +1412,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "cli-is-up-to-date",
 					description: "cli is up-to-date",
 					hintAnchor:  "l5d-version-cli",
 					warning:     true,
@@ -1235,6 +1427,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 			LinkerdControlPlaneVersionChecks,
 			[]Checker{
 				{
+					id:            "can-retrieve-the-control-plane-version",
 					description:   "can retrieve the control plane version",
 					hintAnchor:    "l5d-version-control",
 					retryDeadline: hc.RetryDeadline,
@@ -1245,6 +1438,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "control-plane-is-up-to-date",
 					description: "control plane is up-to-date",
 					hintAnchor:  "l5d-version-control",
 					warning:     true,
@@ -1253,6 +1447,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "control-plane-and-cli-versions-match",
 					description: "control plane and cli versions match",
 					hintAnchor:  "l5d-version-control",
 					warning:     true,
@@ -1270,6 +1465,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 			LinkerdControlPlaneProxyChecks,
 			[]Checker{
 				{
+					id:                  "control-plane-proxies-are-healthy",
 					description:         "control plane proxies are healthy",
 					hintAnchor:          "l5d-cp-proxy-healthy",
 					retryDeadline:       hc.RetryDeadline,
@@ -1280,6 +1476,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "control-plane-proxies-are-up-to-date",
 					description: "control plane proxies are up-to-date",
 					hintAnchor:  "l5d-cp-proxy-version",
 					warning:     true,
@@ -1293,6 +1490,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "control-plane-proxies-and-cli-versions-match",
 					description: "control plane proxies and cli versions match",
 					hintAnchor:  "l5d-cp-proxy-cli-version",
 					warning:     true,
@@ -1312,6 +1510,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 			LinkerdDataPlaneChecks,
 			[]Checker{
 				{
+					id:          "data-plane-namespace-exists",
 					description: "data plane namespace exists",
 					hintAnchor:  "l5d-data-plane-exists",
 					fatal:       true,
@@ -1324,6 +1523,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:            "data-plane-proxies-are-ready",
 					description:   "data plane proxies are ready",
 					hintAnchor:    "l5d-data-plane-ready",
 					retryDeadline: hc.RetryDeadline,
@@ -1338,6 +1538,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "data-plane-is-up-to-date",
 					description: "data plane is up-to-date",
 					hintAnchor:  "l5d-data-plane-version",
 					warning:     true,
@@ -1351,6 +1552,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "data-plane-and-cli-versions-match",
 					description: "data plane and cli versions match",
 					hintAnchor:  "l5d-data-plane-cli-version",
 					warning:     true,
@@ -1364,6 +1566,51 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "data-plane-proxy-versions-are-supported",
+					description: "data plane proxy versions are supported by the control plane",
+					hintAnchor:  "l5d-data-plane-version-skew",
+					check: func(ctx context.Context) error {
+						matrix, err := hc.proxyVersionMatrix(ctx)
+						if err != nil {
+							return err
+						}
+
+						return CheckProxyVersionsSupported(matrix)
+					},
+				},
+				{
+					id:          "data-plane-runs-a-single-proxy-version",
+					description: "data plane runs a single proxy version",
+					hintAnchor:  "l5d-data-plane-single-version",
+					warning:     true,
+					check: func(ctx context.Context) error {
+						matrix, err := hc.proxyVersionMatrix(ctx)
+						if err != nil {
+							return err
+						}
+
+						return CheckSingleProxyVersion(matrix)
+					},
+				},
+				{
+					id:          "data-plane-proxy-version-skews-are-known",
+					description: "data plane proxy version skews are known",
+					hintAnchor:  "l5d-data-plane-version-skew",
+					warning:     true,
+					check: func(ctx context.Context) error {
+						matrix, err := hc.proxyVersionMatrix(ctx)
+						if err != nil {
+							return err
+						}
+						if !version.IsReleaseVersion(hc.serverVersion) {
+							return &SkipError{Reason: "not run for development versions of the control plane"}
+						}
+
+						return CheckProxyVersionSkewsKnown(matrix)
+					},
+				},
+				{
+					id:          "data-plane-pod-labels-are-configured-correctly",
 					description: "data plane pod labels are configured correctly",
 					hintAnchor:  "l5d-data-plane-pod-labels",
 					warning:     true,
@@ -1377,6 +1624,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "data-plane-service-labels-are-configured-correctly",
 					description: "data plane service labels are configured correctly",
 					hintAnchor:  "l5d-data-plane-services-labels",
 					warning:     true,
@@ -1390,6 +1638,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "data-plane-service-annotations-are-configured-correctly",
 					description: "data plane service annotations are configured correctly",
 					hintAnchor:  "l5d-data-plane-services-annotations",
 					warning:     true,
@@ -1403,12 +1652,27 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:          "opaque-ports-are-properly-annotated",
 					description: "opaque ports are properly annotated",
 					hintAnchor:  "linkerd-opaque-ports-definition",
 					check: func(ctx context.Context) error {
 						return hc.checkMisconfiguredOpaquePortAnnotations(ctx)
 					},
 				},
+				{
+					id:          "no-workloads-were-unexpectedly-skipped-by-the-proxy-injector",
+					description: "no workloads were unexpectedly skipped by the proxy injector",
+					hintAnchor:  "l5d-data-plane-injection-skipped",
+					warning:     true,
+					check: func(ctx context.Context) error {
+						events, err := hc.GetInjectionEvents(ctx)
+						if err != nil {
+							return err
+						}
+
+						return checkInjectionSkips(events)
+					},
+				},
 			},
 			false,
 		),
@@ -1416,6 +1680,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 			LinkerdHAChecks,
 			[]Checker{
 				{
+					id:          "pod-injection-disabled-on-kube-system",
 					description: "pod injection disabled on kube-system",
 					hintAnchor:  "l5d-injection-disabled",
 					warning:     true,
@@ -1431,6 +1696,7 @@ func (hc *HealthChecker) allCategories() []*Category {
 					},
 				},
 				{
+					id:            "multiple-replicas-of-control-plane-pods",
 					description:   "multiple replicas of control plane pods",
 					hintAnchor:    "l5d-control-plane-replicas",
 					retryDeadline: hc.RetryDeadline,
@@ -1474,6 +1740,139 @@ func CheckProxyVersionsUpToDate(pods []corev1.Pod, versions version.Channels) er
 	return nil
 }
 
+// ProxyVersionCount is the number of running pods with a given proxy version,
+// along with the skew between that version and the control plane version
+type ProxyVersionCount struct {
+	Version string
+	Pods    int
+	// Skew is the number of releases the proxies are behind the control
+	// plane, in the unit of Compatibility
+	Skew int
+	// Compatibility is nil when the skew can't be determined, e.g. between
+	// different release channels
+	Compatibility *version.Compatibility
+	// SkewErr is set when the skew is unknown because the proxy version isn't
+	// a release version, e.g. for images pinned by digest or tagged latest
+	SkewErr error
+	// Err is set when the proxies aren't supported by the control plane
+	Err error
+}
+
+func (c ProxyVersionCount) String() string {
+	var skew string
+	switch {
+	case c.Err != nil:
+		skew = c.Err.Error()
+	case c.SkewErr != nil:
+		skew = fmt.Sprintf("skew unknown: %s", c.SkewErr)
+	case c.Compatibility == nil:
+		skew = "skew not determined"
+	case c.Skew == 0:
+		skew = "same release as the control plane"
+	case c.Skew > 0:
+		skew = fmt.Sprintf("%d %s behind the control plane", c.Skew, c.Compatibility.Unit)
+	default:
+		skew = fmt.Sprintf("%d %s ahead of the control plane", -c.Skew, c.Compatibility.Unit)
+	}
+	return fmt.Sprintf("%s: %d pods (%s)", c.Version, c.Pods, skew)
+}
+
+// proxyVersionMatrix returns the proxy versions of the running data plane
+// pods along with their skew from the control plane version. The matrix is
+// cached so that it's shared by the proxy version checks.
+func (hc *HealthChecker) proxyVersionMatrix(ctx context.Context) ([]ProxyVersionCount, error) {
+	if hc.proxyVersions != nil {
+		return hc.proxyVersions, nil
+	}
+	if hc.serverVersion == "" {
+		serverVersion, err := GetServerVersion(ctx, hc.ControlPlaneNamespace, hc.kubeAPI)
+		if err != nil {
+			return nil, err
+		}
+		hc.serverVersion = serverVersion
+	}
+	pods, err := hc.GetDataPlanePods(ctx)
+	if err != nil {
+		return nil, err
+	}
+	hc.proxyVersions = ProxyVersionMatrix(pods, hc.serverVersion)
+	return hc.proxyVersions, nil
+}
+
+// ProxyVersionMatrix returns every distinct proxy version of the running
+// pods, with the number of pods running it and its skew from the control
+// plane version, the most common versions first. The versions which can't be
+// parsed have an unknown skew, and are considered supported.
+func ProxyVersionMatrix(pods []corev1.Pod, controlPlaneVersion string) []ProxyVersionCount {
+	counts := map[string]int{}
+	for _, pod := range pods {
+		if k8s.GetPodStatus(pod) == string(corev1.PodRunning) && containsProxy(pod) {
+			counts[k8s.GetProxyVersion(pod)]++
+		}
+	}
+
+	matrix := make([]ProxyVersionCount, 0, len(counts))
+	for proxyVersion, pods := range counts {
+		count := ProxyVersionCount{Version: proxyVersion, Pods: pods}
+		count.Skew, count.Compatibility, count.SkewErr = version.ProxySkew(proxyVersion, controlPlaneVersion)
+		if count.SkewErr == nil {
+			count.Err = version.CheckProxySkew(proxyVersion, controlPlaneVersion)
+		}
+		matrix = append(matrix, count)
+	}
+	sort.Slice(matrix, func(i, j int) bool {
+		if matrix[i].Pods != matrix[j].Pods {
+			return matrix[i].Pods > matrix[j].Pods
+		}
+		return matrix[i].Version < matrix[j].Version
+	})
+	return matrix
+}
+
+// CheckProxyVersionsSupported checks that all the proxy versions of the
+// matrix are within the skew supported by the control plane
+func CheckProxyVersionsSupported(matrix []ProxyVersionCount) error {
+	var unsupported []string
+	for _, count := range matrix {
+		if count.Err != nil {
+			unsupported = append(unsupported, fmt.Sprintf("\t* %s", count))
+		}
+	}
+	if len(unsupported) > 0 {
+		return fmt.Errorf("some proxy versions are not supported by the control plane:\n%s", strings.Join(unsupported, "\n"))
+	}
+	return nil
+}
+
+// CheckProxyVersionSkewsKnown checks that the skew of all the proxy versions of
+// the matrix could be determined, listing the versions whose support by the
+// control plane thus can't be checked
+func CheckProxyVersionSkewsKnown(matrix []ProxyVersionCount) error {
+	var unknown []string
+	for _, count := range matrix {
+		if count.SkewErr != nil {
+			unknown = append(unknown, fmt.Sprintf("\t* %s", count))
+		}
+	}
+	if len(unknown) > 0 {
+		return fmt.Errorf("the support of some proxy versions by the control plane can't be checked:\n%s", strings.Join(unknown, "\n"))
+	}
+	return nil
+}
+
+// CheckSingleProxyVersion checks that a single proxy version runs in the
+// data plane, and lists all the versions otherwise, e.g. during rollouts
+func CheckSingleProxyVersion(matrix []ProxyVersionCount) error {
+	if len(matrix) <= 1 {
+		return nil
+	}
+	versions := make([]string, len(matrix))
+	for i, count := range matrix {
+		versions[i] = fmt.Sprintf("\t* %s", count)
+	}
+	return fmt.Errorf("proxies are running %d different versions:\n%s", len(matrix), strings.Join(versions, "\n"))
+}
+
 // CheckIfProxyVersionsMatchWithCLI checks if the latest proxy version
 // matches that of the CLI
 func CheckIfProxyVersionsMatchWithCLI(pods []corev1.Pod) error {
@@ -1613,7 +2012,21 @@ func (hc *HealthChecker) RunChecks(observer CheckObserver) bool {
 			for _, checker := range c.checkers {
 				checker := checker // pin
 				if checker.check != nil {
-					if !hc.runCheck(c, &checker, observer) {
+					checkObserver := observer
+					if !CheckSelected(c.ID, checker.ID(), hc.Include, hc.Exclude) {
+						// Fatal checks that weren't selected still run, as the
+						// following checks depend on them, but are only
+						// reported when they fail
+						if !checker.fatal {
+							continue
+						}
+						checkObserver = func(result *CheckResult) {
+							if result.Err != nil && !result.Retry {
+								observer(result)
+							}
+						}
+					}
+					if !hc.runCheck(c, &checker, checkObserver) {
 						if !checker.warning {
 							success = false
 						}
@@ -1638,17 +2051,21 @@ func (hc *HealthChecker) runCheck(category *Category, c *Checker, observer Check
 	for {
 		ctx, cancel := context.WithTimeout(context.Background(), RequestTimeout)
 		defer cancel()
+		start := time.Now()
 		err := c.check(ctx)
+		duration := time.Since(start)
 		if se, ok := err.(*SkipError); ok {
 			log.Debugf("Skipping check: %s. Reason: %s", c.description, se.Reason)
 			return true
 		}
 
 		checkResult := &CheckResult{
+			ID:          fmt.Sprintf("%s/%s", category.ID, c.ID()),
 			Category:    category.ID,
 			Description: c.description,
 			Warning:     c.warning,
 			HintURL:     fmt.Sprintf("%s%s", category.hintBaseURL, c.hintAnchor),
+			Duration:    duration,
 		}
 		if vs, ok := err.(*VerboseSuccess); ok {
 			checkResult.Description = fmt.Sprintf("%s\n%s", checkResult.Description, vs.Message)
@@ -1697,6 +2114,30 @@ func (hc *HealthChecker) checkLinkerdConfigConfigMap(ctx context.Context) (strin
 	return string(configMap.GetUID()), values, nil
 }
 
+// checkLinkerdConfigValues validates the values stored in the linkerd-config
+// config map against the schema of the chart values
+func (hc *HealthChecker) checkLinkerdConfigValues(ctx context.Context) error {
+	cm, _, err := FetchLinkerdConfigMap(ctx, hc.kubeAPI, hc.ControlPlaneNamespace)
+	if err != nil {
+		return err
+	}
+	rawValues := cm.Data["values"]
+	if rawValues == "" {
+		// the legacy config doesn't hold chart values
+		return nil
+	}
+
+	rawValuesBytes, err := config.RemoveGlobalFieldIfPresent([]byte(rawValues))
+	if err != nil {
+		return err
+	}
+	var values map[string]interface{}
+	if err := yaml.Unmarshal(rawValuesBytes, &values); err != nil {
+		return err
+	}
+	return l5dcharts.ValidateValues(values)
+}
+
 // Checks whether the configuration of the linkerd-identity-issuer is correct. This means:
 // 1. There is a config map present with identity context
 // 2. The scheme in the identity context corresponds to the format of the issuer secret
@@ -2369,6 +2810,21 @@ func (hc *HealthChecker) GetDataPlanePods(ctx context.Context) ([]corev1.Pod, er
 	return podList.Items, nil
 }
 
+// GetInjectionEvents returns the events recorded by the proxy injector within
+// the data plane namespace when injecting the proxy or skipping injection
+func (hc *HealthChecker) GetInjectionEvents(ctx context.Context) ([]corev1.Event, error) {
+	var events []corev1.Event
+	for _, reason := range []string{k8s.InjectionSkippedEventReason, k8s.InjectedEventReason} {
+		selector := fmt.Sprintf("reason=%s", reason)
+		eventList, err := hc.kubeAPI.CoreV1().Events(hc.DataPlaneNamespace).List(ctx, metav1.ListOptions{FieldSelector: selector})
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, eventList.Items...)
+	}
+	return events, nil
+}
+
 // GetServices returns all services within data plane namespace
 func (hc *HealthChecker) GetServices(ctx context.Context) ([]corev1.Service, error) {
 	svcList, err := hc.kubeAPI.CoreV1().Services(hc.DataPlaneNamespace).List(ctx, metav1.ListOptions{})
@@ -0,0 +1,72 @@
+package healthcheck
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// optOutSkipReasons are the proxy injector skip reasons of workloads that
+// deliberately aren't part of the mesh. They're not reported by
+// checkInjectionSkips.
+var optOutSkipReasons = map[string]bool{
+	k8s.InjectSkipReasonEnableAnnotationAbsent:   true,
+	k8s.InjectSkipReasonDisableAnnotationPresent: true,
+}
+
+// checkInjectionSkips summarizes, by skip reason, the workloads whose latest
+// injection event is an InjectionSkipped event reporting that the proxy
+// injector skipped them for a reason other than having opted out of the
+// mesh. The skips of workloads injected since then aren't reported.
+func checkInjectionSkips(events []corev1.Event) error {
+	latest := map[string]corev1.Event{}
+	for _, event := range events {
+		if event.Reason != k8s.InjectionSkippedEventReason && event.Reason != k8s.InjectedEventReason {
+			continue
+		}
+		obj := event.InvolvedObject
+		key := fmt.Sprintf("%s/%s/%s", obj.Namespace, strings.ToLower(obj.Kind), obj.Name)
+		if prev, ok := latest[key]; ok && event.LastTimestamp.Before(&prev.LastTimestamp) {
+			continue
+		}
+		latest[key] = event
+	}
+
+	workloadsByReason := map[string][]string{}
+	for workload, event := range latest {
+		if event.Reason != k8s.InjectionSkippedEventReason {
+			continue
+		}
+		reasons := event.Annotations[k8s.ProxyInjectSkipReasonsAnnotation]
+		if reasons == "" {
+			continue
+		}
+		for _, reason := range strings.Split(reasons, ",") {
+			if optOutSkipReasons[reason] {
+				continue
+			}
+			workloadsByReason[reason] = append(workloadsByReason[reason], workload)
+		}
+	}
+	if len(workloadsByReason) == 0 {
+		return nil
+	}
+
+	reasons := make([]string, 0, len(workloadsByReason))
+	for reason := range workloadsByReason {
+		reasons = append(reasons, reason)
+	}
+	sort.Strings(reasons)
+
+	var summary []string
+	for _, reason := range reasons {
+		workloads := workloadsByReason[reason]
+		sort.Strings(workloads)
+		summary = append(summary,
+			fmt.Sprintf("\t* %s (%d)\n\t\t%s", reason, len(workloads), strings.Join(workloads, "\n\t\t")))
+	}
+	return fmt.Errorf("Some workloads were skipped by the proxy injector:\n%s", strings.Join(summary, "\n"))
+}
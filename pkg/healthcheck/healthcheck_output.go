@@ -27,6 +27,10 @@ const (
 	WideOutput = "wide"
 	// ShortOutput is used to specify the short output format
 	ShortOutput = "short"
+	// JUnitOutput is used to specify the JUnit XML output format
+	JUnitOutput = "junit"
+	// SARIFOutput is used to specify the SARIF output format
+	SARIFOutput = "sarif"
 
 	// DefaultHintBaseURL is the default base URL on the linkerd.io website
 	// that all check hints for the latest linkerd version point to. Each
@@ -85,70 +89,19 @@ func RunExtensionsChecks(wout io.Writer, werr io.Writer, extensions []string, fl
 
 	success := true
 	for _, extension := range extensions {
-		var path string
-		args := append([]string{"check"}, flags...)
-		var err error
-		results := CheckResults{
-			Results: []CheckResult{},
+		if isatty.IsTerminal(os.Stdout.Fd()) {
+			spin.Suffix = fmt.Sprintf(" Running %s extension check", extension)
+			spin.Color("bold") // this calls spin.Restart()
 		}
-		extensionCmd := fmt.Sprintf("linkerd-%s", extension)
-
-		switch extension {
-		case "jaeger":
-			path = os.Args[0]
-			args = append([]string{"jaeger"}, args...)
-		case "viz":
-			path = os.Args[0]
-			args = append([]string{"viz"}, args...)
-		case "multicluster":
-			path = os.Args[0]
-			args = append([]string{"multicluster"}, args...)
-		default:
-			path, err = exec.LookPath(extensionCmd)
-			results.Results = []CheckResult{
-				{
-					Category:    CategoryID(extensionCmd),
-					Description: fmt.Sprintf("Linkerd extension command %s exists", extensionCmd),
-					Err:         err,
-					HintURL:     HintBaseURL(version.Version) + "extensions",
-					Warning:     true,
-				},
-			}
+		results, extensionSuccess := runExtensionCheck(extension, flags)
+		spin.Stop()
+		if !extensionSuccess {
+			success = false
 		}
 
-		if err == nil {
-			if isatty.IsTerminal(os.Stdout.Fd()) {
-				spin.Suffix = fmt.Sprintf(" Running %s extension check", extension)
-				spin.Color("bold") // this calls spin.Restart()
-			}
-			plugin := exec.Command(path, args...)
-			var stdout, stderr bytes.Buffer
-			plugin.Stdout = &stdout
-			plugin.Stderr = &stderr
-			plugin.Run()
-			extensionResults, err := parseJSONCheckOutput(stdout.Bytes())
-			spin.Stop()
-			if err != nil {
-				command := fmt.Sprintf("%s %s", path, strings.Join(args, " "))
-				if len(stderr.String()) > 0 {
-					err = errors.New(stderr.String())
-				} else {
-					err = fmt.Errorf("invalid extension check output from \"%s\" (JSON object expected):\n%s\n[%s]", command, stdout.String(), err)
-				}
-				results.Results = append(results.Results, CheckResult{
-					Category:    CategoryID(extensionCmd),
-					Description: fmt.Sprintf("Running: %s", command),
-					Err:         err,
-					HintURL:     HintBaseURL(version.Version) + "extensions",
-				})
-				success = false
-			} else {
-				results.Results = append(results.Results, extensionResults.Results...)
-			}
-		}
 		// add a new line to space out each check output
 		fmt.Fprintln(wout)
-		extensionSuccess := RunChecks(wout, werr, results, fmt.Sprintf("extension-%s", output))
+		extensionSuccess = RunChecks(wout, werr, results, fmt.Sprintf("extension-%s", output))
 		if !extensionSuccess {
 			success = false
 		}
@@ -157,10 +110,115 @@ func RunExtensionsChecks(wout io.Writer, werr io.Writer, extensions []string, fl
 	return success
 }
 
+// runExtensionCheck runs the check command of the given extension, with the
+// given flags, and returns its results. It returns false if the command
+// couldn't be run or didn't produce a valid output.
+func runExtensionCheck(extension string, flags []string) (CheckResults, bool) {
+	var path string
+	args := append([]string{"check"}, flags...)
+	var err error
+	results := CheckResults{
+		Results: []CheckResult{},
+	}
+	extensionCmd := fmt.Sprintf("linkerd-%s", extension)
+
+	switch extension {
+	case "jaeger":
+		path = os.Args[0]
+		args = append([]string{"jaeger"}, args...)
+	case "viz":
+		path = os.Args[0]
+		args = append([]string{"viz"}, args...)
+	case "multicluster":
+		path = os.Args[0]
+		args = append([]string{"multicluster"}, args...)
+	default:
+		path, err = exec.LookPath(extensionCmd)
+		results.Results = []CheckResult{
+			{
+				Category:    CategoryID(extensionCmd),
+				Description: fmt.Sprintf("Linkerd extension command %s exists", extensionCmd),
+				Err:         err,
+				HintURL:     HintBaseURL(version.Version) + "extensions",
+				Warning:     true,
+			},
+		}
+	}
+
+	if err != nil {
+		return results, true
+	}
+
+	plugin := exec.Command(path, args...)
+	var stdout, stderr bytes.Buffer
+	plugin.Stdout = &stdout
+	plugin.Stderr = &stderr
+	plugin.Run()
+	extensionResults, err := parseJSONCheckOutput(stdout.Bytes())
+	if err != nil {
+		command := fmt.Sprintf("%s %s", path, strings.Join(args, " "))
+		if len(stderr.String()) > 0 {
+			err = errors.New(stderr.String())
+		} else {
+			err = fmt.Errorf("invalid extension check output from \"%s\" (JSON object expected):\n%s\n[%s]", command, stdout.String(), err)
+		}
+		results.Results = append(results.Results, CheckResult{
+			Category:    CategoryID(extensionCmd),
+			Description: fmt.Sprintf("Running: %s", command),
+			Err:         err,
+			HintURL:     HintBaseURL(version.Version) + "extensions",
+		})
+		return results, false
+	}
+
+	results.Results = append(results.Results, extensionResults.Results...)
+	return results, true
+}
+
+// ExtensionsRunner is a Runner for the checks of Linkerd extensions, which
+// runs the check command of each extension with the given flags
+type ExtensionsRunner struct {
+	Extensions []string
+	Flags      []string
+}
+
+// RunChecks runs the check command of each extension, submitting their
+// results to the given observer
+func (er ExtensionsRunner) RunChecks(observer CheckObserver) bool {
+	success := true
+	for _, extension := range er.Extensions {
+		results, extensionSuccess := runExtensionCheck(extension, er.Flags)
+		if !results.RunChecks(observer) || !extensionSuccess {
+			success = false
+		}
+	}
+	return success
+}
+
+// Runners is a Runner running each of its runners in turn
+type Runners []Runner
+
+// RunChecks runs the checks of each runner, submitting their results to the
+// given observer
+func (runners Runners) RunChecks(observer CheckObserver) bool {
+	success := true
+	for _, runner := range runners {
+		if !runner.RunChecks(observer) {
+			success = false
+		}
+	}
+	return success
+}
+
 // RunChecks runs the checks that are part of hc
 func RunChecks(wout io.Writer, werr io.Writer, hc Runner, output string) bool {
-	if output == JSONOutput {
+	switch output {
+	case JSONOutput:
 		return runChecksJSON(wout, werr, hc)
+	case JUnitOutput:
+		return runChecksJUnit(wout, werr, hc)
+	case SARIFOutput:
+		return runChecksSARIF(wout, werr, hc)
 	}
 
 	return runChecksTable(wout, hc, output)
@@ -266,10 +324,14 @@ type checkCategory struct {
 // check is a user-facing version of `healthcheck.CheckResult`, for output via
 // `linkerd check -o json`.
 type check struct {
+	ID          string      `json:"id,omitempty"`
 	Description string      `json:"description"`
 	Hint        string      `json:"hint,omitempty"`
 	Error       string      `json:"error,omitempty"`
 	Result      checkResult `json:"result"`
+	// DurationMs is the time spent running the check, in milliseconds, so
+	// that the checks of extensions run by `linkerd check` keep their timing
+	DurationMs int64 `json:"durationMs,omitempty"`
 }
 
 type checkResult string
@@ -304,8 +366,10 @@ func runChecksJSON(wout io.Writer, werr io.Writer, hc Runner) bool {
 			}
 
 			currentCheck := &check{
+				ID:          result.ID,
 				Description: result.Description,
 				Result:      status,
+				DurationMs:  result.Duration.Milliseconds(),
 			}
 
 			if result.Err != nil {
@@ -353,11 +417,13 @@ func parseJSONCheckOutput(data []byte) (CheckResults, error) {
 				err = errors.New(check.Error)
 			}
 			results = append(results, CheckResult{
+				ID:          check.ID,
 				Category:    CategoryID(category.Name),
 				Description: check.Description,
 				Err:         err,
 				HintURL:     check.Hint,
 				Warning:     check.Result == checkWarn,
+				Duration:    time.Duration(check.DurationMs) * time.Millisecond,
 			})
 		}
 	}
@@ -0,0 +1,37 @@
+package healthcheck
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseJSONCheckOutput(t *testing.T) {
+	results := CheckResults{
+		Results: []CheckResult{
+			{ID: "linkerd-viz/prometheus", Category: "linkerd-viz", Description: "prometheus is running", Duration: 1500 * time.Millisecond},
+			{Category: "linkerd-viz", Description: "viz extension self-check", Warning: true, Err: errors.New("slow"), Duration: 20 * time.Millisecond},
+		},
+	}
+
+	var output bytes.Buffer
+	runChecksJSON(&output, &bytes.Buffer{}, results)
+
+	parsed, err := parseJSONCheckOutput(output.Bytes())
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(parsed.Results) != len(results.Results) {
+		t.Fatalf("Expected %d results, got %d", len(results.Results), len(parsed.Results))
+	}
+	for i, result := range parsed.Results {
+		expected := results.Results[i]
+		if result.Description != expected.Description || result.Warning != expected.Warning {
+			t.Fatalf("Expected result %+v, got %+v", expected, result)
+		}
+		if result.Duration != expected.Duration {
+			t.Fatalf("Expected the duration of %q to be %s, got %s", result.Description, expected.Duration, result.Duration)
+		}
+	}
+}
@@ -0,0 +1,252 @@
+package healthcheck
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+
+	"github.com/linkerd/linkerd2/pkg/version"
+)
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+	toolName     = "linkerd-check"
+	toolURI      = "https://linkerd.io"
+)
+
+type junitTestSuites struct {
+	XMLName  xml.Name          `xml:"testsuites"`
+	Name     string            `xml:"name,attr"`
+	Tests    int               `xml:"tests,attr"`
+	Failures int               `xml:"failures,attr"`
+	Time     string            `xml:"time,attr"`
+	Suites   []*junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string           `xml:"name,attr"`
+	Tests     int              `xml:"tests,attr"`
+	Failures  int              `xml:"failures,attr"`
+	Time      string           `xml:"time,attr"`
+	TestCases []*junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	ClassName string        `xml:"classname,attr"`
+	Name      string        `xml:"name,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool      `json:"tool"`
+	Results []*sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string       `json:"name"`
+	Version        string       `json:"version"`
+	InformationURI string       `json:"informationUri"`
+	Rules          []*sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+	HelpURI          string       `json:"helpUri,omitempty"`
+	Properties       sarifProps   `json:"properties"`
+}
+
+type sarifProps struct {
+	Category string `json:"category"`
+}
+
+type sarifResult struct {
+	RuleID    string       `json:"ruleId"`
+	RuleIndex int          `json:"ruleIndex"`
+	Kind      string       `json:"kind"`
+	Level     string       `json:"level"`
+	Message   sarifMessage `json:"message"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+// collectResults runs the checks of hc and returns their final results,
+// leaving out the intermediate results of the checks being retried
+func collectResults(hc Runner) ([]*CheckResult, bool) {
+	var results []*CheckResult
+	success := hc.RunChecks(func(result *CheckResult) {
+		if !result.Retry {
+			results = append(results, result)
+		}
+	})
+	return results, success
+}
+
+// runChecksJUnit prints the results of the checks as a JUnit XML report,
+// where each category is a test suite and each check is a test case. As
+// `linkerd check` doesn't fail on warnings, the checks resulting in a
+// warning aren't reported as failures; their error and hint are reported as
+// the output of the test case instead.
+func runChecksJUnit(wout io.Writer, werr io.Writer, hc Runner) bool {
+	results, success := collectResults(hc)
+
+	report := junitTestSuites{Name: toolName}
+	var total float64
+	var suite *junitTestSuite
+	var suiteTime float64
+	for _, result := range results {
+		if suite == nil || suite.Name != string(result.Category) {
+			if suite != nil {
+				suite.Time = formatSeconds(suiteTime)
+			}
+			suite = &junitTestSuite{Name: string(result.Category)}
+			suiteTime = 0
+			report.Suites = append(report.Suites, suite)
+		}
+
+		testCase := &junitTestCase{
+			ClassName: string(result.Category),
+			Name:      result.Description,
+			Time:      formatSeconds(result.Duration.Seconds()),
+		}
+		if result.Err != nil {
+			details := resultDetails(result)
+			if result.Warning {
+				testCase.SystemOut = fmt.Sprintf("warning: %s", details)
+			} else {
+				testCase.Failure = &junitFailure{
+					Message: result.Err.Error(),
+					Type:    "error",
+					Text:    details,
+				}
+				suite.Failures++
+				report.Failures++
+			}
+		}
+		suite.TestCases = append(suite.TestCases, testCase)
+		suite.Tests++
+		report.Tests++
+		suiteTime += result.Duration.Seconds()
+		total += result.Duration.Seconds()
+	}
+	if suite != nil {
+		suite.Time = formatSeconds(suiteTime)
+	}
+	report.Time = formatSeconds(total)
+
+	out, err := xml.MarshalIndent(report, "", "  ")
+	if err != nil {
+		fmt.Fprintf(werr, "JUnit serialization of the check result failed with %s", err)
+		return success
+	}
+	fmt.Fprintf(wout, "%s%s\n", xml.Header, out)
+	return success
+}
+
+// runChecksSARIF prints the results of the checks as a SARIF log, where each
+// check is a rule and has a result. Passing checks have a "pass" result, so
+// that the log also records what was checked.
+func runChecksSARIF(wout io.Writer, werr io.Writer, hc Runner) bool {
+	results, success := collectResults(hc)
+
+	run := sarifRun{
+		Tool: sarifTool{
+			Driver: sarifDriver{
+				Name:           toolName,
+				Version:        version.Version,
+				InformationURI: toolURI,
+				Rules:          []*sarifRule{},
+			},
+		},
+		Results: []*sarifResult{},
+	}
+	ruleIndexes := map[string]int{}
+	for _, result := range results {
+		id := ruleID(result)
+		index, ok := ruleIndexes[id]
+		if !ok {
+			index = len(run.Tool.Driver.Rules)
+			ruleIndexes[id] = index
+			run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, &sarifRule{
+				ID:               id,
+				ShortDescription: sarifMessage{Text: result.Description},
+				HelpURI:          result.HintURL,
+				Properties:       sarifProps{Category: string(result.Category)},
+			})
+		}
+
+		sarifRes := &sarifResult{
+			RuleID:    id,
+			RuleIndex: index,
+			Kind:      "pass",
+			Level:     "none",
+			Message:   sarifMessage{Text: result.Description},
+		}
+		if result.Err != nil {
+			sarifRes.Kind = "fail"
+			sarifRes.Level = "error"
+			if result.Warning {
+				sarifRes.Level = "warning"
+			}
+			sarifRes.Message.Text = resultDetails(result)
+		}
+		run.Results = append(run.Results, sarifRes)
+	}
+
+	out, err := json.MarshalIndent(sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs:    []sarifRun{run},
+	}, "", "  ")
+	if err != nil {
+		fmt.Fprintf(werr, "SARIF serialization of the check result failed with %s", err)
+		return success
+	}
+	fmt.Fprintf(wout, "%s\n", out)
+	return success
+}
+
+// resultDetails returns the error of a failed check followed by its hint,
+// the same way they're printed in the table output
+func resultDetails(result *CheckResult) string {
+	details := result.Err.Error()
+	if result.HintURL != "" {
+		details = fmt.Sprintf("%s\nsee %s for hints", details, result.HintURL)
+	}
+	return details
+}
+
+// ruleID returns the ID of the check as the SARIF rule ID, deriving it from
+// the check description for results lacking an ID
+func ruleID(result *CheckResult) string {
+	if result.ID != "" {
+		return result.ID
+	}
+	return fmt.Sprintf("%s/%s", result.Category, slugify(result.Description))
+}
+
+func formatSeconds(seconds float64) string {
+	return fmt.Sprintf("%.3f", seconds)
+}
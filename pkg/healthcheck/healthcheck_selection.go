@@ -0,0 +1,63 @@
+package healthcheck
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+var reNonAlphanumeric = regexp.MustCompile(`[^a-z0-9]+`)
+
+// ID returns the ID of the checker within its category, derived from its
+// description when it wasn't set explicitly
+func (c *Checker) ID() string {
+	if c.id != "" {
+		return c.id
+	}
+	return slugify(c.description)
+}
+
+// CheckSelected tells whether the check with the given category and ID is
+// selected by the include and exclude patterns. A pattern matches a check if
+// it's equal to its category ID, or if it matches its full ID (the category
+// and check IDs joined by a slash) as a shell file name pattern, e.g.
+// "linkerd-identity/*-valid". A check is selected if it matches any of the
+// include patterns, or if there are none, and none of the exclude patterns.
+func CheckSelected(category CategoryID, id string, include, exclude []string) bool {
+	fullID := fmt.Sprintf("%s/%s", category, id)
+	matches := func(patterns []string) bool {
+		for _, pattern := range patterns {
+			if pattern == string(category) {
+				return true
+			}
+			if ok, _ := path.Match(pattern, fullID); ok {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(include) > 0 && !matches(include) {
+		return false
+	}
+	return !matches(exclude)
+}
+
+// ValidatePatterns returns an error if any of the given include or exclude
+// patterns is malformed
+func ValidatePatterns(patterns []string) error {
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid check pattern %q: %s", pattern, err)
+		}
+	}
+	return nil
+}
+
+// slugify turns a check description into an ID, e.g. "control plane
+// Namespace exists" into "control-plane-namespace-exists"
+func slugify(description string) string {
+	description = strings.SplitN(description, "\n", 2)[0]
+	return strings.Trim(reNonAlphanumeric.ReplaceAllString(strings.ToLower(description), "-"), "-")
+}
@@ -0,0 +1,170 @@
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCheckSelected(t *testing.T) {
+	testCases := []struct {
+		name     string
+		category CategoryID
+		id       string
+		include  []string
+		exclude  []string
+		expected bool
+	}{
+		{
+			name:     "no patterns",
+			category: "linkerd-identity",
+			id:       "issuer-cert-is-valid",
+			expected: true,
+		},
+		{
+			name:     "included category",
+			category: "linkerd-identity",
+			id:       "issuer-cert-is-valid",
+			include:  []string{"linkerd-identity"},
+			expected: true,
+		},
+		{
+			name:     "not included category",
+			category: "linkerd-config",
+			id:       "control-plane-crds-exist",
+			include:  []string{"linkerd-identity"},
+			expected: false,
+		},
+		{
+			name:     "included glob",
+			category: "linkerd-identity",
+			id:       "issuer-cert-is-valid",
+			include:  []string{"linkerd-identity/*-valid"},
+			expected: true,
+		},
+		{
+			name:     "excluded glob",
+			category: "linkerd-identity",
+			id:       "issuer-cert-is-valid",
+			exclude:  []string{"*/issuer-*"},
+			expected: false,
+		},
+		{
+			name:     "excluded takes precedence",
+			category: "linkerd-identity",
+			id:       "issuer-cert-is-valid",
+			include:  []string{"linkerd-identity"},
+			exclude:  []string{"linkerd-identity/issuer-cert-is-valid"},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			selected := CheckSelected(tc.category, tc.id, tc.include, tc.exclude)
+			if selected != tc.expected {
+				t.Fatalf("Expected selected to be %t, got %t", tc.expected, selected)
+			}
+		})
+	}
+}
+
+func TestCheckIDs(t *testing.T) {
+	hc := NewHealthChecker([]CategoryID{}, &Options{})
+	for _, category := range hc.allCategories() {
+		ids := map[string]bool{}
+		for _, checker := range category.checkers {
+			if checker.id == "" {
+				t.Errorf("Check %q of category %s has no ID", checker.description, category.ID)
+				continue
+			}
+			if ids[checker.id] {
+				t.Errorf("Duplicate check ID %s/%s", category.ID, checker.id)
+			}
+			ids[checker.id] = true
+		}
+	}
+}
+
+func TestValidatePatterns(t *testing.T) {
+	if err := ValidatePatterns([]string{"linkerd-identity", "*/issuer-*"}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if err := ValidatePatterns([]string{"linkerd-identity/[-"}); err == nil {
+		t.Fatal("Expected an error for a malformed pattern")
+	}
+}
+
+func TestRunChecksSelection(t *testing.T) {
+	ran := map[string]bool{}
+	newCheck := func(desc string, fatal bool, err error) Checker {
+		return Checker{
+			description: desc,
+			fatal:       fatal,
+			check: func(context.Context) error {
+				ran[desc] = true
+				return err
+			},
+		}
+	}
+
+	t.Run("Runs only the selected checks", func(t *testing.T) {
+		ran = map[string]bool{}
+		hc := NewHealthChecker(
+			[]CategoryID{},
+			&Options{
+				Include: []string{"cat2"},
+				Exclude: []string{"cat2/desc3"},
+			},
+		)
+		hc.AppendCategories(NewCategory("cat1", []Checker{
+			newCheck("desc1", true, nil),
+			newCheck("desc2", false, nil),
+		}, true))
+		hc.AppendCategories(NewCategory("cat2", []Checker{
+			newCheck("desc3", false, nil),
+			newCheck("desc4", false, nil),
+		}, true))
+
+		obs := newObserver()
+		hc.RunChecks(obs.resultFn)
+
+		expectedResults := []string{"cat2 desc4"}
+		if !reflect.DeepEqual(obs.results, expectedResults) {
+			t.Fatalf("Expected results %v, but got %v", expectedResults, obs.results)
+		}
+		if !ran["desc1"] {
+			t.Fatal("Expected the unselected fatal check to run")
+		}
+		if ran["desc2"] || ran["desc3"] {
+			t.Fatal("Expected the unselected non-fatal checks not to run")
+		}
+	})
+
+	t.Run("Reports unselected fatal checks that fail", func(t *testing.T) {
+		ran = map[string]bool{}
+		hc := NewHealthChecker(
+			[]CategoryID{},
+			&Options{Include: []string{"cat2"}},
+		)
+		hc.AppendCategories(NewCategory("cat1", []Checker{
+			newCheck("desc1", true, errors.New("fatal")),
+		}, true))
+		hc.AppendCategories(NewCategory("cat2", []Checker{
+			newCheck("desc2", false, nil),
+		}, true))
+
+		obs := newObserver()
+		success := hc.RunChecks(obs.resultFn)
+
+		expectedResults := []string{"cat1 desc1: fatal"}
+		if !reflect.DeepEqual(obs.results, expectedResults) {
+			t.Fatalf("Expected results %v, but got %v", expectedResults, obs.results)
+		}
+		if success {
+			t.Fatal("Expected checks not to be successful")
+		}
+	})
+}
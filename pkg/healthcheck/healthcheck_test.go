@@ -1893,6 +1893,160 @@ func TestDataPlanePodLabels(t *testing.T) {
 	})
 }
 
+func proxyPod(name, proxyVersion string, phase corev1.PodPhase) corev1.Pod {
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "emojivoto"},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{Name: k8s.ProxyContainerName, Image: "cr.l5d.io/linkerd/proxy:" + proxyVersion},
+			},
+		},
+		Status: corev1.PodStatus{Phase: phase},
+	}
+}
+
+func TestProxyVersionMatrix(t *testing.T) {
+	pods := []corev1.Pod{
+		proxyPod("web-1", "stable-2.10.2", corev1.PodRunning),
+		proxyPod("web-2", "stable-2.11.0", corev1.PodRunning),
+		proxyPod("web-3", "stable-2.11.0", corev1.PodRunning),
+		proxyPod("voting-1", "stable-2.9.4", corev1.PodRunning),
+		proxyPod("voting-2", "stable-2.8.1", corev1.PodPending),
+		{ObjectMeta: metav1.ObjectMeta{Name: "unmeshed"}, Status: corev1.PodStatus{Phase: corev1.PodRunning}},
+	}
+
+	matrix := ProxyVersionMatrix(pods, "stable-2.11.0")
+	var versions []string
+	for _, count := range matrix {
+		versions = append(versions, count.String())
+	}
+	expected := []string{
+		"stable-2.11.0: 2 pods (same release as the control plane)",
+		"stable-2.10.2: 1 pods (1 minor releases behind the control plane)",
+		"stable-2.9.4: 1 pods (stable-2.9.4 is 2 minor releases away from stable-2.11.0, the maximum supported skew is 1)",
+	}
+	if !reflect.DeepEqual(versions, expected) {
+		t.Fatalf("Expected versions\n%v\ngot\n%v", expected, versions)
+	}
+
+	err := CheckProxyVersionsSupported(matrix)
+	expectedErr := "some proxy versions are not supported by the control plane:\n\t* " + expected[2]
+	if err == nil || err.Error() != expectedErr {
+		t.Fatalf("Expected error %q, got %v", expectedErr, err)
+	}
+	if err := CheckProxyVersionsSupported(matrix[:2]); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	err = CheckSingleProxyVersion(matrix)
+	if err == nil || !strings.HasPrefix(err.Error(), "proxies are running 3 different versions:") {
+		t.Fatalf("Expected an error listing the versions, got %v", err)
+	}
+	if err := CheckSingleProxyVersion(matrix[:1]); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if err := CheckProxyVersionSkewsKnown(matrix); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	t.Run("Reports the skew of unparseable versions as unknown", func(t *testing.T) {
+		pods := []corev1.Pod{
+			proxyPod("web-1", "stable-2.11.0", corev1.PodRunning),
+			proxyPod("web-2", "latest", corev1.PodRunning),
+		}
+		matrix := ProxyVersionMatrix(pods, "stable-2.11.0")
+		if err := CheckProxyVersionsSupported(matrix); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		err := CheckProxyVersionSkewsKnown(matrix)
+		expectedErr := "the support of some proxy versions by the control plane can't be checked:\n\t* latest: 1 pods (skew unknown: failed to parse proxy version: unsupported version format: latest)"
+		if err == nil || err.Error() != expectedErr {
+			t.Fatalf("Expected error %q, got %v", expectedErr, err)
+		}
+	})
+}
+
+func TestCheckInjectionSkips(t *testing.T) {
+	skipEvent := func(kind, name, reasons string, ts int64) corev1.Event {
+		return corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{k8s.ProxyInjectSkipReasonsAnnotation: reasons},
+			},
+			InvolvedObject: corev1.ObjectReference{Kind: kind, Namespace: "emojivoto", Name: name},
+			Reason:         k8s.InjectionSkippedEventReason,
+			LastTimestamp:  metav1.Unix(ts, 0),
+		}
+	}
+	injectedEvent := func(kind, name string, ts int64) corev1.Event {
+		return corev1.Event{
+			InvolvedObject: corev1.ObjectReference{Kind: kind, Namespace: "emojivoto", Name: name},
+			Reason:         k8s.InjectedEventReason,
+			LastTimestamp:  metav1.Unix(ts, 0),
+		}
+	}
+
+	for _, tc := range []struct {
+		description      string
+		events           []corev1.Event
+		expectedErrorMsg string
+	}{
+		{
+			description: "no events",
+		},
+		{
+			description: "opted out workloads",
+			events: []corev1.Event{
+				skipEvent("ReplicaSet", "web-123", "injection_enable_annotation_absent", 1),
+				skipEvent("ReplicaSet", "vote-123", "injection_disable_annotation_present", 1),
+			},
+		},
+		{
+			description: "skipped workloads",
+			events: []corev1.Event{
+				skipEvent("ReplicaSet", "web-123", "host_network_enabled,disabled_automount_service_account_token_account", 1),
+				skipEvent("ReplicaSet", "vote-123", "host_network_enabled", 1),
+				skipEvent("DaemonSet", "agent", "injection_enable_annotation_absent,disabled_automount_service_account_token_account", 1),
+			},
+			expectedErrorMsg: "Some workloads were skipped by the proxy injector:\n\t* disabled_automount_service_account_token_account (2)\n\t\temojivoto/daemonset/agent\n\t\temojivoto/replicaset/web-123\n\t* host_network_enabled (2)\n\t\temojivoto/replicaset/vote-123\n\t\temojivoto/replicaset/web-123",
+		},
+		{
+			description: "skips followed by an injection are ignored",
+			events: []corev1.Event{
+				skipEvent("ReplicaSet", "web-123", "host_network_enabled", 1),
+				injectedEvent("ReplicaSet", "web-123", 2),
+				skipEvent("ReplicaSet", "vote-123", "host_network_enabled", 2),
+				injectedEvent("ReplicaSet", "vote-123", 1),
+			},
+			expectedErrorMsg: "Some workloads were skipped by the proxy injector:\n\t* host_network_enabled (1)\n\t\temojivoto/replicaset/vote-123",
+		},
+		{
+			description: "only the latest event of a workload is considered",
+			events: []corev1.Event{
+				skipEvent("ReplicaSet", "web-123", "injection_enable_annotation_absent", 2),
+				skipEvent("ReplicaSet", "web-123", "host_network_enabled", 1),
+			},
+		},
+	} {
+		tc := tc //pin
+		t.Run(tc.description, func(t *testing.T) {
+			err := checkInjectionSkips(tc.events)
+			if tc.expectedErrorMsg == "" {
+				if err != nil {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("Expected error, got nothing")
+			}
+			if err.Error() != tc.expectedErrorMsg {
+				t.Fatalf("Unexpected error message: %s", err.Error())
+			}
+		})
+	}
+}
+
 func TestServicesLabels(t *testing.T) {
 
 	t.Run("Returns nil if service labels are ok", func(t *testing.T) {
@@ -0,0 +1,299 @@
+package healthcheck
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	l5dcharts "github.com/linkerd/linkerd2/pkg/charts/linkerd2"
+	"github.com/linkerd/linkerd2/pkg/config"
+	"github.com/linkerd/linkerd2/pkg/issuercerts"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/linkerd/linkerd2/pkg/tls"
+	"github.com/linkerd/linkerd2/pkg/version"
+	corev1 "k8s.io/api/core/v1"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	yamlDecoder "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	serviceProfileCRDName = "serviceprofiles.linkerd.io"
+	configOverridesName   = "linkerd-config-overrides"
+)
+
+// checkServiceProfileCRDCompatible checks that the ServiceProfile CRD of the
+// upgrade manifest can replace the installed one without breaking the
+// existing ServiceProfiles
+func (hc *HealthChecker) checkServiceProfileCRDCompatible(ctx context.Context) error {
+	var target apiextv1.CustomResourceDefinition
+	found, err := findManifestObject(hc.UpgradeManifest, "CustomResourceDefinition", "", serviceProfileCRDName, &target)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("CustomResourceDefinition/%s not found in the upgrade manifest", serviceProfileCRDName)
+	}
+
+	live, err := hc.kubeAPI.Apiextensions.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, serviceProfileCRDName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	return checkCRDCompatibility(live, &target)
+}
+
+// checkCRDCompatibility returns an error if replacing the live CRD with the
+// target CRD would either be rejected by the API server, make versions in use
+// unavailable, or prune fields from the existing resources
+func checkCRDCompatibility(live, target *apiextv1.CustomResourceDefinition) error {
+	targetVersions := map[string]*apiextv1.CustomResourceDefinitionVersion{}
+	for i := range target.Spec.Versions {
+		targetVersions[target.Spec.Versions[i].Name] = &target.Spec.Versions[i]
+	}
+
+	var errs []string
+	for _, stored := range live.Status.StoredVersions {
+		if _, ok := targetVersions[stored]; !ok {
+			errs = append(errs, fmt.Sprintf("version %s is still used to store resources but is removed", stored))
+		}
+	}
+	for _, liveVersion := range live.Spec.Versions {
+		if !liveVersion.Served {
+			continue
+		}
+		targetVersion, ok := targetVersions[liveVersion.Name]
+		if !ok || !targetVersion.Served {
+			errs = append(errs, fmt.Sprintf("version %s is no longer served", liveVersion.Name))
+			continue
+		}
+		if liveVersion.Schema == nil || targetVersion.Schema == nil {
+			continue
+		}
+		removed := removedProperties("", liveVersion.Schema.OpenAPIV3Schema, targetVersion.Schema.OpenAPIV3Schema)
+		if len(removed) > 0 {
+			errs = append(errs, fmt.Sprintf("version %s no longer has the fields %s", liveVersion.Name, strings.Join(removed, ", ")))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("CustomResourceDefinition/%s is not compatible with the installed one:\n\t%s", live.Name, strings.Join(errs, "\n\t"))
+	}
+	return nil
+}
+
+// removedProperties returns the paths of the properties of the live schema
+// which aren't in the target schema, and would thus be pruned from the
+// existing resources
+func removedProperties(path string, live, target *apiextv1.JSONSchemaProps) []string {
+	if live == nil || target == nil {
+		return nil
+	}
+	if target.XPreserveUnknownFields != nil && *target.XPreserveUnknownFields {
+		return nil
+	}
+
+	var removed []string
+	for name := range live.Properties {
+		liveProp := live.Properties[name]
+		propPath := name
+		if path != "" {
+			propPath = fmt.Sprintf("%s.%s", path, name)
+		}
+		targetProp, ok := target.Properties[name]
+		if !ok {
+			removed = append(removed, propPath)
+			continue
+		}
+		removed = append(removed, removedProperties(propPath, &liveProp, &targetProp)...)
+	}
+	if live.Items != nil && live.Items.Schema != nil && target.Items != nil {
+		removed = append(removed, removedProperties(path+"[]", live.Items.Schema, target.Items.Schema)...)
+	}
+	sort.Strings(removed)
+	return removed
+}
+
+// checkConfigOverridesRemovedValues checks that the values stored in the
+// linkerd-config-overrides secret are still supported by the chart of this
+// version, as the values which were removed are ignored on upgrade
+func (hc *HealthChecker) checkConfigOverridesRemovedValues(ctx context.Context) error {
+	secret, err := hc.kubeAPI.CoreV1().Secrets(hc.ControlPlaneNamespace).Get(ctx, configOverridesName, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		// Linkerd was either installed with Helm or prior to the introduction
+		// of this secret
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	overrides, ok := secret.Data[configOverridesName]
+	if !ok {
+		return fmt.Errorf("secret/%s is missing %s data", configOverridesName, configOverridesName)
+	}
+	overrides, err = config.RemoveGlobalFieldIfPresent(overrides)
+	if err != nil {
+		return err
+	}
+
+	removed, err := l5dcharts.UnknownFields(overrides)
+	if err != nil {
+		return err
+	}
+	if len(removed) > 0 {
+		return fmt.Errorf("secret/%s contains values which are no longer supported and will be ignored:\n\t* %s", configOverridesName, strings.Join(removed, "\n\t* "))
+	}
+	return nil
+}
+
+// checkUpgradeIssuerCert checks that the issuer certificate of the upgrade
+// manifest (or the externally managed one) is valid, is signed by the trust
+// anchors of the upgrade manifest, and is trusted by all the proxies
+func (hc *HealthChecker) checkUpgradeIssuerCert(ctx context.Context) error {
+	data, err := hc.upgradeIssuerData(ctx)
+	if err != nil {
+		return err
+	}
+
+	cred, err := data.VerifyAndBuildCreds()
+	if err != nil {
+		return fmt.Errorf("invalid issuer certificate: %s", err)
+	}
+	if err := issuercerts.CheckExpiringSoon(cred.Certificate); err != nil {
+		return fmt.Errorf("issuer certificate %s", err)
+	}
+
+	meshedPods, err := GetMeshedPodsIdentityData(ctx, hc.kubeAPI, hc.DataPlaneNamespace)
+	if err != nil {
+		return err
+	}
+	var problematicPods []string
+	for _, pod := range meshedPods {
+		anchors, err := tls.DecodePEMCertPool(pod.Anchors)
+		if err == nil {
+			err = cred.Verify(anchors, "", time.Time{})
+		}
+		if err != nil {
+			problematicPods = append(problematicPods, fmt.Sprintf("* %s/%s", pod.Namespace, pod.Name))
+		}
+	}
+	if len(problematicPods) > 0 {
+		return fmt.Errorf("the issuer certificate does not validate against the trust anchors of the following pods, which must be restarted before upgrading:\n\t%s", strings.Join(problematicPods, "\n\t"))
+	}
+	return nil
+}
+
+// upgradeIssuerData returns the issuer certificate and trust anchors the
+// control plane will use after the upgrade
+func (hc *HealthChecker) upgradeIssuerData(ctx context.Context) (*issuercerts.IssuerCertData, error) {
+	var secret corev1.Secret
+	found, err := findManifestObject(hc.UpgradeManifest, "Secret", hc.ControlPlaneNamespace, k8s.IdentityIssuerSecretName, &secret)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		// The issuer certificate isn't rendered when it's managed externally
+		return issuercerts.FetchExternalIssuerData(ctx, hc.kubeAPI, hc.ControlPlaneNamespace)
+	}
+
+	var cm corev1.ConfigMap
+	found, err = findManifestObject(hc.UpgradeManifest, "ConfigMap", hc.ControlPlaneNamespace, k8s.ConfigConfigMapName, &cm)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("ConfigMap/%s not found in the upgrade manifest", k8s.ConfigConfigMapName)
+	}
+	values, err := l5dcharts.ValuesFromConfigMap(&cm)
+	if err != nil {
+		return nil, err
+	}
+
+	return &issuercerts.IssuerCertData{
+		TrustAnchors: values.IdentityTrustAnchorsPEM,
+		IssuerCrt:    string(secret.Data[k8s.IdentityIssuerCrtName]),
+		IssuerKey:    string(secret.Data[k8s.IdentityIssuerKeyName]),
+	}, nil
+}
+
+// checkProxyVersionSkew checks that the version of all the proxies is
+// supported by the control plane version being upgraded to
+func (hc *HealthChecker) checkProxyVersionSkew(ctx context.Context) error {
+	pods, err := hc.GetDataPlanePods(ctx)
+	if err != nil {
+		return err
+	}
+	return CheckProxyVersionSkew(pods, version.Version)
+}
+
+// CheckProxyVersionSkew checks that the proxies of the given pods are within
+// the version skew supported by the given control plane version. The skew
+// can only be determined between release versions, so the check is skipped
+// for development control planes, and proxies running development builds or
+// custom tags are only listed in the success message.
+func CheckProxyVersionSkew(pods []corev1.Pod, controlPlaneVersion string) error {
+	if !version.IsReleaseVersion(controlPlaneVersion) {
+		return &SkipError{Reason: fmt.Sprintf("the skew can't be determined for control plane version %s", controlPlaneVersion)}
+	}
+
+	var skewedPods, unknownPods []string
+	for _, pod := range pods {
+		if k8s.GetPodStatus(pod) != string(corev1.PodRunning) || !containsProxy(pod) {
+			continue
+		}
+		proxyVersion := k8s.GetProxyVersion(pod)
+		if !version.IsReleaseVersion(proxyVersion) {
+			unknownPods = append(unknownPods, fmt.Sprintf("\t* %s/%s (%s)", pod.Namespace, pod.Name, proxyVersion))
+			continue
+		}
+		if err := version.CheckProxySkew(proxyVersion, controlPlaneVersion); err != nil {
+			skewedPods = append(skewedPods, fmt.Sprintf("\t* %s/%s (%s)", pod.Namespace, pod.Name, err))
+		}
+	}
+	if len(skewedPods) > 0 {
+		return fmt.Errorf("some proxies are not supported by %s and must be upgraded first:\n%s", controlPlaneVersion, strings.Join(skewedPods, "\n"))
+	}
+	if len(unknownPods) > 0 {
+		return &VerboseSuccess{Message: fmt.Sprintf("the skew of some proxy versions can't be determined:\n%s", strings.Join(unknownPods, "\n"))}
+	}
+	return nil
+}
+
+// findManifestObject looks for the object with the given kind, namespace and
+// name in the manifest, and unmarshals it into obj. It returns false if the
+// object isn't found.
+func findManifestObject(manifest, kind, namespace, name string, obj interface{}) (bool, error) {
+	yamlReader := yamlDecoder.NewYAMLReader(bufio.NewReader(strings.NewReader(manifest)))
+	for {
+		objYAML, err := yamlReader.Read()
+		if err == io.EOF {
+			return false, nil
+		}
+		if err != nil {
+			return false, fmt.Errorf("error reading upgrade manifest: %v", err)
+		}
+
+		var meta struct {
+			metav1.TypeMeta   `json:",inline"`
+			metav1.ObjectMeta `json:"metadata"`
+		}
+		if err := yaml.Unmarshal(objYAML, &meta); err != nil {
+			return false, fmt.Errorf("error unmarshaling yaml object %s: %v", objYAML, err)
+		}
+		if meta.Kind != kind || meta.Namespace != namespace || meta.Name != name {
+			continue
+		}
+
+		if err := yaml.Unmarshal(objYAML, obj); err != nil {
+			return false, fmt.Errorf("error unmarshaling %s/%s: %v", kind, name, err)
+		}
+		return true, nil
+	}
+}
@@ -0,0 +1,278 @@
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	corev1 "k8s.io/api/core/v1"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func crdVersion(name string, served, storage bool, properties ...string) apiextv1.CustomResourceDefinitionVersion {
+	spec := apiextv1.JSONSchemaProps{Type: "object", Properties: map[string]apiextv1.JSONSchemaProps{}}
+	for _, property := range properties {
+		spec.Properties[property] = apiextv1.JSONSchemaProps{Type: "string"}
+	}
+	return apiextv1.CustomResourceDefinitionVersion{
+		Name:    name,
+		Served:  served,
+		Storage: storage,
+		Schema: &apiextv1.CustomResourceValidation{
+			OpenAPIV3Schema: &apiextv1.JSONSchemaProps{
+				Type:       "object",
+				Properties: map[string]apiextv1.JSONSchemaProps{"spec": spec},
+			},
+		},
+	}
+}
+
+func TestCheckCRDCompatibility(t *testing.T) {
+	live := &apiextv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: serviceProfileCRDName},
+		Spec: apiextv1.CustomResourceDefinitionSpec{
+			Versions: []apiextv1.CustomResourceDefinitionVersion{
+				crdVersion("v1alpha1", true, false, "routes"),
+				crdVersion("v1alpha2", true, true, "routes", "retryBudget"),
+			},
+		},
+		Status: apiextv1.CustomResourceDefinitionStatus{
+			StoredVersions: []string{"v1alpha1", "v1alpha2"},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		versions []apiextv1.CustomResourceDefinitionVersion
+		errs     []string
+	}{
+		{
+			name: "same versions with new fields",
+			versions: []apiextv1.CustomResourceDefinitionVersion{
+				crdVersion("v1alpha1", true, false, "routes"),
+				crdVersion("v1alpha2", true, true, "routes", "retryBudget", "opaquePorts"),
+			},
+		},
+		{
+			name: "removed stored version",
+			versions: []apiextv1.CustomResourceDefinitionVersion{
+				crdVersion("v1alpha2", true, true, "routes", "retryBudget"),
+			},
+			errs: []string{
+				"version v1alpha1 is still used to store resources but is removed",
+				"version v1alpha1 is no longer served",
+			},
+		},
+		{
+			name: "removed field",
+			versions: []apiextv1.CustomResourceDefinitionVersion{
+				crdVersion("v1alpha1", true, false, "routes"),
+				crdVersion("v1alpha2", true, true, "routes"),
+			},
+			errs: []string{"version v1alpha2 no longer has the fields spec.retryBudget"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			target := live.DeepCopy()
+			target.Spec.Versions = tc.versions
+			err := checkCRDCompatibility(live, target)
+			if len(tc.errs) == 0 {
+				if err != nil {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("Expected an error")
+			}
+			for _, expected := range tc.errs {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("Expected error to contain %q, got %q", expected, err)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckConfigOverridesRemovedValues(t *testing.T) {
+	testCases := []struct {
+		name        string
+		k8sConfigs  []string
+		expectedErr string
+	}{
+		{
+			name: "no linkerd-config-overrides secret",
+		},
+		{
+			name: "supported values",
+			k8sConfigs: []string{`
+apiVersion: v1
+kind: Secret
+metadata:
+  name: linkerd-config-overrides
+  namespace: linkerd
+data:
+  linkerd-config-overrides: cHJveHk6CiAgbG9nTGV2ZWw6IGRlYnVnCg==
+`},
+		},
+		{
+			name: "removed values",
+			k8sConfigs: []string{`
+apiVersion: v1
+kind: Secret
+metadata:
+  name: linkerd-config-overrides
+  namespace: linkerd
+data:
+  linkerd-config-overrides: cHJveHk6CiAgbG9nTGV2ZWw6IGRlYnVnCiAgcmVtb3ZlZDogdHJ1ZQo=
+`},
+			expectedErr: "secret/linkerd-config-overrides contains values which are no longer supported and will be ignored:\n\t* proxy.removed",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			hc := NewHealthChecker([]CategoryID{}, &Options{ControlPlaneNamespace: "linkerd"})
+			var err error
+			hc.kubeAPI, err = k8s.NewFakeAPI(tc.k8sConfigs...)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			err = hc.checkConfigOverridesRemovedValues(context.Background())
+			if tc.expectedErr == "" {
+				if err != nil {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tc.expectedErr {
+				t.Fatalf("Expected error %q, got %v", tc.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestCheckProxyVersionSkew(t *testing.T) {
+	pod := func(name, proxyVersion string, phase corev1.PodPhase) corev1.Pod {
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "emojivoto"},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name:  k8s.ProxyContainerName,
+						Image: "cr.l5d.io/linkerd/proxy:" + proxyVersion,
+					},
+				},
+			},
+			Status: corev1.PodStatus{
+				Phase: phase,
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						Name:  k8s.ProxyContainerName,
+						Image: "cr.l5d.io/linkerd/proxy:" + proxyVersion,
+					},
+				},
+			},
+		}
+	}
+
+	pods := []corev1.Pod{
+		pod("web", "stable-2.10.2", corev1.PodRunning),
+		pod("voting", "stable-2.9.4", corev1.PodRunning),
+		pod("emoji", "stable-2.8.1", corev1.PodFailed),
+	}
+
+	err := CheckProxyVersionSkew(pods, "stable-2.11.0")
+	expected := "some proxies are not supported by stable-2.11.0 and must be upgraded first:\n\t* emojivoto/voting (stable-2.9.4 is 2 minor releases away from stable-2.11.0, the maximum supported skew is 1)"
+	if err == nil || err.Error() != expected {
+		t.Fatalf("Expected error %q, got %v", expected, err)
+	}
+
+	if err := CheckProxyVersionSkew(pods[:1], "stable-2.11.0"); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	t.Run("Lists proxies whose skew can't be determined", func(t *testing.T) {
+		pods := []corev1.Pod{
+			pod("web", "stable-2.10.2", corev1.PodRunning),
+			pod("voting", "dev-0bc9ed72-alex", corev1.PodRunning),
+			pod("emoji", "latest", corev1.PodRunning),
+			pod("vote-bot", "a1b2c3d4", corev1.PodRunning),
+		}
+		err := CheckProxyVersionSkew(pods, "stable-2.11.0")
+		var vs *VerboseSuccess
+		if !errors.As(err, &vs) {
+			t.Fatalf("Expected a VerboseSuccess, got %v", err)
+		}
+		expected := "the skew of some proxy versions can't be determined:\n\t* emojivoto/voting (dev-0bc9ed72-alex)\n\t* emojivoto/emoji (latest)\n\t* emojivoto/vote-bot (a1b2c3d4)"
+		if vs.Message != expected {
+			t.Fatalf("Expected message %q, got %q", expected, vs.Message)
+		}
+	})
+
+	t.Run("Still fails on skewed proxies alongside unknown versions", func(t *testing.T) {
+		pods := []corev1.Pod{
+			pod("voting", "stable-2.9.4", corev1.PodRunning),
+			pod("emoji", "latest", corev1.PodRunning),
+		}
+		err := CheckProxyVersionSkew(pods, "stable-2.11.0")
+		expected := "some proxies are not supported by stable-2.11.0 and must be upgraded first:\n\t* emojivoto/voting (stable-2.9.4 is 2 minor releases away from stable-2.11.0, the maximum supported skew is 1)"
+		if err == nil || err.Error() != expected {
+			t.Fatalf("Expected error %q, got %v", expected, err)
+		}
+	})
+
+	t.Run("Skips dev control plane versions", func(t *testing.T) {
+		for _, cpVersion := range []string{"dev-0bc9ed72-alex", "latest", "a1b2c3d4"} {
+			err := CheckProxyVersionSkew(pods, cpVersion)
+			var se *SkipError
+			if !errors.As(err, &se) {
+				t.Fatalf("Expected a SkipError for %s, got %v", cpVersion, err)
+			}
+		}
+	})
+}
+
+func TestFindManifestObject(t *testing.T) {
+	manifest := `
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: linkerd-config
+  namespace: linkerd
+data:
+  values: foo
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: linkerd-identity-issuer
+  namespace: linkerd
+data:
+  crt.pem: Y3J0
+`
+	var secret corev1.Secret
+	found, err := findManifestObject(manifest, "Secret", "linkerd", "linkerd-identity-issuer", &secret)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if !found || string(secret.Data["crt.pem"]) != "crt" {
+		t.Fatalf("Expected to find the issuer secret, got %v", secret)
+	}
+
+	found, err = findManifestObject(manifest, "Secret", "linkerd", "linkerd-config", &secret)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if found {
+		t.Fatal("Expected not to find a secret named linkerd-config")
+	}
+}
@@ -0,0 +1,414 @@
+package healthcheck
+
+import (
+	"bytes"
+	"context"
+	cryptotls "crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	admissionRegistration "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// webhookLatencyThreshold is the latency above which a webhook is reported
+	// as slow by the linkerd-webhooks checks
+	webhookLatencyThreshold = time.Second
+
+	syntheticAdmissionReviewName = "linkerd-check-synthetic"
+)
+
+// webhook is a webhook of a MutatingWebhookConfiguration or
+// ValidatingWebhookConfiguration installed by Linkerd or one of its extensions
+type webhook struct {
+	name         string
+	clientConfig admissionRegistration.WebhookClientConfig
+	rules        []admissionRegistration.RuleWithOperations
+}
+
+// webhookProbe holds the result of sending a synthetic AdmissionReview to a
+// webhook
+type webhookProbe struct {
+	name    string
+	latency time.Duration
+	// caBundleErr is set when the webhook serving certificate can't be
+	// verified with the caBundle of its configuration
+	caBundleErr error
+	// err is set when the webhook couldn't be reached or didn't respond with
+	// a valid AdmissionReview
+	err error
+}
+
+// getWebhookProbes sends a synthetic AdmissionReview to each of the Linkerd
+// webhooks. The results are cached so that they're shared by all the checks of
+// the LinkerdWebhooksChecks category.
+func (hc *HealthChecker) getWebhookProbes(ctx context.Context) ([]webhookProbe, error) {
+	if hc.webhookProbes != nil {
+		return hc.webhookProbes, nil
+	}
+
+	webhooks, err := hc.getLinkerdWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	probes := []webhookProbe{}
+	for _, wh := range webhooks {
+		probe := hc.probeWebhook(ctx, wh)
+		probe.name = wh.name
+		probes = append(probes, probe)
+	}
+	hc.webhookProbes = probes
+	return probes, nil
+}
+
+// getLinkerdWebhooks returns the webhooks of the webhook configurations of
+// the control plane and of the installed extensions, sorted by name
+func (hc *HealthChecker) getLinkerdWebhooks(ctx context.Context) ([]webhook, error) {
+	webhooks := []webhook{}
+	for _, selector := range []string{hc.controlPlaneComponentsSelector(), k8s.LinkerdExtensionLabel} {
+		options := metav1.ListOptions{LabelSelector: selector}
+
+		mwcs, err := hc.kubeAPI.AdmissionregistrationV1().MutatingWebhookConfigurations().List(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+		for _, mwc := range mwcs.Items {
+			for _, wh := range mwc.Webhooks {
+				webhooks = append(webhooks, webhook{wh.Name, wh.ClientConfig, wh.Rules})
+			}
+		}
+
+		vwcs, err := hc.kubeAPI.AdmissionregistrationV1().ValidatingWebhookConfigurations().List(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+		for _, vwc := range vwcs.Items {
+			for _, wh := range vwc.Webhooks {
+				webhooks = append(webhooks, webhook{wh.Name, wh.ClientConfig, wh.Rules})
+			}
+		}
+	}
+
+	sort.Slice(webhooks, func(i, j int) bool { return webhooks[i].name < webhooks[j].name })
+	return webhooks, nil
+}
+
+// probeWebhook port-forwards to the deployment backing the webhook service
+// and sends it a synthetic AdmissionReview
+func (hc *HealthChecker) probeWebhook(ctx context.Context, wh webhook) webhookProbe {
+	svc := wh.clientConfig.Service
+	if svc == nil {
+		return webhookProbe{err: errors.New("webhook isn't backed by a service")}
+	}
+
+	deployName, port, err := hc.getServiceBackend(ctx, svc)
+	if err != nil {
+		return webhookProbe{err: err}
+	}
+
+	pf, err := k8s.NewPortForward(ctx, hc.kubeAPI, svc.Namespace, deployName, "localhost", 0, port, false)
+	if err != nil {
+		return webhookProbe{err: err}
+	}
+	defer pf.Stop()
+	if err := pf.Init(); err != nil {
+		return webhookProbe{err: err}
+	}
+
+	path := "/"
+	if svc.Path != nil {
+		path = *svc.Path
+	}
+	url := fmt.Sprintf("https://%s%s", pf.AddressAndPort(), path)
+	serverName := fmt.Sprintf("%s.%s.svc", svc.Name, svc.Namespace)
+	review := syntheticAdmissionReview(svc.Namespace, wh.rules)
+
+	return sendAdmissionReview(ctx, url, serverName, wh.clientConfig.CABundle, review)
+}
+
+// getServiceBackend returns the name of the deployment owning the ready pods
+// backing the service, and the pod port the service forwards to
+func (hc *HealthChecker) getServiceBackend(ctx context.Context, ref *admissionRegistration.ServiceReference) (string, int, error) {
+	svcPort := int32(443)
+	if ref.Port != nil {
+		svcPort = *ref.Port
+	}
+
+	svc, err := hc.kubeAPI.CoreV1().Services(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return "", 0, err
+	}
+	portName := ""
+	found := false
+	for _, p := range svc.Spec.Ports {
+		if p.Port == svcPort {
+			portName = p.Name
+			found = true
+			break
+		}
+	}
+	if !found {
+		return "", 0, fmt.Errorf("service %s/%s has no port %d", ref.Namespace, ref.Name, svcPort)
+	}
+
+	endpoints, err := hc.kubeAPI.CoreV1().Endpoints(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return "", 0, err
+	}
+	for _, subset := range endpoints.Subsets {
+		for _, port := range subset.Ports {
+			if port.Name != portName {
+				continue
+			}
+			for _, addr := range subset.Addresses {
+				if addr.TargetRef == nil || addr.TargetRef.Kind != "Pod" {
+					continue
+				}
+				deployName, err := hc.getPodDeployment(ctx, ref.Namespace, addr.TargetRef.Name)
+				if err != nil {
+					return "", 0, err
+				}
+				if deployName != "" {
+					return deployName, int(port.Port), nil
+				}
+			}
+		}
+	}
+
+	return "", 0, fmt.Errorf("no ready pods found for service %s/%s", ref.Namespace, ref.Name)
+}
+
+// getPodDeployment returns the name of the deployment owning the pod, or an
+// empty string if it isn't owned by a deployment
+func (hc *HealthChecker) getPodDeployment(ctx context.Context, namespace, podName string) (string, error) {
+	pod, err := hc.kubeAPI.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	for _, parent := range pod.GetOwnerReferences() {
+		if parent.Kind != "ReplicaSet" {
+			continue
+		}
+		rs, err := hc.kubeAPI.AppsV1().ReplicaSets(namespace).Get(ctx, parent.Name, metav1.GetOptions{})
+		if err != nil {
+			return "", err
+		}
+		for _, grandparent := range rs.GetOwnerReferences() {
+			if grandparent.Kind == "Deployment" {
+				return grandparent.Name, nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// syntheticAdmissionReview builds a dry-run AdmissionReview for the first
+// resource matched by the webhook rules, for an object in the given namespace
+func syntheticAdmissionReview(namespace string, rules []admissionRegistration.RuleWithOperations) *admissionv1beta1.AdmissionReview {
+	group, version, resource := "", "v1", "pods"
+	if len(rules) > 0 && len(rules[0].Resources) > 0 && rules[0].Resources[0] != "*" {
+		resource = rules[0].Resources[0]
+		if len(rules[0].APIGroups) > 0 && rules[0].APIGroups[0] != "*" {
+			group = rules[0].APIGroups[0]
+		}
+		if len(rules[0].APIVersions) > 0 && rules[0].APIVersions[0] != "*" {
+			version = rules[0].APIVersions[0]
+		}
+	}
+
+	kind := resourceToKind(resource)
+	apiVersion := version
+	if group != "" {
+		apiVersion = fmt.Sprintf("%s/%s", group, version)
+	}
+	object := map[string]interface{}{
+		"apiVersion": apiVersion,
+		"kind":       kind,
+		"metadata": map[string]interface{}{
+			"name":      syntheticAdmissionReviewName,
+			"namespace": namespace,
+		},
+	}
+	if kind == "Pod" {
+		object["spec"] = corev1.PodSpec{
+			Containers: []corev1.Container{{Name: syntheticAdmissionReviewName, Image: syntheticAdmissionReviewName}},
+		}
+	}
+	raw, _ := json.Marshal(object)
+
+	dryRun := true
+	return &admissionv1beta1.AdmissionReview{
+		TypeMeta: metav1.TypeMeta{APIVersion: "admission.k8s.io/v1beta1", Kind: "AdmissionReview"},
+		Request: &admissionv1beta1.AdmissionRequest{
+			UID:       types.UID(fmt.Sprintf("%s-%d", syntheticAdmissionReviewName, time.Now().UnixNano())),
+			Kind:      metav1.GroupVersionKind{Group: group, Version: version, Kind: kind},
+			Resource:  metav1.GroupVersionResource{Group: group, Version: version, Resource: resource},
+			Name:      syntheticAdmissionReviewName,
+			Namespace: namespace,
+			Operation: admissionv1beta1.Create,
+			Object:    runtime.RawExtension{Raw: raw},
+			DryRun:    &dryRun,
+		},
+	}
+}
+
+func resourceToKind(resource string) string {
+	switch resource {
+	case "pods":
+		return "Pod"
+	case "services":
+		return "Service"
+	case "serviceprofiles":
+		return "ServiceProfile"
+	}
+	singular := strings.TrimSuffix(resource, "s")
+	return strings.ToUpper(singular[:1]) + singular[1:]
+}
+
+// sendAdmissionReview posts the review to the webhook url. The webhook serving
+// certificate is verified against caBundle, for the given serverName; when
+// the verification fails, the probe caBundleErr is set and the request is
+// retried without verification so that the latency can still be measured.
+func sendAdmissionReview(
+	ctx context.Context,
+	url, serverName string,
+	caBundle []byte,
+	review *admissionv1beta1.AdmissionReview,
+) webhookProbe {
+	var probe webhookProbe
+	body, err := json.Marshal(review)
+	if err != nil {
+		probe.err = err
+		return probe
+	}
+
+	roots := x509.NewCertPool()
+	tlsConfig := &cryptotls.Config{RootCAs: roots, ServerName: serverName}
+	if !roots.AppendCertsFromPEM(caBundle) {
+		probe.caBundleErr = errors.New("caBundle contains no valid certificates")
+		tlsConfig = &cryptotls.Config{InsecureSkipVerify: true}
+	}
+
+	latency, resp, err := postAdmissionReview(ctx, url, tlsConfig, body)
+	if err != nil && probe.caBundleErr == nil && isCertificateError(err) {
+		probe.caBundleErr = fmt.Errorf("serving certificate doesn't match the caBundle: %s", err)
+		tlsConfig = &cryptotls.Config{InsecureSkipVerify: true}
+		latency, resp, err = postAdmissionReview(ctx, url, tlsConfig, body)
+	}
+	if err != nil {
+		probe.err = err
+		return probe
+	}
+	probe.latency = latency
+
+	var response admissionv1beta1.AdmissionReview
+	if err := json.Unmarshal(resp, &response); err != nil {
+		probe.err = fmt.Errorf("invalid AdmissionReview response: %s", err)
+		return probe
+	}
+	if response.Response == nil || response.Response.UID != review.Request.UID {
+		probe.err = errors.New("AdmissionReview response doesn't match the request")
+	}
+
+	return probe
+}
+
+func postAdmissionReview(ctx context.Context, url string, tlsConfig *cryptotls.Config, body []byte) (time.Duration, []byte, error) {
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}}
+	defer client.CloseIdleConnections()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	latency := time.Since(start)
+	if err != nil {
+		return 0, nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return latency, nil, fmt.Errorf("unexpected HTTP status: %s", resp.Status)
+	}
+
+	return latency, respBody, nil
+}
+
+func isCertificateError(err error) bool {
+	var unknownAuthorityErr x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	var certInvalidErr x509.CertificateInvalidError
+	return errors.As(err, &unknownAuthorityErr) ||
+		errors.As(err, &hostnameErr) ||
+		errors.As(err, &certInvalidErr)
+}
+
+func checkWebhookResponses(probes []webhookProbe) error {
+	var failed []string
+	for _, probe := range probes {
+		if probe.err != nil {
+			failed = append(failed, fmt.Sprintf("\t* %s: %s", probe.name, probe.err))
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("Some webhooks failed to respond to a synthetic AdmissionReview:\n%s", strings.Join(failed, "\n"))
+	}
+	return nil
+}
+
+func checkWebhookCABundles(probes []webhookProbe) error {
+	var mismatched []string
+	for _, probe := range probes {
+		if probe.caBundleErr != nil {
+			mismatched = append(mismatched, fmt.Sprintf("\t* %s: %s", probe.name, probe.caBundleErr))
+		}
+	}
+	if len(mismatched) > 0 {
+		return fmt.Errorf("Some webhooks caBundles don't match their serving certificates:\n%s", strings.Join(mismatched, "\n"))
+	}
+	return nil
+}
+
+// checkWebhookLatencies reports the latency of each webhook that responded,
+// failing if one of them is slow
+func checkWebhookLatencies(probes []webhookProbe) error {
+	slow := false
+	var latencies []string
+	for _, probe := range probes {
+		if probe.err != nil {
+			continue
+		}
+		if probe.latency > webhookLatencyThreshold {
+			slow = true
+		}
+		latencies = append(latencies, fmt.Sprintf("\t* %s: %s", probe.name, probe.latency.Round(time.Millisecond)))
+	}
+	if slow {
+		return fmt.Errorf("Some webhooks took more than %s to respond:\n%s", webhookLatencyThreshold, strings.Join(latencies, "\n"))
+	}
+	if len(latencies) > 0 {
+		return &VerboseSuccess{Message: strings.Join(latencies, "\n")}
+	}
+	return nil
+}
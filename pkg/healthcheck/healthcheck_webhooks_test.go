@@ -0,0 +1,135 @@
+package healthcheck
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/tls"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	admissionRegistration "k8s.io/api/admissionregistration/v1"
+)
+
+func TestSendAdmissionReview(t *testing.T) {
+	echo := func(w http.ResponseWriter, r *http.Request) {
+		var review admissionv1beta1.AdmissionReview
+		if err := json.NewDecoder(r.Body).Decode(&review); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		review.Response = &admissionv1beta1.AdmissionResponse{UID: review.Request.UID, Allowed: true}
+		json.NewEncoder(w).Encode(review)
+	}
+	server := httptest.NewTLSServer(http.HandlerFunc(echo))
+	defer server.Close()
+
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	otherCA, err := tls.GenerateRootCAWithDefaults("other-ca")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		name        string
+		url         string
+		caBundle    []byte
+		caBundleErr string
+	}{
+		{
+			name:     "matching caBundle",
+			url:      server.URL,
+			caBundle: caBundle,
+		},
+		{
+			name:        "empty caBundle",
+			url:         server.URL,
+			caBundleErr: "caBundle contains no valid certificates",
+		},
+		{
+			name:        "mismatched caBundle",
+			url:         server.URL,
+			caBundle:    []byte(otherCA.Cred.Crt.EncodeCertificatePEM()),
+			caBundleErr: "serving certificate doesn't match the caBundle",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			review := syntheticAdmissionReview("linkerd", nil)
+			probe := sendAdmissionReview(context.Background(), tc.url, "example.com", tc.caBundle, review)
+			if probe.err != nil {
+				t.Fatalf("Unexpected error: %s", probe.err)
+			}
+			caBundleErr := probe.caBundleErr
+			if tc.caBundleErr == "" && caBundleErr != nil {
+				t.Fatalf("Unexpected caBundle error: %s", caBundleErr)
+			}
+			if tc.caBundleErr != "" && (caBundleErr == nil || !strings.HasPrefix(caBundleErr.Error(), tc.caBundleErr)) {
+				t.Fatalf("Expected caBundle error %q, got %v", tc.caBundleErr, caBundleErr)
+			}
+		})
+	}
+}
+
+func TestSyntheticAdmissionReview(t *testing.T) {
+	rules := []admissionRegistration.RuleWithOperations{
+		{
+			Rule: admissionRegistration.Rule{
+				APIGroups:   []string{"linkerd.io"},
+				APIVersions: []string{"v1alpha2"},
+				Resources:   []string{"serviceprofiles"},
+			},
+		},
+	}
+	review := syntheticAdmissionReview("emojivoto", rules)
+
+	if review.Request.Kind.Kind != "ServiceProfile" || review.Request.Kind.Group != "linkerd.io" {
+		t.Fatalf("Unexpected kind: %v", review.Request.Kind)
+	}
+	if review.Request.DryRun == nil || !*review.Request.DryRun {
+		t.Fatal("Expected a dry-run AdmissionReview")
+	}
+
+	var object map[string]interface{}
+	if err := json.Unmarshal(review.Request.Object.Raw, &object); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if object["apiVersion"] != "linkerd.io/v1alpha2" {
+		t.Fatalf("Unexpected apiVersion: %v", object["apiVersion"])
+	}
+}
+
+func TestCheckWebhookLatencies(t *testing.T) {
+	probes := []webhookProbe{
+		{name: "linkerd-proxy-injector.linkerd.io", latency: 12 * time.Millisecond},
+		{name: "linkerd-sp-validator.linkerd.io", latency: 3 * time.Millisecond},
+		{name: "linkerd-tap-injector.linkerd.io", err: errors.New("connection refused")},
+	}
+
+	err := checkWebhookLatencies(probes)
+	vs, ok := err.(*VerboseSuccess)
+	if !ok {
+		t.Fatalf("Expected the latencies to be reported, got %v", err)
+	}
+	expected := "\t* linkerd-proxy-injector.linkerd.io: 12ms\n\t* linkerd-sp-validator.linkerd.io: 3ms"
+	if vs.Message != expected {
+		t.Fatalf("Expected message %q, got %q", expected, vs.Message)
+	}
+
+	probes[1].latency = 2 * time.Second
+	err = checkWebhookLatencies(probes)
+	if _, ok := err.(*VerboseSuccess); ok || err == nil || !strings.HasPrefix(err.Error(), "Some webhooks took more than 1s to respond:") {
+		t.Fatalf("Expected the slow webhook to be reported, got %v", err)
+	}
+
+	if err := checkWebhookLatencies(nil); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+}
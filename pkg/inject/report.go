@@ -14,8 +14,8 @@ const (
 	hostNetworkEnabled                   = "host_network_enabled"
 	sidecarExists                        = "sidecar_already_exists"
 	unsupportedResource                  = "unsupported_resource"
-	injectEnableAnnotationAbsent         = "injection_enable_annotation_absent"
-	injectDisableAnnotationPresent       = "injection_disable_annotation_present"
+	injectEnableAnnotationAbsent         = k8s.InjectSkipReasonEnableAnnotationAbsent
+	injectDisableAnnotationPresent       = k8s.InjectSkipReasonDisableAnnotationPresent
 	annotationAtNamespace                = "namespace"
 	annotationAtWorkload                 = "workload"
 	invalidInjectAnnotationWorkload      = "invalid_inject_annotation_at_workload"
@@ -54,6 +54,7 @@ type Report struct {
 	Annotatable                  bool
 	Annotated                    bool
 	AutomountServiceAccountToken bool
+	MeshExempt                   bool // true if the pod has the MeshExemptAnnotation set to "true"
 
 	// Uninjected consists of two boolean flags to indicate if a proxy and
 	// proxy-init containers have been uninjected in this report
@@ -90,6 +91,7 @@ func newReport(conf *ResourceConfig) *Report {
 		report.HostNetwork = conf.pod.spec.HostNetwork
 		report.Sidecar = healthcheck.HasExistingSidecars(conf.pod.spec)
 		report.UDP = checkUDPPorts(conf.pod.spec)
+		report.MeshExempt = conf.pod.meta.Annotations[k8s.MeshExemptAnnotation] == "true"
 		if conf.pod.spec.AutomountServiceAccountToken != nil {
 			report.AutomountServiceAccountToken = *conf.pod.spec.AutomountServiceAccountToken
 		}
@@ -81,6 +81,10 @@ const (
 	// Namespace that this proxy belongs to.
 	WorkloadNamespaceLabel = Prefix + "/workload-ns"
 
+	// MeshRequiredLabel is set to "true" on a namespace to have the injection
+	// policy webhook reject pods in that namespace that don't get a proxy.
+	MeshRequiredLabel = Prefix + "/mesh-required"
+
 	// Enabled is used by annotations whose valid values include "enabled".
 	Enabled = "enabled"
 
@@ -120,6 +124,31 @@ const (
 	// disable injection for a pod or namespace.
 	ProxyInjectDisabled = Disabled
 
+	// MeshExemptAnnotation is set to "true" on a pod to exempt it from the
+	// MeshRequiredLabel policy of its namespace.
+	MeshExemptAnnotation = Prefix + "/mesh-exempt"
+
+	// ProxyInjectSkipReasonsAnnotation is set by the proxy injector on the
+	// events it records when skipping injection. It holds the comma-separated
+	// keys of the reasons why injection was skipped.
+	ProxyInjectSkipReasonsAnnotation = Prefix + "/inject-skip-reasons"
+
+	// InjectSkipReasonEnableAnnotationAbsent is the key of the proxy injector
+	// skip reason of workloads that don't opt into the mesh.
+	InjectSkipReasonEnableAnnotationAbsent = "injection_enable_annotation_absent"
+
+	// InjectSkipReasonDisableAnnotationPresent is the key of the proxy
+	// injector skip reason of workloads that opt out of the mesh.
+	InjectSkipReasonDisableAnnotationPresent = "injection_disable_annotation_present"
+
+	// InjectionSkippedEventReason is the reason of the events recorded by the
+	// proxy injector on the owner of a pod whose injection was skipped.
+	InjectionSkippedEventReason = "InjectionSkipped"
+
+	// InjectedEventReason is the reason of the events recorded by the proxy
+	// injector on the owner of a pod injected with the proxy.
+	InjectedEventReason = "Injected"
+
 	// IdentityModeAnnotation controls how a pod participates
 	// in service identity.
 	IdentityModeAnnotation = Prefix + "/identity-mode"
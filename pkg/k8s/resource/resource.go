@@ -11,6 +11,7 @@ import (
 	policy "k8s.io/api/policy/v1beta1"
 	rbac "k8s.io/api/rbac/v1"
 	apiextension "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	apiRegistration "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
@@ -71,6 +72,14 @@ func (r Kubernetes) RenderResource(w io.Writer) error {
 	return err
 }
 
+// Delete deletes the kubernetes object from the cluster. Its dependents, e.g.
+// the resources of a namespace, are deleted in the background.
+func (r Kubernetes) Delete(ctx context.Context, k *k8s.KubernetesAPI) error {
+	gvr, _ := meta.UnsafeGuessKindToResource(r.GroupVersionKind())
+	propagation := metav1.DeletePropagationBackground
+	return k.DynamicClient.Resource(gvr).Namespace(r.Namespace).Delete(ctx, r.Name, metav1.DeleteOptions{PropagationPolicy: &propagation})
+}
+
 // FetchKubernetesResources returns a slice of all cluster scoped kubernetes
 // resources which match the given ListOptions.
 func FetchKubernetesResources(ctx context.Context, k *k8s.KubernetesAPI, options metav1.ListOptions) ([]Kubernetes, error) {
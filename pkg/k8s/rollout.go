@@ -0,0 +1,200 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// RestartedAtAnnotation is the pod template annotation updated to trigger a
+// rollout of a workload; it's the same one used by `kubectl rollout restart`.
+const RestartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
+// Workload identifies a Deployment, DaemonSet or StatefulSet, the kinds of
+// workloads that can be restarted by RestartWorkload.
+type Workload struct {
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+func (w Workload) String() string {
+	return fmt.Sprintf("%s/%s/%s", w.Namespace, w.Kind, w.Name)
+}
+
+// GetPodWorkload returns the workload owning the given pod, resolving
+// ReplicaSets into their owning Deployment. It returns nil if the pod isn't
+// owned by a Deployment, DaemonSet or StatefulSet.
+func (kubeAPI *KubernetesAPI) GetPodWorkload(ctx context.Context, pod corev1.Pod) (*Workload, error) {
+	ownerRef := metav1.GetControllerOf(&pod)
+	if ownerRef == nil {
+		return nil, nil
+	}
+
+	switch strings.ToLower(ownerRef.Kind) {
+	case Deployment, DaemonSet, StatefulSet:
+		return &Workload{strings.ToLower(ownerRef.Kind), pod.Namespace, ownerRef.Name}, nil
+	case ReplicaSet:
+		rs, err := kubeAPI.AppsV1().ReplicaSets(pod.Namespace).Get(ctx, ownerRef.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		rsOwnerRef := metav1.GetControllerOf(rs)
+		if rsOwnerRef == nil || strings.ToLower(rsOwnerRef.Kind) != Deployment {
+			return nil, nil
+		}
+		return &Workload{Deployment, pod.Namespace, rsOwnerRef.Name}, nil
+	}
+
+	return nil, nil
+}
+
+// RestartWorkload triggers a rollout of the given workload by updating the
+// RestartedAtAnnotation annotation of its pod template.
+func (kubeAPI *KubernetesAPI) RestartWorkload(ctx context.Context, w Workload) error {
+	return kubeAPI.PatchWorkloadTemplateAnnotations(ctx, w, map[string]*string{})
+}
+
+// PatchWorkloadTemplateAnnotations sets the given annotations on the pod
+// template of the workload, removing those with a nil value, and triggers a
+// rollout by also updating its RestartedAtAnnotation annotation.
+func (kubeAPI *KubernetesAPI) PatchWorkloadTemplateAnnotations(ctx context.Context, w Workload, annotations map[string]*string) error {
+	restartedAt := time.Now().Format(time.RFC3339)
+	merged := map[string]*string{RestartedAtAnnotation: &restartedAt}
+	for k, v := range annotations {
+		merged[k] = v
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"annotations": merged,
+				},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	switch w.Kind {
+	case Deployment:
+		_, err = kubeAPI.AppsV1().Deployments(w.Namespace).Patch(ctx, w.Name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	case DaemonSet:
+		_, err = kubeAPI.AppsV1().DaemonSets(w.Namespace).Patch(ctx, w.Name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	case StatefulSet:
+		_, err = kubeAPI.AppsV1().StatefulSets(w.Namespace).Patch(ctx, w.Name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	default:
+		err = fmt.Errorf("unsupported workload kind: %s", w.Kind)
+	}
+	return err
+}
+
+// WaitForRollout polls the given workload until its latest rollout completes,
+// returning an error if the rollout fails or if the context is done first.
+func (kubeAPI *KubernetesAPI) WaitForRollout(ctx context.Context, w Workload, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		done, err := kubeAPI.rolloutComplete(ctx, w)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for %s to be rolled out", w)
+		case <-ticker.C:
+		}
+	}
+}
+
+// rolloutComplete adapts the logic of `kubectl rollout status` to tell
+// whether the latest rollout of a workload has completed.
+func (kubeAPI *KubernetesAPI) rolloutComplete(ctx context.Context, w Workload) (bool, error) {
+	switch w.Kind {
+	case Deployment:
+		d, err := kubeAPI.AppsV1().Deployments(w.Namespace).Get(ctx, w.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		return deploymentRolloutComplete(d)
+	case DaemonSet:
+		ds, err := kubeAPI.AppsV1().DaemonSets(w.Namespace).Get(ctx, w.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		return daemonSetRolloutComplete(ds), nil
+	case StatefulSet:
+		ss, err := kubeAPI.AppsV1().StatefulSets(w.Namespace).Get(ctx, w.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		return statefulSetRolloutComplete(ss), nil
+	}
+
+	return false, fmt.Errorf("unsupported workload kind: %s", w.Kind)
+}
+
+// daemonSetRolloutComplete returns true once the pods of a DaemonSet are
+// updated. With the OnDelete strategy the pods are only updated when they're
+// deleted, so the rollout is complete once the DaemonSet has been observed.
+func daemonSetRolloutComplete(ds *appsv1.DaemonSet) bool {
+	if ds.Status.ObservedGeneration < ds.Generation {
+		return false
+	}
+	if ds.Spec.UpdateStrategy.Type == appsv1.OnDeleteDaemonSetStrategyType {
+		return true
+	}
+	return ds.Status.UpdatedNumberScheduled == ds.Status.DesiredNumberScheduled &&
+		ds.Status.NumberAvailable == ds.Status.DesiredNumberScheduled
+}
+
+// statefulSetRolloutComplete returns true once the pods of a StatefulSet are
+// updated. With the OnDelete strategy the pods are only updated when they're
+// deleted, so the rollout is complete once the StatefulSet has been observed.
+func statefulSetRolloutComplete(ss *appsv1.StatefulSet) bool {
+	if ss.Status.ObservedGeneration < ss.Generation {
+		return false
+	}
+	if ss.Spec.UpdateStrategy.Type == appsv1.OnDeleteStatefulSetStrategyType {
+		return true
+	}
+	replicas := int32(1)
+	if ss.Spec.Replicas != nil {
+		replicas = *ss.Spec.Replicas
+	}
+	return ss.Status.ReadyReplicas == replicas &&
+		ss.Status.UpdatedReplicas == replicas &&
+		ss.Status.CurrentRevision == ss.Status.UpdateRevision
+}
+
+func deploymentRolloutComplete(d *appsv1.Deployment) (bool, error) {
+	if d.Status.ObservedGeneration < d.Generation {
+		return false, nil
+	}
+	for _, cond := range d.Status.Conditions {
+		if cond.Type == appsv1.DeploymentProgressing && cond.Reason == "ProgressDeadlineExceeded" {
+			return false, fmt.Errorf("deployment %s/%s exceeded its progress deadline", d.Namespace, d.Name)
+		}
+	}
+
+	replicas := int32(1)
+	if d.Spec.Replicas != nil {
+		replicas = *d.Spec.Replicas
+	}
+	return d.Status.UpdatedReplicas == replicas &&
+		d.Status.Replicas == d.Status.UpdatedReplicas &&
+		d.Status.AvailableReplicas == d.Status.UpdatedReplicas, nil
+}
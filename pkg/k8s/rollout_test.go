@@ -0,0 +1,202 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestWaitForRollout(t *testing.T) {
+	testCases := []struct {
+		name     string
+		workload Workload
+		config   string
+		complete bool
+	}{
+		{
+			name:     "complete deployment",
+			workload: Workload{Deployment, "emojivoto", "web"},
+			config: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  namespace: emojivoto
+  generation: 2
+spec:
+  replicas: 2
+status:
+  observedGeneration: 2
+  replicas: 2
+  updatedReplicas: 2
+  availableReplicas: 2
+`,
+			complete: true,
+		},
+		{
+			name:     "deployment with outdated replicas",
+			workload: Workload{Deployment, "emojivoto", "web"},
+			config: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  namespace: emojivoto
+  generation: 2
+spec:
+  replicas: 2
+status:
+  observedGeneration: 2
+  replicas: 3
+  updatedReplicas: 2
+  availableReplicas: 2
+`,
+		},
+		{
+			name:     "complete daemonset",
+			workload: Workload{DaemonSet, "kube-system", "agent"},
+			config: `
+apiVersion: apps/v1
+kind: DaemonSet
+metadata:
+  name: agent
+  namespace: kube-system
+  generation: 1
+status:
+  observedGeneration: 1
+  desiredNumberScheduled: 3
+  updatedNumberScheduled: 3
+  numberAvailable: 3
+`,
+			complete: true,
+		},
+		{
+			name:     "statefulset with pending revision",
+			workload: Workload{StatefulSet, "emojivoto", "vote-bot"},
+			config: `
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: vote-bot
+  namespace: emojivoto
+  generation: 1
+spec:
+  replicas: 1
+status:
+  observedGeneration: 1
+  readyReplicas: 1
+  updatedReplicas: 1
+  currentRevision: vote-bot-1
+  updateRevision: vote-bot-2
+`,
+		},
+		{
+			name:     "statefulset updated on delete",
+			workload: Workload{StatefulSet, "emojivoto", "vote-bot"},
+			config: `
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: vote-bot
+  namespace: emojivoto
+  generation: 1
+spec:
+  replicas: 1
+  updateStrategy:
+    type: OnDelete
+status:
+  observedGeneration: 1
+  readyReplicas: 1
+  updatedReplicas: 0
+  currentRevision: vote-bot-1
+  updateRevision: vote-bot-2
+`,
+			complete: true,
+		},
+		{
+			name:     "daemonset updated on delete",
+			workload: Workload{DaemonSet, "kube-system", "agent"},
+			config: `
+apiVersion: apps/v1
+kind: DaemonSet
+metadata:
+  name: agent
+  namespace: kube-system
+  generation: 1
+spec:
+  updateStrategy:
+    type: OnDelete
+status:
+  observedGeneration: 1
+  desiredNumberScheduled: 3
+  updatedNumberScheduled: 0
+  numberAvailable: 3
+`,
+			complete: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.name, func(t *testing.T) {
+			api, err := NewFakeAPI(tc.config)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if err := api.RestartWorkload(context.Background(), tc.workload); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+			err = api.WaitForRollout(ctx, tc.workload, 10*time.Millisecond)
+			if tc.complete && err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if !tc.complete && err == nil {
+				t.Fatal("Expected the rollout to time out")
+			}
+		})
+	}
+}
+
+func TestPatchWorkloadTemplateAnnotations(t *testing.T) {
+	api, err := NewFakeAPI(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  namespace: emojivoto
+spec:
+  template:
+    metadata:
+      annotations:
+        linkerd.io/inject: enabled
+        config.linkerd.io/skip-inbound-ports: "4222"
+`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	w := Workload{Deployment, "emojivoto", "web"}
+	if err := api.PatchWorkloadTemplateAnnotations(context.Background(), w, map[string]*string{ProxyInjectAnnotation: nil}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	d, err := api.AppsV1().Deployments("emojivoto").Get(context.Background(), "web", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	annotations := d.Spec.Template.Annotations
+	if _, ok := annotations[ProxyInjectAnnotation]; ok {
+		t.Fatalf("Expected the %s annotation to be removed", ProxyInjectAnnotation)
+	}
+	if annotations[ProxyIgnoreInboundPortsAnnotation] != "4222" {
+		t.Fatalf("Expected the other annotations to be kept, got %v", annotations)
+	}
+	if annotations[RestartedAtAnnotation] == "" {
+		t.Fatalf("Expected the %s annotation to be set", RestartedAtAnnotation)
+	}
+}
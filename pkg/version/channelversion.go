@@ -2,6 +2,7 @@ package version
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 )
 
@@ -37,3 +38,60 @@ func IsReleaseChannel(version string) (bool, error) {
 	}
 	return cv.channel == "edge" || cv.channel == "stable", nil
 }
+
+// CompareChannelVersions compares two versions of the same release channel,
+// returning -1, 0 or 1 if a is older than, the same as or newer than b. It
+// returns an error if the versions are of different channels, as their order
+// can't be known, or if they aren't of the CHANNEL-X.Y.Z form.
+func CompareChannelVersions(a, b string) (int, error) {
+	cva, err := parseChannelVersion(a)
+	if err != nil {
+		return 0, err
+	}
+	cvb, err := parseChannelVersion(b)
+	if err != nil {
+		return 0, err
+	}
+	if cva.channel != cvb.channel {
+		return 0, fmt.Errorf("%s and %s are of different release channels", a, b)
+	}
+
+	na, err := versionNumbers(cva)
+	if err != nil {
+		return 0, err
+	}
+	nb, err := versionNumbers(cvb)
+	if err != nil {
+		return 0, err
+	}
+	for i := 0; i < len(na) || i < len(nb); i++ {
+		var x, y int
+		if i < len(na) {
+			x = na[i]
+		}
+		if i < len(nb) {
+			y = nb[i]
+		}
+		if x < y {
+			return -1, nil
+		}
+		if x > y {
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+// versionNumbers returns the dot-separated numbers of a channel version
+func versionNumbers(cv channelVersion) ([]int, error) {
+	parts := strings.Split(cv.version, ".")
+	numbers := make([]int, len(parts))
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("unsupported version format: %s", cv)
+		}
+		numbers[i] = n
+	}
+	return numbers, nil
+}
@@ -52,3 +52,40 @@ func TestIsReleaseChannel(t *testing.T) {
 		})
 	}
 }
+
+func TestCompareChannelVersions(t *testing.T) {
+	cases := []struct {
+		a             string
+		b             string
+		expected      int
+		expectedError bool
+	}{
+		{a: "stable-2.10.0", b: "stable-2.10.2", expected: -1},
+		{a: "stable-2.10.2", b: "stable-2.10.2", expected: 0},
+		{a: "stable-2.11.0", b: "stable-2.10.2", expected: 1},
+		{a: "stable-2.9.1", b: "stable-2.10.0", expected: -1},
+		{a: "edge-21.5.3", b: "edge-21.5.10", expected: -1},
+		{a: "edge-21.6.1", b: "edge-21.5.3", expected: 1},
+		{a: "edge-21.5.3", b: "stable-2.10.2", expectedError: true},
+		{a: "dev-abcdef01-jane", b: "dev-abcdef01-jane", expectedError: true},
+		{a: "latest", b: "stable-2.10.2", expectedError: true},
+	}
+	for _, c := range cases {
+		c := c // pin
+		t.Run(c.a+" "+c.b, func(t *testing.T) {
+			actual, err := CompareChannelVersions(c.a, c.b)
+			if c.expectedError {
+				if err == nil {
+					t.Fatalf("Expected an error, got %d", actual)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if actual != c.expected {
+				t.Fatalf("Expected %d, got %d", c.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package version
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// MaxStableSkew is the number of stable minor releases that proxies are
+	// allowed to be away from the control plane, e.g. stable-2.10.x proxies are
+	// supported by stable-2.11.x control planes but not by stable-2.12.x ones
+	MaxStableSkew = 1
+
+	// MaxEdgeSkew is the number of months that edge proxies are allowed to be
+	// away from the control plane, as edge releases are versioned by date
+	MaxEdgeSkew = 3
+)
+
+// Compatibility describes how far proxies of a release channel are allowed to
+// be from the control plane
+type Compatibility struct {
+	// MaxSkew is the maximum number of releases, in Unit, between the proxies
+	// and the control plane
+	MaxSkew int
+	// Unit is the unit of the release numbers of the channel
+	Unit string
+}
+
+// CompatibilityTable lists the supported skew between proxies and the
+// control plane for each release channel. The skew can only be determined
+// between versions of the same release channel, so it's always allowed for
+// development builds and when switching between the edge and stable channels.
+var CompatibilityTable = map[string]Compatibility{
+	"stable": {MaxSkew: MaxStableSkew, Unit: "minor releases"},
+	"edge":   {MaxSkew: MaxEdgeSkew, Unit: "months"},
+}
+
+// ProxySkew returns how many releases the proxy version is behind the
+// control plane version (negative if it's ahead), along with the
+// compatibility of their channel. The compatibility is nil if the skew can't
+// be determined.
+func ProxySkew(proxyVersion, controlPlaneVersion string) (int, *Compatibility, error) {
+	proxy, err := parseChannelVersion(proxyVersion)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to parse proxy version: %s", err)
+	}
+	controlPlane, err := parseChannelVersion(controlPlaneVersion)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to parse control plane version: %s", err)
+	}
+	if proxy.channel != controlPlane.channel {
+		return 0, nil, nil
+	}
+	compatibility, ok := CompatibilityTable[proxy.channel]
+	if !ok {
+		return 0, nil, nil
+	}
+
+	proxyRelease, err := releaseNumber(proxy)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to parse proxy version: %s", err)
+	}
+	controlPlaneRelease, err := releaseNumber(controlPlane)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to parse control plane version: %s", err)
+	}
+	return controlPlaneRelease - proxyRelease, &compatibility, nil
+}
+
+// IsReleaseVersion returns true if v is the version of a release of one of
+// the channels of the CompatibilityTable, e.g. stable-2.11.0, whose skew from
+// other versions can thus be determined
+func IsReleaseVersion(v string) bool {
+	cv, err := parseChannelVersion(v)
+	if err != nil {
+		return false
+	}
+	if _, ok := CompatibilityTable[cv.channel]; !ok {
+		return false
+	}
+	_, err = releaseNumber(cv)
+	return err == nil
+}
+
+// CheckProxySkew returns an error if the proxy version is too far away from
+// the control plane version for the proxy to be supported by that control
+// plane, according to the CompatibilityTable.
+func CheckProxySkew(proxyVersion, controlPlaneVersion string) error {
+	skew, compatibility, err := ProxySkew(proxyVersion, controlPlaneVersion)
+	if err != nil || compatibility == nil {
+		return err
+	}
+	if skew < 0 {
+		skew = -skew
+	}
+	if skew > compatibility.MaxSkew {
+		return fmt.Errorf("%s is %d %s away from %s, the maximum supported skew is %d", proxyVersion, skew, compatibility.Unit, controlPlaneVersion, compatibility.MaxSkew)
+	}
+	return nil
+}
+
+// releaseNumber returns a number which increases by one with every stable
+// minor release (stable-MAJOR.MINOR.PATCH) or with every month of edge
+// releases (edge-YY.MM.N), so that the skew between two versions of the same
+// channel is the difference of their release numbers
+func releaseNumber(cv channelVersion) (int, error) {
+	parts := strings.SplitN(cv.version, ".", 3)
+	if len(parts) < 2 {
+		return 0, fmt.Errorf("unsupported version format: %s", cv)
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, fmt.Errorf("unsupported version format: %s", cv)
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, fmt.Errorf("unsupported version format: %s", cv)
+	}
+
+	if cv.channel == "edge" {
+		return major*12 + minor, nil
+	}
+	// minor releases never reset, as stable-2.x has been the only major
+	// version so far
+	return major*1000 + minor, nil
+}
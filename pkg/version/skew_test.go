@@ -0,0 +1,76 @@
+package version
+
+import (
+	"testing"
+)
+
+func TestCheckProxySkew(t *testing.T) {
+	cases := []struct {
+		proxy         string
+		controlPlane  string
+		expectedError bool
+	}{
+		{proxy: "stable-2.10.2", controlPlane: "stable-2.10.2"},
+		{proxy: "stable-2.10.2", controlPlane: "stable-2.11.0"},
+		{proxy: "stable-2.9.4", controlPlane: "stable-2.11.0", expectedError: true},
+		{proxy: "stable-2.12.0", controlPlane: "stable-2.10.0", expectedError: true},
+		{proxy: "edge-21.9.4", controlPlane: "edge-21.12.1"},
+		{proxy: "edge-21.11.1", controlPlane: "edge-22.1.2"},
+		{proxy: "edge-21.6.1", controlPlane: "edge-21.10.3", expectedError: true},
+		{proxy: "stable-2.9.4", controlPlane: "edge-21.10.3"},
+		{proxy: "dev-abcdef-foo", controlPlane: "dev-undefined"},
+		{proxy: "stable-2", controlPlane: "stable-2.11.0", expectedError: true},
+		{proxy: "unversioned", controlPlane: "stable-2.11.0", expectedError: true},
+	}
+
+	for _, c := range cases {
+		c := c // pin
+		t.Run(c.proxy+"/"+c.controlPlane, func(t *testing.T) {
+			err := CheckProxySkew(c.proxy, c.controlPlane)
+			if (err != nil) != c.expectedError {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestProxySkew(t *testing.T) {
+	skew, compatibility, err := ProxySkew("stable-2.10.2", "stable-2.11.0")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if skew != 1 || compatibility == nil || compatibility.MaxSkew != MaxStableSkew {
+		t.Fatalf("Unexpected skew %d with compatibility %v", skew, compatibility)
+	}
+
+	skew, _, err = ProxySkew("edge-22.1.2", "edge-21.11.1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if skew != -2 {
+		t.Fatalf("Expected the proxy to be 2 months ahead, got %d", skew)
+	}
+
+	_, compatibility, err = ProxySkew("stable-2.10.2", "edge-21.11.1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if compatibility != nil {
+		t.Fatalf("Expected the skew between channels not to be determined, got %v", compatibility)
+	}
+}
+
+func TestIsReleaseVersion(t *testing.T) {
+	for v, expected := range map[string]bool{
+		"stable-2.11.0":  true,
+		"edge-21.10.3":   true,
+		"dev-abcdef-foo": false,
+		"git-abcdef":     false,
+		"latest":         false,
+		"stable-2":       false,
+	} {
+		if IsReleaseVersion(v) != expected {
+			t.Errorf("Expected IsReleaseVersion(%q) to be %t", v, expected)
+		}
+	}
+}
@@ -17,6 +17,8 @@ type checkOptions struct {
 	wait      time.Duration
 	namespace string
 	output    string
+	include   []string
+	exclude   []string
 }
 
 func newCheckOptions() *checkOptions {
@@ -27,10 +29,12 @@ func newCheckOptions() *checkOptions {
 }
 
 func (options *checkOptions) validate() error {
-	if options.output != healthcheck.TableOutput && options.output != healthcheck.JSONOutput {
-		return fmt.Errorf("Invalid output type '%s'. Supported output types are: %s, %s", options.output, healthcheck.JSONOutput, healthcheck.TableOutput)
+	switch options.output {
+	case healthcheck.TableOutput, healthcheck.JSONOutput, healthcheck.JUnitOutput, healthcheck.SARIFOutput:
+	default:
+		return fmt.Errorf("Invalid output type '%s'. Supported output types are: %s, %s, %s, %s", options.output, healthcheck.JSONOutput, healthcheck.TableOutput, healthcheck.JUnitOutput, healthcheck.SARIFOutput)
 	}
-	return nil
+	return healthcheck.ValidatePatterns(append(options.include, options.exclude...))
 }
 
 // NewCmdCheck generates a new cobra command for the viz extension.
@@ -54,9 +58,10 @@ code.`,
 		},
 	}
 
-	cmd.Flags().StringVarP(&options.output, "output", "o", options.output, "Output format. One of: basic, json")
+	cmd.Flags().StringVarP(&options.output, "output", "o", options.output, "Output format. One of: basic, json, junit, sarif")
 	cmd.Flags().BoolVar(&options.proxy, "proxy", options.proxy, "Also run data-plane checks, to determine if the data plane is healthy")
 	cmd.Flags().DurationVar(&options.wait, "wait", options.wait, "Maximum allowed time for all tests to pass")
+	pkgcmd.AddCheckSelectionFlags(cmd.Flags(), &options.include, &options.exclude)
 	cmd.Flags().StringVarP(&options.namespace, "namespace", "n", options.namespace, "Namespace to use for --proxy checks (default: all namespaces)")
 
 	pkgcmd.ConfigureNamespaceFlagCompletion(
@@ -79,6 +84,8 @@ func configureAndRunChecks(wout io.Writer, werr io.Writer, options *checkOptions
 		ImpersonateGroup:      impersonateGroup,
 		APIAddr:               apiAddr,
 		RetryDeadline:         time.Now().Add(options.wait),
+		Include:               options.include,
+		Exclude:               options.exclude,
 		DataPlaneNamespace:    options.namespace,
 	})
 	err = hc.InitializeKubeAPIClient()
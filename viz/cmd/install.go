@@ -23,6 +23,7 @@ import (
 var (
 	templatesViz = []string{
 		"templates/namespace.yaml",
+		"templates/slo-crd.yaml",
 		"templates/metrics-api-rbac.yaml",
 		"templates/grafana-rbac.yaml",
 		"templates/prometheus-rbac.yaml",
@@ -26,6 +26,8 @@ const (
 	jsonOutput  = healthcheck.JSONOutput
 	tableOutput = healthcheck.TableOutput
 	wideOutput  = healthcheck.WideOutput
+	jsonlOutput = "jsonl"
+	harOutput   = "har"
 )
 
 var (
@@ -83,6 +85,7 @@ func NewCmdViz() *cobra.Command {
 	vizCmd.AddCommand(newCmdList())
 	vizCmd.AddCommand(newCmdProfile())
 	vizCmd.AddCommand(NewCmdRoutes())
+	vizCmd.AddCommand(NewCmdSLO())
 	vizCmd.AddCommand(NewCmdStat())
 	vizCmd.AddCommand(NewCmdTap())
 	vizCmd.AddCommand(NewCmdTop())
@@ -63,7 +63,13 @@ This command will only display traffic which is sent to a service that has a Ser
   linkerd viz routes service/webapp -n test
 
   # Routes for calls from the traffic deployment to the webapp service in the test namespace.
-  linkerd viz routes deploy/traffic -n test --to svc/webapp`,
+  linkerd viz routes deploy/traffic -n test --to svc/webapp
+
+  # p99 and p99.9 latencies of the routes of the webapp service in the test namespace.
+  linkerd viz routes service/webapp -n test --percentiles 99,99.9
+
+  # Breakdown of the responses of the routes of the webapp service in the test namespace by status.
+  linkerd viz routes service/webapp -n test --by-status`,
 		Args:      cobra.ExactArgs(1),
 		ValidArgs: pkg.ValidTargets,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -100,10 +106,15 @@ This command will only display traffic which is sent to a service that has a Ser
 
 	cmd.PersistentFlags().StringVarP(&options.namespace, "namespace", "n", options.namespace, "Namespace of the specified resource")
 	cmd.PersistentFlags().StringVarP(&options.timeWindow, "time-window", "t", options.timeWindow, "Stat window (for example: \"10s\", \"1m\", \"10m\", \"1h\")")
+	cmd.PersistentFlags().StringVar(&options.since, "since", options.since, "Start of the stats, as a duration before now (for example: \"2h\") or an RFC3339 timestamp; overrides \"--time-window\"")
+	cmd.PersistentFlags().StringVar(&options.until, "until", options.until, "End of the stats started with \"--since\", as a duration before now or an RFC3339 timestamp; defaults to now")
 	cmd.PersistentFlags().StringVar(&options.toResource, "to", options.toResource, "If present, shows outbound stats to the specified resource")
 	cmd.PersistentFlags().StringVar(&options.toNamespace, "to-namespace", options.toNamespace, "Sets the namespace used to lookup the \"--to\" resource; by default the current \"--namespace\" is used")
 	cmd.PersistentFlags().StringVarP(&options.outputFormat, "output", "o", options.outputFormat, fmt.Sprintf("Output format; one of: \"%s\", \"%s\", or \"%s\"", tableOutput, wideOutput, jsonOutput))
 	cmd.PersistentFlags().StringVarP(&options.labelSelector, "selector", "l", options.labelSelector, "Selector (label query) to filter on, supports '=', '==', and '!='")
+	cmd.PersistentFlags().Float64SliceVar(&options.percentiles, "percentiles", options.percentiles, "Latency percentiles to display instead of the p50, p95 and p99 (for example: \"50,99,99.9\")")
+	cmd.PersistentFlags().BoolVar(&options.histogram, "histogram", options.histogram, "If present, includes the histogram of the latencies in the JSON output")
+	cmd.PersistentFlags().BoolVar(&options.byStatus, "by-status", options.byStatus, "If present, breaks the responses down by HTTP status and gRPC status")
 
 	pkgcmd.ConfigureNamespaceFlagCompletion(
 		cmd, []string{"namespace", "to-namespace"},
@@ -145,13 +156,16 @@ func writeRouteStatsToBuffer(resp *pb.TopRoutesResponse, w *tabwriter.Writer, op
 				route := r.GetRoute()
 				table = append(table, &routeRowStats{
 					rowStats: rowStats{
-						route:       route,
-						dst:         r.GetAuthority(),
-						requestRate: getRequestRate(r.Stats.GetSuccessCount(), r.Stats.GetFailureCount(), r.TimeWindow),
-						successRate: getSuccessRate(r.Stats.GetSuccessCount(), r.Stats.GetFailureCount()),
-						latencyP50:  r.Stats.LatencyMsP50,
-						latencyP95:  r.Stats.LatencyMsP95,
-						latencyP99:  r.Stats.LatencyMsP99,
+						route:            route,
+						dst:              r.GetAuthority(),
+						requestRate:      getRequestRate(r.Stats.GetSuccessCount(), r.Stats.GetFailureCount(), r.TimeWindow),
+						successRate:      getSuccessRate(r.Stats.GetSuccessCount(), r.Stats.GetFailureCount()),
+						latencyP50:       r.Stats.LatencyMsP50,
+						latencyP95:       r.Stats.LatencyMsP95,
+						latencyP99:       r.Stats.LatencyMsP99,
+						percentiles:      options.percentileLatencies(r.Stats),
+						latencyHistogram: r.Stats.LatencyHistogram,
+						statusCounts:     r.Stats.StatusCounts,
 					},
 					actualRequestRate: getRequestRate(r.Stats.GetActualSuccessCount(), r.Stats.GetActualFailureCount(), r.TimeWindow),
 					actualSuccessRate: getSuccessRate(r.Stats.GetActualSuccessCount(), r.Stats.GetActualFailureCount()),
@@ -179,7 +193,11 @@ func writeRouteStatsToBuffer(resp *pb.TopRoutesResponse, w *tabwriter.Writer, op
 			if len(tables) > 1 {
 				fmt.Fprintf(w, "==> %s <==\t\f", resource)
 			}
-			printRouteTable(tables[resource], w, options)
+			if options.byStatus {
+				printRouteStatusBreakdown(tables[resource], w, options)
+			} else {
+				printRouteTable(tables[resource], w, options)
+			}
 			fmt.Fprintln(w)
 		}
 	case jsonOutput:
@@ -187,6 +205,25 @@ func writeRouteStatsToBuffer(resp *pb.TopRoutesResponse, w *tabwriter.Writer, op
 	}
 }
 
+// printRouteStatusBreakdown writes the breakdown of the responses of the
+// routes by status
+func printRouteStatusBreakdown(stats []*routeRowStats, w *tabwriter.Writer, options *routesOptions) {
+	authorityColumn := "AUTHORITY"
+	if options.dstIsService {
+		authorityColumn = "SERVICE"
+	}
+
+	rows := make([]statusBreakdownRow, 0, len(stats))
+	for _, row := range stats {
+		breakdown := statusBreakdownRow{labels: []string{row.route, row.dst}}
+		if row.hasRequestData {
+			breakdown.counts = row.statusCounts
+		}
+		rows = append(rows, breakdown)
+	}
+	printStatusBreakdown([]string{"ROUTE", authorityColumn}, rows, w)
+}
+
 func printRouteTable(stats []*routeRowStats, w *tabwriter.Writer, options *routesOptions) {
 	// template for left-aligning the route column
 	routeTemplate := fmt.Sprintf("%%-%ds", routeWidth(stats))
@@ -215,11 +252,9 @@ func printRouteTable(stats []*routeRowStats, w *tabwriter.Writer, options *route
 		}...)
 	}
 
-	headers = append(headers, []string{
-		"LATENCY_P50",
-		"LATENCY_P95",
-		"LATENCY_P99\t", // trailing \t is required to format last column
-	}...)
+	latencyHeaders := options.latencyHeaders()
+	headers = append(headers, latencyHeaders...)
+	headers[len(headers)-1] = headers[len(headers)-1] + "\t" // trailing \t is required to format last column
 
 	fmt.Fprintln(w, strings.Join(headers, "\t"))
 
@@ -229,15 +264,16 @@ func printRouteTable(stats []*routeRowStats, w *tabwriter.Writer, options *route
 		// actual success rate, actual rps
 		templateString = templateString + "%.2f%%\t%.1frps\t"
 	}
-	// p50, p95, p99
-	templateString = templateString + "%dms\t%dms\t%dms\t\n"
+	// latencies
+	templateString = templateString + strings.Repeat("%dms\t", len(latencyHeaders)) + "\n"
 
 	var emptyTemplateString string
 	if outputActual {
-		emptyTemplateString = routeTemplate + "\t%s\t-\t-\t-\t-\t-\t-\t-\t\n"
+		emptyTemplateString = routeTemplate + "\t%s\t-\t-\t-\t-\t"
 	} else {
-		emptyTemplateString = routeTemplate + "\t%s\t-\t-\t-\t-\t-\t\n"
+		emptyTemplateString = routeTemplate + "\t%s\t-\t-\t"
 	}
+	emptyTemplateString = emptyTemplateString + strings.Repeat("-\t", len(latencyHeaders)) + "\n"
 
 	for _, row := range stats {
 
@@ -258,11 +294,7 @@ func printRouteTable(stats []*routeRowStats, w *tabwriter.Writer, options *route
 					row.actualRequestRate,
 				}...)
 			}
-			values = append(values, []interface{}{
-				row.latencyP50,
-				row.latencyP95,
-				row.latencyP99,
-			}...)
+			values = append(values, row.latencies()...)
 
 			fmt.Fprintf(w, templateString, values...)
 		} else {
@@ -303,6 +335,11 @@ type JSONRouteStats struct {
 	LatencyMSp50     *uint64  `json:"latency_ms_p50"`
 	LatencyMSp95     *uint64  `json:"latency_ms_p95"`
 	LatencyMSp99     *uint64  `json:"latency_ms_p99"`
+	// LatencyMS are the latencies of the percentiles requested with
+	// --percentiles, indexed by percentile
+	LatencyMS        map[string]uint64    `json:"latency_ms,omitempty"`
+	LatencyHistogram []*jsonLatencyBucket `json:"latency_histogram,omitempty"`
+	StatusCounts     []*jsonStatusCount   `json:"status_counts,omitempty"`
 }
 
 func printRouteJSON(tables map[string][]*routeRowStats, w *tabwriter.Writer, options *routesOptions) {
@@ -328,6 +365,9 @@ func printRouteJSON(tables map[string][]*routeRowStats, w *tabwriter.Writer, opt
 			entry.LatencyMSp50 = &row.latencyP50
 			entry.LatencyMSp95 = &row.latencyP95
 			entry.LatencyMSp99 = &row.latencyP99
+			entry.LatencyMS = jsonPercentileLatencies(row.percentiles)
+			entry.LatencyHistogram = jsonLatencyHistogram(row.latencyHistogram)
+			entry.StatusCounts = jsonStatusCounts(row.statusCounts)
 
 			entries[resource] = append(entries[resource], entry)
 		}
@@ -360,17 +400,34 @@ func buildTopRoutesRequest(resource string, options *routesOptions) (*pb.TopRout
 		return nil, err
 	}
 
+	if err := options.validateLatencyFlags(); err != nil {
+		return nil, err
+	}
+
+	if err := options.validateStatusFlags(); err != nil {
+		return nil, err
+	}
+
 	target, err := coreUtil.BuildResource(options.namespace, resource)
 	if err != nil {
 		return nil, err
 	}
 
+	timeRange, err := options.timeRange()
+	if err != nil {
+		return nil, err
+	}
+
 	requestParams := util.TopRoutesRequestParams{
 		StatsBaseRequestParams: util.StatsBaseRequestParams{
-			TimeWindow:   options.timeWindow,
-			ResourceName: target.Name,
-			ResourceType: target.Type,
-			Namespace:    options.namespace,
+			TimeWindow:       options.timeWindow,
+			TimeRange:        timeRange,
+			ResourceName:     target.Name,
+			ResourceType:     target.Type,
+			Namespace:        options.namespace,
+			LatencyQuantiles: options.latencyQuantiles(),
+			LatencyHistogram: options.histogram,
+			StatusBreakdown:  options.byStatus,
 		},
 		LabelSelector: options.labelSelector,
 	}
@@ -34,6 +34,28 @@ func TestRoutes(t *testing.T) {
 		}, t)
 	})
 
+	percentilesOptions := newRoutesOptions()
+	percentilesOptions.percentiles = []float64{99, 99.9}
+	t.Run("Returns route stats with the requested latency percentiles", func(t *testing.T) {
+		testRoutesCall(routesParamsExp{
+			routes:  []string{"/a", "/b", "/c"},
+			counts:  []uint64{90, 60, 0, 30},
+			options: percentilesOptions,
+			file:    "routes_percentiles_output.golden",
+		}, t)
+	})
+
+	statusOptions := newRoutesOptions()
+	statusOptions.byStatus = true
+	t.Run("Returns route stats broken down by status", func(t *testing.T) {
+		testRoutesCall(routesParamsExp{
+			routes:  []string{"/a", "/b", "/c"},
+			counts:  []uint64{90, 60, 0, 30},
+			options: statusOptions,
+			file:    "routes_by_status_output.golden",
+		}, t)
+	})
+
 	wideOptions := newRoutesOptions()
 	wideOptions.toResource = "deploy/bar"
 	wideOptions.outputFormat = wideOutput
@@ -59,6 +81,13 @@ func testRoutesCall(exp routesParamsExp, t *testing.T) {
 		t.Fatalf("Unexpected error: %v", err)
 	}
 
+	for _, table := range response.GetOk().GetRoutes() {
+		for _, row := range table.Rows {
+			addMockLatencies(row.Stats, req)
+			addMockStatusCounts(row.Stats, req)
+		}
+	}
+
 	output, err := requestRouteStatsFromAPI(mockClient, req, exp.options)
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
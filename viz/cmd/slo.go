@@ -0,0 +1,267 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	pkgcmd "github.com/linkerd/linkerd2/pkg/cmd"
+	"github.com/linkerd/linkerd2/pkg/healthcheck"
+	pb "github.com/linkerd/linkerd2/viz/metrics-api/gen/viz"
+	"github.com/linkerd/linkerd2/viz/pkg/api"
+	"github.com/spf13/cobra"
+	v1 "k8s.io/api/core/v1"
+)
+
+type sloOptions struct {
+	namespace     string
+	outputFormat  string
+	allNamespaces bool
+}
+
+func newSLOOptions() *sloOptions {
+	return &sloOptions{
+		outputFormat:  tableOutput,
+		allNamespaces: false,
+	}
+}
+
+// NewCmdSLO creates a new cobra command `slo` for ServiceLevelObjectives
+// functionality
+func NewCmdSLO() *cobra.Command {
+	options := newSLOOptions()
+
+	cmd := &cobra.Command{
+		Use:   "slo [flags]",
+		Short: "Display the status of ServiceLevelObjectives",
+		Long: `Display the status of ServiceLevelObjectives.
+
+  For each ServiceLevelObjective, this displays the success rate and latency of
+  its target over its window, the remaining error budget of its success rate
+  objective and the rate at which it is currently burned. A burn rate of 1
+  exhausts the error budget exactly at the end of the window.`,
+		Example: `  # Get the objectives of the default namespace.
+  linkerd viz slo
+
+  # Get the objectives of all the namespaces.
+  linkerd viz slo --all-namespaces
+
+  # Get the objectives of the emojivoto namespace in JSON.
+  linkerd viz slo -n emojivoto -o json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if options.outputFormat != tableOutput && options.outputFormat != jsonOutput {
+				return fmt.Errorf("--output supports %s and %s", tableOutput, jsonOutput)
+			}
+
+			if options.namespace == "" {
+				options.namespace = pkgcmd.GetDefaultNamespace(kubeconfigPath, kubeContext)
+			}
+			if options.allNamespaces {
+				options.namespace = v1.NamespaceAll
+			}
+
+			client := api.CheckClientOrExit(healthcheck.Options{
+				ControlPlaneNamespace: controlPlaneNamespace,
+				KubeConfig:            kubeconfigPath,
+				Impersonate:           impersonate,
+				ImpersonateGroup:      impersonateGroup,
+				KubeContext:           kubeContext,
+				APIAddr:               apiAddr,
+			})
+
+			resp, err := requestSLOsFromAPI(client, &pb.ServiceLevelObjectivesRequest{Namespace: options.namespace})
+			if err != nil {
+				return err
+			}
+
+			renderSLOs(resp.GetOk().GetObjectives(), options, stdout)
+			return nil
+		},
+	}
+
+	cmd.PersistentFlags().StringVarP(&options.namespace, "namespace", "n", options.namespace, "Namespace of the objectives")
+	cmd.PersistentFlags().StringVarP(&options.outputFormat, "output", "o", options.outputFormat, "Output format; one of: \"table\" or \"json\"")
+	cmd.PersistentFlags().BoolVarP(&options.allNamespaces, "all-namespaces", "A", options.allNamespaces, "If present, returns the objectives of all the namespaces, ignoring the \"--namespace\" flag")
+
+	pkgcmd.ConfigureNamespaceFlagCompletion(
+		cmd, []string{"namespace"},
+		kubeconfigPath, impersonate, impersonateGroup, kubeContext)
+	return cmd
+}
+
+func requestSLOsFromAPI(client pb.ApiClient, req *pb.ServiceLevelObjectivesRequest) (*pb.ServiceLevelObjectivesResponse, error) {
+	resp, err := client.ServiceLevelObjectives(context.Background(), req)
+	if err != nil {
+		return nil, fmt.Errorf("ServiceLevelObjectives API error: %v", err)
+	}
+	if e := resp.GetError(); e != nil {
+		return nil, fmt.Errorf("ServiceLevelObjectives API response error: %v", e.Error)
+	}
+	return resp, nil
+}
+
+func renderSLOs(objectives []*pb.ServiceLevelObjective, options *sloOptions, w io.Writer) {
+	switch options.outputFormat {
+	case jsonOutput:
+		printSLOsJSON(objectives, w)
+	default:
+		printSLOsTable(objectives, options, w)
+	}
+}
+
+func printSLOsTable(objectives []*pb.ServiceLevelObjective, options *sloOptions, w io.Writer) {
+	if len(objectives) == 0 {
+		fmt.Fprintln(w, "No ServiceLevelObjectives found.")
+		return
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, padding, ' ', 0)
+	headers := []string{}
+	if options.allNamespaces {
+		headers = append(headers, namespaceHeader)
+	}
+	headers = append(headers, nameHeader, "TARGET", "WINDOW", "SUCCESS_OBJ", "SUCCESS", "BUDGET", "BURN_RATE", "LATENCY_OBJ", "LATENCY")
+	fmt.Fprintln(tw, strings.Join(headers, "\t"))
+
+	errs := []string{}
+	for _, objective := range objectives {
+		values := []string{}
+		if options.allNamespaces {
+			values = append(values, objective.GetNamespace())
+		}
+		values = append(values,
+			objective.GetName(),
+			fmt.Sprintf("%s/%s", objective.GetTarget().GetType(), objective.GetTarget().GetName()),
+			objective.GetWindow(),
+		)
+		values = append(values, sloSuccessRateValues(objective.GetSuccessRate())...)
+		values = append(values, sloLatencyValues(objective.GetLatency())...)
+		fmt.Fprintln(tw, strings.Join(values, "\t"))
+
+		if objective.GetError() != "" {
+			errs = append(errs, fmt.Sprintf("%s/%s: %s", objective.GetNamespace(), objective.GetName(), objective.GetError()))
+		}
+	}
+	tw.Flush()
+
+	if len(errs) > 0 {
+		fmt.Fprintln(w, "\nSome objectives couldn't be evaluated:")
+		for _, e := range errs {
+			fmt.Fprintf(w, "* %s\n", e)
+		}
+	}
+}
+
+func sloSuccessRateValues(sr *pb.ServiceLevelObjective_SuccessRateObjective) []string {
+	if sr == nil {
+		return []string{"-", "-", "-", "-"}
+	}
+	values := []string{fmt.Sprintf("%s%%", formatPercent(sr.GetObjective()))}
+	if sr.GetSuccessCount()+sr.GetFailureCount() == 0 {
+		values = append(values, "-")
+	} else {
+		values = append(values, fmt.Sprintf("%.2f%%", sr.GetSuccessRate()*100))
+	}
+	return append(values,
+		fmt.Sprintf("%.2f%%", sr.GetErrorBudgetRemaining()*100),
+		fmt.Sprintf("%.2fx (%s)", sr.GetBurnRate(), sr.GetBurnRateWindow()),
+	)
+}
+
+func sloLatencyValues(l *pb.ServiceLevelObjective_LatencyObjective) []string {
+	if l == nil {
+		return []string{"-", "-"}
+	}
+	objective := fmt.Sprintf("p%s<=%dms", strconv.FormatFloat(l.GetPercentile(), 'f', -1, 64), l.GetThresholdMs())
+	if l.GetNoData() {
+		return []string{objective, "- (no data)"}
+	}
+	status := "met"
+	if !l.GetMet() {
+		status = "missed"
+	}
+	return []string{objective, fmt.Sprintf("%dms (%s)", l.GetLatencyMs(), status)}
+}
+
+// formatPercent formats a ratio as a percentage without trailing zeros
+func formatPercent(ratio float64) string {
+	return strconv.FormatFloat(ratio*100, 'f', -1, 64)
+}
+
+type jsonSLO struct {
+	Namespace   string              `json:"namespace"`
+	Name        string              `json:"name"`
+	Target      string              `json:"target"`
+	Window      string              `json:"window"`
+	SuccessRate *jsonSuccessRateSLO `json:"success_rate,omitempty"`
+	Latency     *jsonLatencySLO     `json:"latency,omitempty"`
+	Error       string              `json:"error,omitempty"`
+}
+
+type jsonSuccessRateSLO struct {
+	Objective            float64  `json:"objective"`
+	Success              *float64 `json:"success"`
+	ErrorBudgetRemaining float64  `json:"error_budget_remaining"`
+	BurnRate             float64  `json:"burn_rate"`
+	BurnRateWindow       string   `json:"burn_rate_window"`
+}
+
+type jsonLatencySLO struct {
+	Percentile  float64 `json:"percentile"`
+	ThresholdMs uint64  `json:"threshold_ms"`
+	// the latency and whether the objective is met are null when there were
+	// no requests over the window
+	LatencyMs *uint64 `json:"latency_ms"`
+	Met       *bool   `json:"met"`
+}
+
+func printSLOsJSON(objectives []*pb.ServiceLevelObjective, w io.Writer) {
+	// avoid nil initialization so that if there are no objectives it gets
+	// marshalled as an empty array vs null
+	entries := []*jsonSLO{}
+	for _, objective := range objectives {
+		entry := &jsonSLO{
+			Namespace: objective.GetNamespace(),
+			Name:      objective.GetName(),
+			Target:    fmt.Sprintf("%s/%s", objective.GetTarget().GetType(), objective.GetTarget().GetName()),
+			Window:    objective.GetWindow(),
+			Error:     objective.GetError(),
+		}
+		if sr := objective.GetSuccessRate(); sr != nil {
+			entry.SuccessRate = &jsonSuccessRateSLO{
+				Objective:            sr.GetObjective(),
+				ErrorBudgetRemaining: sr.GetErrorBudgetRemaining(),
+				BurnRate:             sr.GetBurnRate(),
+				BurnRateWindow:       sr.GetBurnRateWindow(),
+			}
+			if sr.GetSuccessCount()+sr.GetFailureCount() > 0 {
+				success := sr.GetSuccessRate()
+				entry.SuccessRate.Success = &success
+			}
+		}
+		if l := objective.GetLatency(); l != nil {
+			entry.Latency = &jsonLatencySLO{
+				Percentile:  l.GetPercentile(),
+				ThresholdMs: l.GetThresholdMs(),
+			}
+			if !l.GetNoData() {
+				latencyMs, met := l.GetLatencyMs(), l.GetMet()
+				entry.Latency.LatencyMs = &latencyMs
+				entry.Latency.Met = &met
+			}
+		}
+		entries = append(entries, entry)
+	}
+
+	b, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		fmt.Fprintf(stderr, "Error marshalling JSON: %s\n", err)
+		return
+	}
+	fmt.Fprintf(w, "%s\n", b)
+}
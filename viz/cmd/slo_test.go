@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	api "github.com/linkerd/linkerd2/viz/metrics-api"
+	pb "github.com/linkerd/linkerd2/viz/metrics-api/gen/viz"
+)
+
+func genSLOsResponse() *pb.ServiceLevelObjectivesResponse {
+	return &pb.ServiceLevelObjectivesResponse{
+		Response: &pb.ServiceLevelObjectivesResponse_Ok_{
+			Ok: &pb.ServiceLevelObjectivesResponse_Ok{
+				Objectives: []*pb.ServiceLevelObjective{
+					{
+						Name:      "emoji-availability",
+						Namespace: "emojivoto",
+						Target:    &pb.Resource{Namespace: "emojivoto", Type: "deployment", Name: "emoji"},
+						Window:    "7d",
+						SuccessRate: &pb.ServiceLevelObjective_SuccessRateObjective{
+							Objective:            0.999,
+							SuccessCount:         9995,
+							FailureCount:         5,
+							SuccessRate:          0.9995,
+							ErrorBudgetRemaining: 0.5,
+							BurnRate:             2,
+							BurnRateWindow:       "1h",
+						},
+						Latency: &pb.ServiceLevelObjective_LatencyObjective{
+							Percentile:  99,
+							ThresholdMs: 300,
+							LatencyMs:   450,
+							Met:         false,
+						},
+					},
+					{
+						Name:      "voting-latency",
+						Namespace: "emojivoto",
+						Target:    &pb.Resource{Namespace: "emojivoto", Type: "deployment", Name: "voting"},
+						Window:    "30d",
+						Latency: &pb.ServiceLevelObjective_LatencyObjective{
+							Percentile:  99.9,
+							ThresholdMs: 100,
+							LatencyMs:   20,
+							Met:         true,
+						},
+					},
+					{
+						Name:      "web-latency",
+						Namespace: "emojivoto",
+						Target:    &pb.Resource{Namespace: "emojivoto", Type: "deployment", Name: "web"},
+						Window:    "1h",
+						Latency: &pb.ServiceLevelObjective_LatencyObjective{
+							Percentile:  99,
+							ThresholdMs: 200,
+							NoData:      true,
+						},
+					},
+					{
+						Name:      "web-availability",
+						Namespace: "emojivoto",
+						Target:    &pb.Resource{Namespace: "emojivoto", Type: "foo", Name: "web"},
+						Window:    "30d",
+						Error:     "invalid target kind: cannot find Kubernetes canonical name from friendly name [foo]",
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestSLO(t *testing.T) {
+	mockClient := &api.MockAPIClient{SLOsResponseToReturn: genSLOsResponse()}
+
+	testCases := []struct {
+		outputFormat  string
+		allNamespaces bool
+		file          string
+	}{
+		{tableOutput, false, "slo_output.golden"},
+		{tableOutput, true, "slo_all_namespaces_output.golden"},
+		{jsonOutput, false, "slo_output_json.golden"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.file, func(t *testing.T) {
+			options := newSLOOptions()
+			options.outputFormat = tc.outputFormat
+			options.allNamespaces = tc.allNamespaces
+
+			resp, err := requestSLOsFromAPI(mockClient, &pb.ServiceLevelObjectivesRequest{})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			var buf bytes.Buffer
+			renderSLOs(resp.GetOk().GetObjectives(), options, &buf)
+			testDataDiffer.DiffTestdata(t, tc.file, buf.String())
+		})
+	}
+}
@@ -5,8 +5,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"math"
 	"os"
+	"os/signal"
 	"sort"
+	"strconv"
 	"strings"
 	"text/tabwriter"
 	"time"
@@ -33,12 +37,21 @@ type statOptions struct {
 	allNamespaces bool
 	labelSelector string
 	unmeshed      bool
+	watch         bool
+	interval      time.Duration
+	compare       bool
+	comparison    compareOptions
 }
 
 type statOptionsBase struct {
 	namespace    string
 	timeWindow   string
+	since        string
+	until        string
 	outputFormat string
+	percentiles  []float64
+	histogram    bool
+	byStatus     bool
 }
 
 func newStatOptionsBase() *statOptionsBase {
@@ -50,13 +63,95 @@ func newStatOptionsBase() *statOptionsBase {
 
 func (o *statOptionsBase) validateOutputFormat() error {
 	switch o.outputFormat {
-	case tableOutput, jsonOutput, wideOutput:
+	case tableOutput, jsonOutput, wideOutput, jsonlOutput:
 		return nil
 	default:
-		return fmt.Errorf("--output currently only supports %s, %s and %s", tableOutput, jsonOutput, wideOutput)
+		return fmt.Errorf("--output currently only supports %s, %s, %s and %s", tableOutput, jsonOutput, wideOutput, jsonlOutput)
 	}
 }
 
+// timeRange returns the absolute time range requested with --since and
+// --until, or nil if the stats are over the time window ending now
+func (o *statOptionsBase) timeRange() (*pb.TimeRange, error) {
+	return util.BuildTimeRange(o.since, o.until, time.Now())
+}
+
+func (o *statOptionsBase) validateLatencyFlags() error {
+	for _, percentile := range o.percentiles {
+		if math.IsNaN(percentile) || percentile <= 0 || percentile >= 100 {
+			return fmt.Errorf("--percentiles need to be between 0 and 100 exclusive, got %v", percentile)
+		}
+	}
+	if o.histogram && o.outputFormat != jsonOutput && o.outputFormat != jsonlOutput {
+		return fmt.Errorf("--histogram is only available with the %s and %s output formats", jsonOutput, jsonlOutput)
+	}
+	return nil
+}
+
+func (o *statOptionsBase) validateStatusFlags() error {
+	if o.byStatus && o.outputFormat == jsonlOutput {
+		return fmt.Errorf("--by-status is not available with the %s output format", jsonlOutput)
+	}
+	return nil
+}
+
+// latencyQuantiles returns the quantiles of the percentiles requested with
+// --percentiles
+func (o *statOptionsBase) latencyQuantiles() []float64 {
+	quantiles := make([]float64, 0, len(o.percentiles))
+	for _, percentile := range o.percentiles {
+		quantiles = append(quantiles, percentileQuantile(percentile))
+	}
+	return quantiles
+}
+
+// percentileQuantile returns the quantile of a percentile, rounded to the
+// shortest decimal representation so that e.g. 99.9 gives 0.999 rather than
+// 0.9990000000000001
+func percentileQuantile(percentile float64) float64 {
+	quantile, err := strconv.ParseFloat(strconv.FormatFloat(percentile/100, 'g', 12, 64), 64)
+	if err != nil {
+		return percentile / 100
+	}
+	return quantile
+}
+
+// latencyHeaders returns the headers of the latency columns, which are the
+// p50, p95 and p99 unless other percentiles are requested
+func (o *statOptionsBase) latencyHeaders() []string {
+	if len(o.percentiles) == 0 {
+		return []string{"LATENCY_P50", "LATENCY_P95", "LATENCY_P99"}
+	}
+	headers := make([]string, 0, len(o.percentiles))
+	for _, percentile := range o.percentiles {
+		headers = append(headers, "LATENCY_P"+formatPercentile(percentile))
+	}
+	return headers
+}
+
+// percentileLatencies returns the latencies of the percentiles requested with
+// --percentiles, or nil if there are none
+func (o *statOptionsBase) percentileLatencies(stats *pb.BasicStats) []percentileLatency {
+	if len(o.percentiles) == 0 {
+		return nil
+	}
+	latencies := make([]percentileLatency, 0, len(o.percentiles))
+	for _, percentile := range o.percentiles {
+		latency := percentileLatency{percentile: percentile}
+		for _, q := range stats.GetLatencyQuantiles() {
+			if q.GetQuantile() == percentileQuantile(percentile) {
+				latency.latencyMs = q.GetLatencyMs()
+			}
+		}
+		latencies = append(latencies, latency)
+	}
+	return latencies
+}
+
+func formatPercentile(percentile float64) string {
+	return strconv.FormatFloat(percentile, 'f', -1, 64)
+}
+
 type indexedResults struct {
 	ix   int
 	rows []*pb.StatTable_PodGroup_Row
@@ -73,6 +168,10 @@ func newStatOptions() *statOptions {
 		allNamespaces:   false,
 		labelSelector:   "",
 		unmeshed:        false,
+		watch:           false,
+		interval:        10 * time.Second,
+		compare:         false,
+		comparison:      newCompareOptions(),
 	}
 }
 
@@ -162,11 +261,30 @@ If no resource name is specified, displays stats about all resources of the spec
   # Get all trafficsplits and their leaf services, and metrics for any traffic coming to the leaf services from the hello1 deployment.
   linkerd viz stat ts --from deploy/hello1
 
+  # Get the p50, p99 and p99.9 latencies of all deployments in the test namespace.
+  linkerd viz stat deploy -n test --percentiles 50,99,99.9
+
+  # Get the histogram of the latencies of the web deployment in the test namespace.
+  linkerd viz stat deploy/web -n test --histogram -o json
+
+  # Break the responses of the web deployment in the test namespace down by status.
+  linkerd viz stat deploy/web -n test --by-status
+
   # Get all namespaces that receive traffic from the default namespace.
   linkerd viz stat namespaces --from ns/default
 
   # Get all inbound stats to the test namespace.
-  linkerd viz stat ns/test`,
+  linkerd viz stat ns/test
+
+  # Watch the stats of the deployments in the test namespace, refreshed every 5 seconds.
+  linkerd viz stat deploy -n test --watch --interval 5s
+
+  # Stream the stats of the deployments in the test namespace as JSON lines.
+  linkerd viz stat deploy -n test --watch -o jsonl
+
+  # Compare the leaves of the hello-split trafficsplit with its primary leaf,
+  # exiting with a non-zero status if one is worse beyond the thresholds.
+  linkerd viz stat ts/hello-split --compare --primary hello-v1 --max-success-rate-drop 0.5`,
 		Args: cobra.MinimumNArgs(1),
 		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 
@@ -213,6 +331,12 @@ If no resource name is specified, displays stats about all resources of the spec
 				APIAddr:               apiAddr,
 			})
 
+			if options.watch {
+				ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+				defer cancel()
+				return watchStats(ctx, client, reqs, options, os.Stdout)
+			}
+
 			c := make(chan indexedResults, len(reqs))
 			for num, req := range reqs {
 				go func(num int, req *pb.StatSummaryRequest) {
@@ -235,6 +359,26 @@ If no resource name is specified, displays stats about all resources of the spec
 				}
 			}
 
+			if options.compare {
+				comparisons, err := compareTrafficSplitLeaves(totalRows, options)
+				if err != nil {
+					return err
+				}
+				if options.outputFormat == tableOutput {
+					fmt.Println(renderStatStats(totalRows, options))
+				}
+				renderComparisons(comparisons, options, os.Stdout)
+				if comparisonsFailed(comparisons) {
+					fmt.Fprintln(os.Stderr, "At least one leaf is worse than its primary beyond the thresholds")
+					os.Exit(compareFailedExitCode)
+				}
+				if comparisonsInconclusive(comparisons) {
+					fmt.Fprintln(os.Stderr, "No leaf served enough requests for the comparisons to be conclusive")
+					os.Exit(compareInconclusiveExitCode)
+				}
+				return nil
+			}
+
 			output := renderStatStats(totalRows, options)
 			_, err = fmt.Print(output)
 
@@ -244,14 +388,27 @@ If no resource name is specified, displays stats about all resources of the spec
 
 	cmd.PersistentFlags().StringVarP(&options.namespace, "namespace", "n", options.namespace, "Namespace of the specified resource")
 	cmd.PersistentFlags().StringVarP(&options.timeWindow, "time-window", "t", options.timeWindow, "Stat window (for example: \"15s\", \"1m\", \"10m\", \"1h\"). Needs to be at least 15s.")
+	cmd.PersistentFlags().StringVar(&options.since, "since", options.since, "Start of the stats, as a duration before now (for example: \"2h\") or an RFC3339 timestamp; overrides \"--time-window\"")
+	cmd.PersistentFlags().StringVar(&options.until, "until", options.until, "End of the stats started with \"--since\", as a duration before now or an RFC3339 timestamp; defaults to now")
 	cmd.PersistentFlags().StringVar(&options.toResource, "to", options.toResource, "If present, restricts outbound stats to the specified resource name")
 	cmd.PersistentFlags().StringVar(&options.toNamespace, "to-namespace", options.toNamespace, "Sets the namespace used to lookup the \"--to\" resource; by default the current \"--namespace\" is used")
 	cmd.PersistentFlags().StringVar(&options.fromResource, "from", options.fromResource, "If present, restricts outbound stats from the specified resource name")
 	cmd.PersistentFlags().StringVar(&options.fromNamespace, "from-namespace", options.fromNamespace, "Sets the namespace used from lookup the \"--from\" resource; by default the current \"--namespace\" is used")
 	cmd.PersistentFlags().BoolVarP(&options.allNamespaces, "all-namespaces", "A", options.allNamespaces, "If present, returns stats across all namespaces, ignoring the \"--namespace\" flag")
-	cmd.PersistentFlags().StringVarP(&options.outputFormat, "output", "o", options.outputFormat, "Output format; one of: \"table\" or \"json\" or \"wide\"")
+	cmd.PersistentFlags().StringVarP(&options.outputFormat, "output", "o", options.outputFormat, "Output format; one of: \"table\" or \"json\" or \"wide\" or \"jsonl\"")
 	cmd.PersistentFlags().StringVarP(&options.labelSelector, "selector", "l", options.labelSelector, "Selector (label query) to filter on, supports '=', '==', and '!='")
 	cmd.PersistentFlags().BoolVar(&options.unmeshed, "unmeshed", options.unmeshed, "If present, include unmeshed resources in the output")
+	cmd.PersistentFlags().BoolVarP(&options.watch, "watch", "w", options.watch, "If present, polls the stats every \"--interval\" and displays their history")
+	cmd.PersistentFlags().DurationVar(&options.interval, "interval", options.interval, "Polling interval of \"--watch\"")
+	cmd.PersistentFlags().Float64SliceVar(&options.percentiles, "percentiles", options.percentiles, "Latency percentiles to display instead of the p50, p95 and p99 (for example: \"50,99,99.9\")")
+	cmd.PersistentFlags().BoolVar(&options.histogram, "histogram", options.histogram, "If present, includes the histogram of the latencies in the JSON output")
+	cmd.PersistentFlags().BoolVar(&options.byStatus, "by-status", options.byStatus, "If present, breaks the responses down by HTTP status and gRPC status")
+	cmd.PersistentFlags().BoolVar(&options.compare, "compare", options.compare, fmt.Sprintf("If present, compares the success rate and latency of each trafficsplit leaf with the primary leaf, and exits with status %d if one is worse beyond the thresholds, or with status %d if none of the comparisons is conclusive", compareFailedExitCode, compareInconclusiveExitCode))
+	cmd.PersistentFlags().StringVar(&options.comparison.primary, "primary", options.comparison.primary, "Leaf the other leaves are compared against with \"--compare\"; defaults to the leaf with the highest weight")
+	cmd.PersistentFlags().Float64Var(&options.comparison.maxSuccessRateDrop, "max-success-rate-drop", options.comparison.maxSuccessRateDrop, "Maximum drop of the success rate of a leaf compared to the primary with \"--compare\", in percentage points")
+	cmd.PersistentFlags().Float64Var(&options.comparison.maxLatencyIncrease, "max-latency-increase", options.comparison.maxLatencyIncrease, "Maximum increase of the latency of a leaf compared to the primary with \"--compare\", in percent of the latency of the primary")
+	cmd.PersistentFlags().Float64Var(&options.comparison.confidence, "confidence", options.comparison.confidence, "Confidence level, in percent, above which a success rate drop is considered significant with \"--compare\"")
+	cmd.PersistentFlags().Uint64Var(&options.comparison.minRequests, "min-requests", options.comparison.minRequests, "Number of requests a leaf and the primary need to have served for \"--compare\" to be conclusive")
 
 	pkgcmd.ConfigureNamespaceFlagCompletion(
 		cmd, []string{"namespace", "to-namespace", "from-namespace"},
@@ -300,11 +457,32 @@ type rowStats struct {
 	latencyP50         uint64
 	latencyP95         uint64
 	latencyP99         uint64
+	percentiles        []percentileLatency
+	latencyHistogram   []*pb.LatencyBucket
+	statusCounts       []*pb.StatusCount
 	tcpOpenConnections uint64
 	tcpReadBytes       float64
 	tcpWriteBytes      float64
 }
 
+type percentileLatency struct {
+	percentile float64
+	latencyMs  uint64
+}
+
+// latencies returns the values of the latency columns, which are the p50, p95
+// and p99 unless other percentiles were requested
+func (r *rowStats) latencies() []interface{} {
+	if r.percentiles == nil {
+		return []interface{}{r.latencyP50, r.latencyP95, r.latencyP99}
+	}
+	latencies := make([]interface{}, 0, len(r.percentiles))
+	for _, p := range r.percentiles {
+		latencies = append(latencies, p.latencyMs)
+	}
+	return latencies
+}
+
 type row struct {
 	meshed string
 	status string
@@ -334,7 +512,40 @@ func isPodOwnerResource(typ string) bool {
 	return typ != k8s.TrafficSplit && typ != k8s.Authority
 }
 
+// statTableWidths are the widths of the variable-length columns of the stat
+// tables
+type statTableWidths struct {
+	name      int
+	namespace int
+	apex      int
+	leaf      int
+	weight    int
+}
+
 func writeStatsToBuffer(rows []*pb.StatTable_PodGroup_Row, w *tabwriter.Writer, options *statOptions) {
+	statTables, widths := buildStatTables(rows, options)
+
+	switch options.outputFormat {
+	case tableOutput, wideOutput:
+		if len(statTables) == 0 {
+			fmt.Fprintln(os.Stderr, "No traffic found.")
+			return
+		}
+		if options.byStatus {
+			printStatStatusBreakdown(statTables, w, options)
+			return
+		}
+		printStatTables(statTables, w, widths.name, widths.namespace, widths.leaf, widths.apex, widths.weight, options)
+	case jsonOutput:
+		printStatJSON(statTables, w)
+	case jsonlOutput:
+		printStatJSONL(statTables, time.Now(), w)
+	}
+}
+
+// buildStatTables indexes the rows to display by resource type and key,
+// skipping the unmeshed resources unless requested
+func buildStatTables(rows []*pb.StatTable_PodGroup_Row, options *statOptions) (map[string]map[string]*row, statTableWidths) {
 	maxNameLength := len(nameHeader)
 	maxNamespaceLength := len(namespaceHeader)
 	maxApexLength := len(apexHeader)
@@ -406,6 +617,9 @@ func writeStatsToBuffer(rows []*pb.StatTable_PodGroup_Row, w *tabwriter.Writer,
 				latencyP50:         r.Stats.LatencyMsP50,
 				latencyP95:         r.Stats.LatencyMsP95,
 				latencyP99:         r.Stats.LatencyMsP99,
+				percentiles:        options.percentileLatencies(r.Stats),
+				latencyHistogram:   r.Stats.LatencyHistogram,
+				statusCounts:       r.Stats.StatusCounts,
 				tcpOpenConnections: r.GetTcpStats().GetOpenConnections(),
 				tcpReadBytes:       getByteRate(r.GetTcpStats().GetReadBytesTotal(), r.TimeWindow),
 				tcpWriteBytes:      getByteRate(r.GetTcpStats().GetWriteBytesTotal(), r.TimeWindow),
@@ -436,15 +650,12 @@ func writeStatsToBuffer(rows []*pb.StatTable_PodGroup_Row, w *tabwriter.Writer,
 		}
 	}
 
-	switch options.outputFormat {
-	case tableOutput, wideOutput:
-		if len(statTables) == 0 {
-			fmt.Fprintln(os.Stderr, "No traffic found.")
-			return
-		}
-		printStatTables(statTables, w, maxNameLength, maxNamespaceLength, maxLeafLength, maxApexLength, maxWeightLength, options)
-	case jsonOutput:
-		printStatJSON(statTables, w)
+	return statTables, statTableWidths{
+		name:      maxNameLength,
+		namespace: maxNamespaceLength,
+		apex:      maxApexLength,
+		leaf:      maxLeafLength,
+		weight:    maxWeightLength,
 	}
 }
 
@@ -511,10 +722,9 @@ func printSingleStatTable(stats map[string]*row, resourceTypeLabel, resourceType
 	headers = append(headers, []string{
 		"SUCCESS",
 		"RPS",
-		"LATENCY_P50",
-		"LATENCY_P95",
-		"LATENCY_P99",
 	}...)
+	latencyHeaders := options.latencyHeaders()
+	headers = append(headers, latencyHeaders...)
 
 	if resourceType != k8s.TrafficSplit {
 		headers = append(headers, "TCP_CONN")
@@ -535,16 +745,18 @@ func printSingleStatTable(stats map[string]*row, resourceTypeLabel, resourceType
 	for _, key := range sortedKeys {
 		namespace, name := namespaceName(resourceTypeLabel, key)
 		values := make([]interface{}, 0)
-		templateString := "%s\t%s\t%.2f%%\t%.1frps\t%dms\t%dms\t%dms\t"
-		templateStringEmpty := "%s\t%s\t-\t-\t-\t-\t-\t-\t"
+		latencyTemplate := strings.Repeat("%dms\t", len(latencyHeaders))
+		latencyTemplateEmpty := strings.Repeat("-\t", len(latencyHeaders))
+		templateString := "%s\t%s\t%.2f%%\t%.1frps\t" + latencyTemplate
+		templateStringEmpty := "%s\t%s\t-\t-\t" + latencyTemplateEmpty + "-\t"
 		if resourceType == k8s.Pod {
 			templateString = "%s\t" + templateString
 			templateStringEmpty = "%s\t" + templateStringEmpty
 		}
 
 		if resourceType == k8s.TrafficSplit {
-			templateString = "%s\t%s\t%s\t%s\t%.2f%%\t%.1frps\t%dms\t%dms\t%dms\t"
-			templateStringEmpty = "%s\t%s\t%s\t%s\t-\t-\t-\t-\t-\t"
+			templateString = "%s\t%s\t%s\t%s\t%.2f%%\t%.1frps\t" + latencyTemplate
+			templateStringEmpty = "%s\t%s\t%s\t%s\t-\t-\t" + latencyTemplateEmpty
 		}
 
 		if !showTCPConns(resourceType) {
@@ -609,10 +821,8 @@ func printSingleStatTable(stats map[string]*row, resourceTypeLabel, resourceType
 			values = append(values, []interface{}{
 				stats[key].successRate * 100,
 				stats[key].requestRate,
-				stats[key].latencyP50,
-				stats[key].latencyP95,
-				stats[key].latencyP99,
 			}...)
+			values = append(values, stats[key].latencies()...)
 
 			if showTCPConns(resourceType) {
 				values = append(values, stats[key].tcpOpenConnections)
@@ -632,6 +842,44 @@ func printSingleStatTable(stats map[string]*row, resourceTypeLabel, resourceType
 	}
 }
 
+// printStatStatusBreakdown writes the breakdown of the responses of the
+// resources by status, in the order of the stat tables
+func printStatStatusBreakdown(statTables map[string]map[string]*row, w io.Writer, options *statOptions) {
+	labelHeaders := []string{}
+	if options.allNamespaces {
+		labelHeaders = append(labelHeaders, namespaceHeader)
+	}
+	labelHeaders = append(labelHeaders, nameHeader)
+
+	rows := []statusBreakdownRow{}
+	for _, resourceType := range k8s.AllResources {
+		stats, ok := statTables[resourceType]
+		if !ok {
+			continue
+		}
+		resourceTypeLabel := ""
+		if len(statTables) > 1 {
+			resourceTypeLabel = resourceType
+		}
+		for _, key := range sortStatsKeys(stats) {
+			namespace, name := namespaceName(resourceTypeLabel, key)
+			if stats[key].tsStats != nil {
+				name = fmt.Sprintf("%s (%s)", name, stats[key].leaf)
+			}
+			labels := []string{}
+			if options.allNamespaces {
+				labels = append(labels, namespace)
+			}
+			row := statusBreakdownRow{labels: append(labels, name)}
+			if stats[key].rowStats != nil {
+				row.counts = stats[key].statusCounts
+			}
+			rows = append(rows, row)
+		}
+	}
+	printStatusBreakdown(labelHeaders, rows, w)
+}
+
 func namespaceName(resourceType string, key string) (string, string) {
 	parts := strings.Split(key, "/")
 	namespace := parts[0]
@@ -642,24 +890,63 @@ func namespaceName(resourceType string, key string) (string, string) {
 
 // Using pointers where the value is NA and the corresponding json is null
 type jsonStats struct {
-	Namespace      string   `json:"namespace"`
-	Kind           string   `json:"kind"`
-	Name           string   `json:"name"`
-	Meshed         string   `json:"meshed,omitempty"`
-	Success        *float64 `json:"success"`
-	Rps            *float64 `json:"rps"`
-	LatencyMSp50   *uint64  `json:"latency_ms_p50"`
-	LatencyMSp95   *uint64  `json:"latency_ms_p95"`
-	LatencyMSp99   *uint64  `json:"latency_ms_p99"`
-	TCPConnections *uint64  `json:"tcp_open_connections,omitempty"`
-	TCPReadBytes   *float64 `json:"tcp_read_bytes_rate,omitempty"`
-	TCPWriteBytes  *float64 `json:"tcp_write_bytes_rate,omitempty"`
-	Apex           string   `json:"apex,omitempty"`
-	Leaf           string   `json:"leaf,omitempty"`
-	Weight         string   `json:"weight,omitempty"`
+	Namespace    string   `json:"namespace"`
+	Kind         string   `json:"kind"`
+	Name         string   `json:"name"`
+	Meshed       string   `json:"meshed,omitempty"`
+	Success      *float64 `json:"success"`
+	Rps          *float64 `json:"rps"`
+	LatencyMSp50 *uint64  `json:"latency_ms_p50"`
+	LatencyMSp95 *uint64  `json:"latency_ms_p95"`
+	LatencyMSp99 *uint64  `json:"latency_ms_p99"`
+	// LatencyMS are the latencies of the percentiles requested with
+	// --percentiles, indexed by percentile
+	LatencyMS        map[string]uint64    `json:"latency_ms,omitempty"`
+	LatencyHistogram []*jsonLatencyBucket `json:"latency_histogram,omitempty"`
+	StatusCounts     []*jsonStatusCount   `json:"status_counts,omitempty"`
+	TCPConnections   *uint64              `json:"tcp_open_connections,omitempty"`
+	TCPReadBytes     *float64             `json:"tcp_read_bytes_rate,omitempty"`
+	TCPWriteBytes    *float64             `json:"tcp_write_bytes_rate,omitempty"`
+	Apex             string               `json:"apex,omitempty"`
+	Leaf             string               `json:"leaf,omitempty"`
+	Weight           string               `json:"weight,omitempty"`
 }
 
 func printStatJSON(statTables map[string]map[string]*row, w *tabwriter.Writer) {
+	b, err := json.MarshalIndent(statJSONEntries(statTables), "", "  ")
+	if err != nil {
+		log.Error(err.Error())
+		return
+	}
+	fmt.Fprintf(w, "%s\n", b)
+}
+
+// jsonlStats is a line of the jsonl output, which is timestamped so that the
+// lines of successive polls can be told apart
+type jsonlStats struct {
+	Timestamp string `json:"timestamp"`
+	*jsonStats
+}
+
+// printStatJSONL prints each entry on its own line, for streaming the stats
+// to other tools
+func printStatJSONL(statTables map[string]map[string]*row, ts time.Time, w io.Writer) {
+	for _, entry := range statJSONEntries(statTables) {
+		b, err := json.Marshal(jsonlStats{
+			Timestamp: ts.UTC().Format(time.RFC3339),
+			jsonStats: entry,
+		})
+		if err != nil {
+			log.Error(err.Error())
+			return
+		}
+		fmt.Fprintf(w, "%s\n", b)
+	}
+}
+
+// statJSONEntries returns the entries of the stat tables, sorted by resource
+// type and key
+func statJSONEntries(statTables map[string]map[string]*row) []*jsonStats {
 	// avoid nil initialization so that if there are not stats it gets marshalled as an empty array vs null
 	entries := []*jsonStats{}
 	for _, resourceType := range k8s.AllResources {
@@ -681,6 +968,9 @@ func printStatJSON(statTables map[string]map[string]*row, w *tabwriter.Writer) {
 					entry.LatencyMSp50 = &stats[key].latencyP50
 					entry.LatencyMSp95 = &stats[key].latencyP95
 					entry.LatencyMSp99 = &stats[key].latencyP99
+					entry.LatencyMS = jsonPercentileLatencies(stats[key].percentiles)
+					entry.LatencyHistogram = jsonLatencyHistogram(stats[key].latencyHistogram)
+					entry.StatusCounts = jsonStatusCounts(stats[key].statusCounts)
 
 					if showTCPConns(resourceType) {
 						entry.TCPConnections = &stats[key].tcpOpenConnections
@@ -698,12 +988,39 @@ func printStatJSON(statTables map[string]map[string]*row, w *tabwriter.Writer) {
 			}
 		}
 	}
-	b, err := json.MarshalIndent(entries, "", "  ")
-	if err != nil {
-		log.Error(err.Error())
-		return
+	return entries
+}
+
+// jsonLatencyBucket is a bucket of a latency histogram, with its upper bound
+// as a string since JSON doesn't support infinite numbers
+type jsonLatencyBucket struct {
+	Le    string `json:"le"`
+	Count uint64 `json:"count"`
+}
+
+func jsonPercentileLatencies(percentiles []percentileLatency) map[string]uint64 {
+	if len(percentiles) == 0 {
+		return nil
 	}
-	fmt.Fprintf(w, "%s\n", b)
+	latencies := make(map[string]uint64, len(percentiles))
+	for _, p := range percentiles {
+		latencies["p"+formatPercentile(p.percentile)] = p.latencyMs
+	}
+	return latencies
+}
+
+func jsonLatencyHistogram(buckets []*pb.LatencyBucket) []*jsonLatencyBucket {
+	if len(buckets) == 0 {
+		return nil
+	}
+	histogram := make([]*jsonLatencyBucket, 0, len(buckets))
+	for _, bucket := range buckets {
+		histogram = append(histogram, &jsonLatencyBucket{
+			Le:    strconv.FormatFloat(bucket.GetLe(), 'f', -1, 64),
+			Count: bucket.GetCount(),
+		})
+	}
+	return histogram
 }
 
 func getNamePrefix(resourceType string) string {
@@ -735,6 +1052,19 @@ func buildStatSummaryRequests(resources []string, options *statOptions) ([]*pb.S
 		}
 	}
 
+	timeRange, err := options.timeRange()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := options.validateLatencyFlags(); err != nil {
+		return nil, err
+	}
+
+	if err := options.validateStatusFlags(); err != nil {
+		return nil, err
+	}
+
 	requests := make([]*pb.StatSummaryRequest, 0)
 	for _, target := range targets {
 		err = options.validate(target.Type)
@@ -744,11 +1074,15 @@ func buildStatSummaryRequests(resources []string, options *statOptions) ([]*pb.S
 
 		requestParams := util.StatsSummaryRequestParams{
 			StatsBaseRequestParams: util.StatsBaseRequestParams{
-				TimeWindow:    options.timeWindow,
-				ResourceName:  target.Name,
-				ResourceType:  target.Type,
-				Namespace:     options.namespace,
-				AllNamespaces: options.allNamespaces,
+				TimeWindow:       options.timeWindow,
+				TimeRange:        timeRange,
+				ResourceName:     target.Name,
+				ResourceType:     target.Type,
+				Namespace:        options.namespace,
+				AllNamespaces:    options.allNamespaces,
+				LatencyQuantiles: options.latencyQuantiles(),
+				LatencyHistogram: options.histogram,
+				StatusBreakdown:  options.byStatus,
 			},
 			ToNamespace:   options.toNamespace,
 			FromNamespace: options.fromNamespace,
@@ -790,6 +1124,10 @@ func (o *statOptions) validate(resourceType string) error {
 		return err
 	}
 
+	if o.compare && resourceType != k8s.TrafficSplit {
+		return fmt.Errorf("--compare only supports %s", k8s.TrafficSplit)
+	}
+
 	if resourceType == k8s.Namespace {
 		err := o.validateNamespaceFlags()
 		if err != nil {
@@ -815,6 +1153,34 @@ func (o *statOptions) validateConflictingFlags() error {
 		return fmt.Errorf("--all-namespaces and --namespace flags are mutually exclusive")
 	}
 
+	if o.watch {
+		if o.since != "" {
+			return fmt.Errorf("--watch and --since flags are mutually exclusive")
+		}
+		if o.outputFormat != tableOutput && o.outputFormat != jsonlOutput {
+			return fmt.Errorf("--watch only supports the %s and %s output formats", tableOutput, jsonlOutput)
+		}
+		if o.interval < time.Second {
+			return fmt.Errorf("--interval needs to be at least 1s")
+		}
+	}
+
+	if o.byStatus && o.watch {
+		return fmt.Errorf("--watch and --by-status flags are mutually exclusive")
+	}
+
+	if o.compare {
+		if o.watch {
+			return fmt.Errorf("--watch and --compare flags are mutually exclusive")
+		}
+		if o.outputFormat != tableOutput && o.outputFormat != jsonOutput {
+			return fmt.Errorf("--compare only supports the %s and %s output formats", tableOutput, jsonOutput)
+		}
+		if err := o.comparison.validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -851,7 +1217,7 @@ func getByteRate(bytes uint64, timeWindow string) float64 {
 func renderStats(buffer bytes.Buffer, options *statOptionsBase) string {
 	var out string
 	switch options.outputFormat {
-	case jsonOutput:
+	case jsonOutput, jsonlOutput:
 		out = buffer.String()
 	default:
 		// strip left padding on the first column
@@ -0,0 +1,395 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	pb "github.com/linkerd/linkerd2/viz/metrics-api/gen/viz"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// compareOptions are the thresholds beyond which a TrafficSplit leaf is
+// considered worse than the primary in --compare mode
+type compareOptions struct {
+	// primary is the leaf the other leaves are compared against; by default
+	// it's the leaf with the highest weight
+	primary string
+	// maxSuccessRateDrop is in percentage points
+	maxSuccessRateDrop float64
+	// maxLatencyIncrease is in percent of the latency of the primary
+	maxLatencyIncrease float64
+	// confidence is the confidence level, in percent, above which a success
+	// rate drop is significant
+	confidence float64
+	// minRequests is the number of requests both leaves need to have served
+	// for the comparison to be conclusive
+	minRequests uint64
+}
+
+func newCompareOptions() compareOptions {
+	return compareOptions{
+		maxSuccessRateDrop: 1,
+		maxLatencyIncrease: 20,
+		confidence:         95,
+		minRequests:        100,
+	}
+}
+
+func (o *compareOptions) validate() error {
+	if o.maxSuccessRateDrop < 0 || o.maxSuccessRateDrop > 100 {
+		return fmt.Errorf("--max-success-rate-drop needs to be between 0 and 100, got %v", o.maxSuccessRateDrop)
+	}
+	if o.maxLatencyIncrease < 0 {
+		return fmt.Errorf("--max-latency-increase can't be negative, got %v", o.maxLatencyIncrease)
+	}
+	if o.confidence <= 0 || o.confidence >= 100 {
+		return fmt.Errorf("--confidence needs to be between 0 and 100 exclusive, got %v", o.confidence)
+	}
+	return nil
+}
+
+const (
+	// compareFailedExitCode is the exit code of `stat --compare` when a leaf
+	// is worse than its primary beyond the thresholds
+	compareFailedExitCode = 1
+	// compareInconclusiveExitCode is the exit code of `stat --compare` when
+	// none of the comparisons is conclusive
+	compareInconclusiveExitCode = 2
+)
+
+const (
+	verdictPass         = "pass"
+	verdictFail         = "fail"
+	verdictInconclusive = "inconclusive"
+)
+
+// leafStats are the stats of a TrafficSplit leaf used in its comparison
+type leafStats struct {
+	leaf      string
+	weight    string
+	successes uint64
+	failures  uint64
+	latencyMs uint64
+}
+
+func (s *leafStats) requests() uint64 {
+	return s.successes + s.failures
+}
+
+// leafComparison is the comparison of a TrafficSplit leaf with the primary
+type leafComparison struct {
+	namespace string
+	apex      string
+	primary   *leafStats
+	canary    *leafStats
+	// successRateDelta is in percentage points
+	successRateDelta float64
+	// pValue is the probability of observing a success rate drop at least
+	// as large if the canary was as good as the primary
+	pValue float64
+	// latencyDelta is in milliseconds
+	latencyDelta int64
+	// latencyIncrease is in percent of the latency of the primary, or NaN if
+	// the primary had no latency
+	latencyIncrease float64
+	verdict         string
+	reasons         []string
+}
+
+// compareTrafficSplitLeaves compares the success rate and latency of each
+// TrafficSplit leaf with the primary leaf of its split, and returns an error if
+// a split doesn't have the requested primary leaf
+func compareTrafficSplitLeaves(rows []*pb.StatTable_PodGroup_Row, options *statOptions) ([]*leafComparison, error) {
+	splits := make(map[string][]*leafStats)
+	apexes := make(map[string][2]string)
+	for _, r := range rows {
+		if r.GetTsStats() == nil {
+			continue
+		}
+		key := r.GetResource().GetNamespace() + "/" + r.GetResource().GetName()
+		apexes[key] = [2]string{r.GetResource().GetNamespace(), r.GetTsStats().GetApex()}
+		splits[key] = append(splits[key], &leafStats{
+			leaf:      r.GetTsStats().GetLeaf(),
+			weight:    r.GetTsStats().GetWeight(),
+			successes: r.GetStats().GetSuccessCount(),
+			failures:  r.GetStats().GetFailureCount(),
+			latencyMs: options.comparedLatency(r.GetStats()),
+		})
+	}
+
+	keys := make([]string, 0, len(splits))
+	for key := range splits {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	comparisons := []*leafComparison{}
+	for _, key := range keys {
+		leaves := splits[key]
+		sort.Slice(leaves, func(i, j int) bool { return leaves[i].leaf < leaves[j].leaf })
+
+		primary, err := primaryLeaf(leaves, options.comparison.primary)
+		if err != nil {
+			return nil, fmt.Errorf("trafficsplit %s: %s", key, err)
+		}
+		if primary == nil {
+			if options.comparison.primary != "" {
+				return nil, fmt.Errorf("trafficsplit %s has no leaf %q", key, options.comparison.primary)
+			}
+			continue
+		}
+		for _, canary := range leaves {
+			if canary == primary {
+				continue
+			}
+			comparison := compareLeaves(primary, canary, &options.comparison)
+			comparison.namespace = apexes[key][0]
+			comparison.apex = apexes[key][1]
+			comparisons = append(comparisons, comparison)
+		}
+	}
+	return comparisons, nil
+}
+
+// primaryLeaf returns the leaf with the given name, or the leaf with the
+// highest weight if there's none. It returns an error if the weight of a leaf
+// can't be parsed, as the primary can't be determined then.
+func primaryLeaf(leaves []*leafStats, name string) (*leafStats, error) {
+	if name != "" {
+		for _, leaf := range leaves {
+			if leaf.leaf == name {
+				return leaf, nil
+			}
+		}
+		return nil, nil
+	}
+
+	var primary *leafStats
+	var primaryWeight resource.Quantity
+	for _, leaf := range leaves {
+		weight, err := resource.ParseQuantity(leaf.weight)
+		if err != nil {
+			return nil, fmt.Errorf("leaf %q has an invalid weight %q: %s", leaf.leaf, leaf.weight, err)
+		}
+		if primary == nil || weight.Cmp(primaryWeight) > 0 {
+			primary = leaf
+			primaryWeight = weight
+		}
+	}
+	return primary, nil
+}
+
+func compareLeaves(primary, canary *leafStats, options *compareOptions) *leafComparison {
+	comparison := &leafComparison{
+		primary:         primary,
+		canary:          canary,
+		pValue:          math.NaN(),
+		latencyDelta:    int64(canary.latencyMs) - int64(primary.latencyMs),
+		latencyIncrease: math.NaN(),
+	}
+	if primary.latencyMs != 0 {
+		comparison.latencyIncrease = float64(comparison.latencyDelta) / float64(primary.latencyMs) * 100
+	}
+
+	if primary.requests() < options.minRequests || canary.requests() < options.minRequests {
+		comparison.verdict = verdictInconclusive
+		comparison.reasons = []string{fmt.Sprintf("less than %d requests", options.minRequests)}
+		if primary.requests() > 0 && canary.requests() > 0 {
+			comparison.successRateDelta = (successRate(canary) - successRate(primary)) * 100
+			comparison.pValue = successRateDropPValue(primary, canary)
+		}
+		return comparison
+	}
+
+	comparison.successRateDelta = (successRate(canary) - successRate(primary)) * 100
+	comparison.pValue = successRateDropPValue(primary, canary)
+
+	if -comparison.successRateDelta > options.maxSuccessRateDrop && comparison.pValue < 1-options.confidence/100 {
+		comparison.reasons = append(comparison.reasons, "success rate")
+	}
+	if comparison.latencyDelta > 0 && (math.IsNaN(comparison.latencyIncrease) || comparison.latencyIncrease > options.maxLatencyIncrease) {
+		comparison.reasons = append(comparison.reasons, "latency")
+	}
+
+	comparison.verdict = verdictPass
+	if len(comparison.reasons) > 0 {
+		comparison.verdict = verdictFail
+	}
+	return comparison
+}
+
+func successRate(s *leafStats) float64 {
+	return getSuccessRate(s.successes, s.failures)
+}
+
+// successRateDropPValue is the one-sided p-value of the two-proportion z-test
+// of the success rate of the canary being lower than the one of the primary
+func successRateDropPValue(primary, canary *leafStats) float64 {
+	n1, n2 := float64(primary.requests()), float64(canary.requests())
+	pooled := float64(primary.successes+canary.successes) / (n1 + n2)
+	stdErr := math.Sqrt(pooled * (1 - pooled) * (1/n1 + 1/n2))
+	if stdErr == 0 {
+		// both leaves have either only successes or only failures
+		return 1
+	}
+	z := (successRate(primary) - successRate(canary)) / stdErr
+	return 0.5 * math.Erfc(z/math.Sqrt2)
+}
+
+// comparedLatency returns the latency compared in --compare mode, which is
+// the highest percentile requested with --percentiles, or the p99
+func (o *statOptions) comparedLatency(stats *pb.BasicStats) uint64 {
+	latencies := o.percentileLatencies(stats)
+	if len(latencies) == 0 {
+		return stats.GetLatencyMsP99()
+	}
+	highest := latencies[0]
+	for _, l := range latencies[1:] {
+		if l.percentile > highest.percentile {
+			highest = l
+		}
+	}
+	return highest.latencyMs
+}
+
+func (o *statOptions) comparedLatencyHeader() string {
+	headers := o.latencyHeaders()
+	if len(o.percentiles) == 0 {
+		return headers[len(headers)-1]
+	}
+	highest := 0
+	for i, p := range o.percentiles {
+		if p > o.percentiles[highest] {
+			highest = i
+		}
+	}
+	return headers[highest]
+}
+
+// comparisonsFailed returns true if a leaf is worse than its primary beyond
+// the thresholds
+func comparisonsFailed(comparisons []*leafComparison) bool {
+	for _, c := range comparisons {
+		if c.verdict == verdictFail {
+			return true
+		}
+	}
+	return false
+}
+
+// comparisonsInconclusive returns true if there are comparisons and none of
+// them is conclusive
+func comparisonsInconclusive(comparisons []*leafComparison) bool {
+	for _, c := range comparisons {
+		if c.verdict != verdictInconclusive {
+			return false
+		}
+	}
+	return len(comparisons) > 0
+}
+
+func renderComparisons(comparisons []*leafComparison, options *statOptions, w io.Writer) {
+	if options.outputFormat == jsonOutput {
+		printComparisonsJSON(comparisons, w)
+		return
+	}
+
+	if len(comparisons) == 0 {
+		fmt.Fprintln(w, "No TrafficSplit leaves to compare.")
+		return
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, padding, ' ', 0)
+	headers := []string{}
+	if options.allNamespaces {
+		headers = append(headers, namespaceHeader)
+	}
+	headers = append(headers, apexHeader, leafHeader, "PRIMARY", "REQUESTS", "SUCCESS_DELTA", "P_VALUE", options.comparedLatencyHeader()+"_DELTA", "VERDICT")
+	fmt.Fprintln(tw, strings.Join(headers, "\t"))
+
+	for _, c := range comparisons {
+		values := []string{}
+		if options.allNamespaces {
+			values = append(values, c.namespace)
+		}
+		values = append(values,
+			c.apex,
+			c.canary.leaf,
+			c.primary.leaf,
+			fmt.Sprintf("%d/%d", c.canary.requests(), c.primary.requests()),
+		)
+		if math.IsNaN(c.pValue) {
+			values = append(values, "-", "-")
+		} else {
+			values = append(values, fmt.Sprintf("%+.2fpp", c.successRateDelta), fmt.Sprintf("%.3f", c.pValue))
+		}
+		if math.IsNaN(c.latencyIncrease) {
+			values = append(values, fmt.Sprintf("%+dms", c.latencyDelta))
+		} else {
+			values = append(values, fmt.Sprintf("%+dms (%+.1f%%)", c.latencyDelta, c.latencyIncrease))
+		}
+		verdict := c.verdict
+		if len(c.reasons) > 0 {
+			verdict = fmt.Sprintf("%s (%s)", verdict, strings.Join(c.reasons, ", "))
+		}
+		values = append(values, verdict)
+		fmt.Fprintln(tw, strings.Join(values, "\t"))
+	}
+	tw.Flush()
+}
+
+type jsonLeafComparison struct {
+	Namespace        string   `json:"namespace"`
+	Apex             string   `json:"apex"`
+	Leaf             string   `json:"leaf"`
+	Primary          string   `json:"primary"`
+	Requests         uint64   `json:"requests"`
+	PrimaryRequests  uint64   `json:"primary_requests"`
+	SuccessRateDelta *float64 `json:"success_rate_delta"`
+	PValue           *float64 `json:"p_value"`
+	LatencyMSDelta   int64    `json:"latency_ms_delta"`
+	LatencyIncrease  *float64 `json:"latency_increase"`
+	Verdict          string   `json:"verdict"`
+	Reasons          []string `json:"reasons,omitempty"`
+}
+
+func printComparisonsJSON(comparisons []*leafComparison, w io.Writer) {
+	// avoid nil initialization so that if there are no comparisons it gets
+	// marshalled as an empty array vs null
+	entries := []*jsonLeafComparison{}
+	for _, c := range comparisons {
+		entry := &jsonLeafComparison{
+			Namespace:       c.namespace,
+			Apex:            c.apex,
+			Leaf:            c.canary.leaf,
+			Primary:         c.primary.leaf,
+			Requests:        c.canary.requests(),
+			PrimaryRequests: c.primary.requests(),
+			LatencyMSDelta:  c.latencyDelta,
+			Verdict:         c.verdict,
+			Reasons:         c.reasons,
+		}
+		if !math.IsNaN(c.pValue) {
+			delta, pValue := c.successRateDelta, c.pValue
+			entry.SuccessRateDelta = &delta
+			entry.PValue = &pValue
+		}
+		if !math.IsNaN(c.latencyIncrease) {
+			increase := c.latencyIncrease
+			entry.LatencyIncrease = &increase
+		}
+		entries = append(entries, entry)
+	}
+
+	b, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		fmt.Fprintf(stderr, "Error marshalling JSON: %s\n", err)
+		return
+	}
+	fmt.Fprintf(w, "%s\n", b)
+}
@@ -0,0 +1,179 @@
+package cmd
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	pb "github.com/linkerd/linkerd2/viz/metrics-api/gen/viz"
+)
+
+func genTrafficSplitLeafRow(leaf, weight string, successes, failures, latencyP99 uint64) *pb.StatTable_PodGroup_Row {
+	return &pb.StatTable_PodGroup_Row{
+		Resource: &pb.Resource{
+			Namespace: "emojivoto",
+			Type:      k8s.TrafficSplit,
+			Name:      "web-split",
+		},
+		TimeWindow: "1m",
+		Stats: &pb.BasicStats{
+			SuccessCount: successes,
+			FailureCount: failures,
+			LatencyMsP50: latencyP99 / 2,
+			LatencyMsP95: latencyP99 - 10,
+			LatencyMsP99: latencyP99,
+		},
+		TsStats: &pb.TrafficSplitStats{
+			Apex:   "web-svc",
+			Leaf:   leaf,
+			Weight: weight,
+		},
+	}
+}
+
+func TestCompareTrafficSplitLeaves(t *testing.T) {
+	rows := []*pb.StatTable_PodGroup_Row{
+		genTrafficSplitLeafRow("web-v1", "900m", 9900, 100, 200),
+		genTrafficSplitLeafRow("web-v2", "100m", 950, 50, 210),
+		genTrafficSplitLeafRow("web-v3", "0", 50, 0, 100),
+		genTrafficSplitLeafRow("web-v4", "0", 1000, 10, 400),
+	}
+
+	t.Run("Compares the leaves with the leaf with the highest weight", func(t *testing.T) {
+		options := newStatOptions()
+		comparisons, err := compareTrafficSplitLeaves(rows, options)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if len(comparisons) != 3 {
+			t.Fatalf("Expected 3 comparisons, got %d", len(comparisons))
+		}
+
+		expected := []struct {
+			leaf    string
+			verdict string
+			reasons []string
+		}{
+			{"web-v2", verdictFail, []string{"success rate"}},
+			{"web-v3", verdictInconclusive, []string{"less than 100 requests"}},
+			{"web-v4", verdictFail, []string{"latency"}},
+		}
+		for i, exp := range expected {
+			c := comparisons[i]
+			if c.primary.leaf != "web-v1" || c.canary.leaf != exp.leaf {
+				t.Fatalf("Expected %s to be compared with web-v1, got %s with %s", exp.leaf, c.canary.leaf, c.primary.leaf)
+			}
+			if c.verdict != exp.verdict || len(c.reasons) != len(exp.reasons) || (len(c.reasons) > 0 && c.reasons[0] != exp.reasons[0]) {
+				t.Fatalf("Expected %s to be %s %v, got %s %v", exp.leaf, exp.verdict, exp.reasons, c.verdict, c.reasons)
+			}
+		}
+
+		if math.Abs(comparisons[0].successRateDelta-(-4)) > 1e-9 {
+			t.Fatalf("Expected a success rate delta of -4pp, got %v", comparisons[0].successRateDelta)
+		}
+		if comparisons[0].pValue > 0.001 {
+			t.Fatalf("Expected a significant success rate drop, got a p-value of %v", comparisons[0].pValue)
+		}
+		if !comparisonsFailed(comparisons) {
+			t.Fatal("Expected the comparisons to fail")
+		}
+		if comparisonsInconclusive(comparisons) {
+			t.Fatal("Expected the comparisons not to be all inconclusive")
+		}
+	})
+
+	t.Run("Doesn't fail on insignificant drops", func(t *testing.T) {
+		options := newStatOptions()
+		options.comparison.primary = "web-v1"
+		options.comparison.maxLatencyIncrease = 200
+		comparisons, err := compareTrafficSplitLeaves([]*pb.StatTable_PodGroup_Row{
+			genTrafficSplitLeafRow("web-v1", "500m", 99, 1, 200),
+			genTrafficSplitLeafRow("web-v2", "500m", 97, 3, 210),
+		}, options)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if len(comparisons) != 1 {
+			t.Fatalf("Expected 1 comparison, got %d", len(comparisons))
+		}
+		if comparisons[0].verdict != verdictPass {
+			t.Fatalf("Expected the comparison to pass, got %s %v (p-value %v)", comparisons[0].verdict, comparisons[0].reasons, comparisons[0].pValue)
+		}
+		if comparisonsFailed(comparisons) {
+			t.Fatal("Expected the comparisons not to fail")
+		}
+	})
+
+	t.Run("Returns an error for an unknown primary", func(t *testing.T) {
+		options := newStatOptions()
+		options.comparison.primary = "web-v5"
+		_, err := compareTrafficSplitLeaves(rows, options)
+		if err == nil || err.Error() != `trafficsplit emojivoto/web-split has no leaf "web-v5"` {
+			t.Fatalf("Expected an error naming the primary, got %v", err)
+		}
+	})
+
+	t.Run("Returns an error for an invalid leaf weight", func(t *testing.T) {
+		options := newStatOptions()
+		_, err := compareTrafficSplitLeaves([]*pb.StatTable_PodGroup_Row{
+			genTrafficSplitLeafRow("web-v1", "900m", 9900, 100, 200),
+			genTrafficSplitLeafRow("web-v2", "ten", 950, 50, 210),
+		}, options)
+		if err == nil || !strings.HasPrefix(err.Error(), `trafficsplit emojivoto/web-split: leaf "web-v2" has an invalid weight "ten"`) {
+			t.Fatalf("Expected an error naming the leaf, got %v", err)
+		}
+	})
+
+	t.Run("Reports when all the comparisons are inconclusive", func(t *testing.T) {
+		options := newStatOptions()
+		comparisons, err := compareTrafficSplitLeaves([]*pb.StatTable_PodGroup_Row{
+			genTrafficSplitLeafRow("web-v1", "900m", 90, 0, 200),
+			genTrafficSplitLeafRow("web-v2", "100m", 10, 0, 210),
+		}, options)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if !comparisonsInconclusive(comparisons) {
+			t.Fatalf("Expected the comparisons to be inconclusive, got %+v", comparisons)
+		}
+		if comparisonsInconclusive(nil) {
+			t.Fatal("Expected no comparisons not to be inconclusive")
+		}
+	})
+
+	t.Run("Renders the comparisons", func(t *testing.T) {
+		for _, exp := range []struct {
+			outputFormat string
+			file         string
+		}{
+			{tableOutput, "stat_compare_output.golden"},
+			{jsonOutput, "stat_compare_output_json.golden"},
+		} {
+			options := newStatOptions()
+			options.outputFormat = exp.outputFormat
+			var buf bytes.Buffer
+			comparisons, err := compareTrafficSplitLeaves(rows, options)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			renderComparisons(comparisons, options, &buf)
+			testDataDiffer.DiffTestdata(t, exp.file, buf.String())
+		}
+	})
+}
+
+func TestCompareValidation(t *testing.T) {
+	options := newStatOptions()
+	options.compare = true
+
+	if _, err := buildStatSummaryRequests([]string{"deploy/web"}, options); err == nil || err.Error() != "--compare only supports trafficsplit" {
+		t.Fatalf("Expected an error for deployments, got %v", err)
+	}
+
+	options.comparison.confidence = 100
+	if _, err := buildStatSummaryRequests([]string{"ts/web-split"}, options); err == nil || err.Error() != "--confidence needs to be between 0 and 100 exclusive, got 100" {
+		t.Fatalf("Expected an error for the confidence, got %v", err)
+	}
+}
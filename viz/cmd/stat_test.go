@@ -1,11 +1,17 @@
 package cmd
 
 import (
+	"bytes"
+	"fmt"
+	"math"
+	"reflect"
 	"testing"
+	"time"
 
 	pkgcmd "github.com/linkerd/linkerd2/pkg/cmd"
 	"github.com/linkerd/linkerd2/pkg/k8s"
 	api "github.com/linkerd/linkerd2/viz/metrics-api"
+	pb "github.com/linkerd/linkerd2/viz/metrics-api/gen/viz"
 )
 
 type paramsExp struct {
@@ -118,6 +124,105 @@ func TestStat(t *testing.T) {
 		}, k8s.Namespace, t)
 	})
 
+	options = newStatOptions()
+	options.percentiles = []float64{50, 99.9}
+	t.Run("Returns stats with the requested latency percentiles", func(t *testing.T) {
+		testStatCall(paramsExp{
+			counts: &api.PodCounts{
+				MeshedPods:  1,
+				RunningPods: 2,
+				FailedPods:  0,
+			},
+			options: options,
+			resNs:   []string{"emojivoto1"},
+			file:    "stat_percentiles_output.golden",
+		}, k8s.Namespace, t)
+	})
+
+	options.outputFormat = jsonOutput
+	options.histogram = true
+	t.Run("Returns stats with the requested latency percentiles and histogram (json)", func(t *testing.T) {
+		testStatCall(paramsExp{
+			counts: &api.PodCounts{
+				MeshedPods:  1,
+				RunningPods: 2,
+				FailedPods:  0,
+			},
+			options: options,
+			resNs:   []string{"emojivoto1"},
+			file:    "stat_percentiles_output_json.golden",
+		}, k8s.Namespace, t)
+	})
+
+	statusOptions := newStatOptions()
+	statusOptions.byStatus = true
+	statusOptions.allNamespaces = true
+	t.Run("Returns stats broken down by status", func(t *testing.T) {
+		testStatCall(paramsExp{
+			counts: &api.PodCounts{
+				MeshedPods:  1,
+				RunningPods: 2,
+				FailedPods:  0,
+			},
+			options: statusOptions,
+			resNs:   []string{"emojivoto1", "emojivoto2"},
+			file:    "stat_by_status_output.golden",
+		}, k8s.Namespace, t)
+	})
+
+	statusOptions = newStatOptions()
+	statusOptions.byStatus = true
+	statusOptions.outputFormat = jsonOutput
+	t.Run("Returns stats broken down by status (json)", func(t *testing.T) {
+		testStatCall(paramsExp{
+			counts: &api.PodCounts{
+				MeshedPods:  1,
+				RunningPods: 2,
+				FailedPods:  0,
+			},
+			options: statusOptions,
+			resNs:   []string{"emojivoto1"},
+			file:    "stat_by_status_output_json.golden",
+		}, k8s.Namespace, t)
+	})
+
+	t.Run("Returns an error for --by-status with the jsonl output", func(t *testing.T) {
+		options := newStatOptions()
+		options.byStatus = true
+		options.outputFormat = jsonlOutput
+		args := []string{"ns/bar"}
+		expectedError := "--by-status is not available with the jsonl output format"
+
+		_, err := buildStatSummaryRequests(args, options)
+		if err == nil || err.Error() != expectedError {
+			t.Fatalf("Expected error [%s] instead got [%s]", expectedError, err)
+		}
+	})
+
+	t.Run("Returns an error for latency percentiles out of range", func(t *testing.T) {
+		options := newStatOptions()
+		options.percentiles = []float64{99, 100}
+		args := []string{"ns/bar"}
+		expectedError := "--percentiles need to be between 0 and 100 exclusive, got 100"
+
+		_, err := buildStatSummaryRequests(args, options)
+		if err == nil || err.Error() != expectedError {
+			t.Fatalf("Expected error [%s] instead got [%s]", expectedError, err)
+		}
+	})
+
+	t.Run("Returns an error for --histogram without the json output", func(t *testing.T) {
+		options := newStatOptions()
+		options.histogram = true
+		args := []string{"ns/bar"}
+		expectedError := "--histogram is only available with the json and jsonl output formats"
+
+		_, err := buildStatSummaryRequests(args, options)
+		if err == nil || err.Error() != expectedError {
+			t.Fatalf("Expected error [%s] instead got [%s]", expectedError, err)
+		}
+	})
+
 	t.Run("Returns an error for named resource queries with the --all-namespaces flag", func(t *testing.T) {
 		options := newStatOptions()
 		options.allNamespaces = true
@@ -248,6 +353,11 @@ func testStatCall(exp paramsExp, resourceType string, t *testing.T) {
 		t.Fatalf("Unexpected error: %v", err)
 	}
 
+	for _, row := range respToRows(response) {
+		addMockLatencies(row.Stats, reqs[0])
+		addMockStatusCounts(row.Stats, reqs[0])
+	}
+
 	resp, err := requestStatsFromAPI(mockClient, reqs[0])
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
@@ -258,3 +368,158 @@ func testStatCall(exp paramsExp, resourceType string, t *testing.T) {
 
 	testDataDiffer.DiffTestdata(t, exp.file, output)
 }
+
+// latencyRequest is a request of latency quantiles and histogram
+type latencyRequest interface {
+	GetLatencyQuantiles() []float64
+	GetLatencyHistogram() bool
+}
+
+// addMockLatencies adds the latencies requested to mock stats, the latencies
+// of the quantiles being the same as their p50, p95 and p99
+func addMockLatencies(stats *pb.BasicStats, req latencyRequest) {
+	if stats == nil {
+		return
+	}
+	for _, quantile := range req.GetLatencyQuantiles() {
+		stats.LatencyQuantiles = append(stats.LatencyQuantiles, &pb.LatencyQuantile{
+			Quantile:  quantile,
+			LatencyMs: stats.LatencyMsP99,
+		})
+	}
+	if req.GetLatencyHistogram() {
+		stats.LatencyHistogram = []*pb.LatencyBucket{
+			{Le: 10, Count: 100},
+			{Le: 100, Count: 120},
+			{Le: math.Inf(1), Count: 123},
+		}
+	}
+}
+
+// addMockStatusCounts adds a breakdown by status to mock stats if requested,
+// splitting their successes between HTTP 200 and gRPC OK responses
+func addMockStatusCounts(stats *pb.BasicStats, req interface{ GetStatusBreakdown() bool }) {
+	if stats == nil || !req.GetStatusBreakdown() || stats.SuccessCount == 0 {
+		return
+	}
+	stats.FailureCount = 3
+	stats.StatusCounts = []*pb.StatusCount{
+		{HttpStatus: "200", SuccessCount: stats.SuccessCount - stats.SuccessCount/3},
+		{HttpStatus: "200", GrpcStatus: "0", SuccessCount: stats.SuccessCount / 3},
+		{HttpStatus: "200", GrpcStatus: "14", FailureCount: 1},
+		{HttpStatus: "503", FailureCount: 2},
+	}
+}
+
+func TestStatWatch(t *testing.T) {
+	t.Run("Rejects --watch with the --since flag", func(t *testing.T) {
+		options := newStatOptions()
+		options.watch = true
+		options.since = "1h"
+		expectedError := "--watch and --since flags are mutually exclusive"
+
+		err := options.validateConflictingFlags()
+		if err == nil || err.Error() != expectedError {
+			t.Fatalf("Expected error [%s] instead got [%s]", expectedError, err)
+		}
+	})
+
+	t.Run("Rejects --watch with the json output", func(t *testing.T) {
+		options := newStatOptions()
+		options.watch = true
+		options.outputFormat = jsonOutput
+		expectedError := "--watch only supports the table and jsonl output formats"
+
+		err := options.validateConflictingFlags()
+		if err == nil || err.Error() != expectedError {
+			t.Fatalf("Expected error [%s] instead got [%s]", expectedError, err)
+		}
+	})
+
+	t.Run("Rejects an --interval of less than 1s", func(t *testing.T) {
+		options := newStatOptions()
+		options.watch = true
+		options.interval = 500 * time.Millisecond
+		expectedError := "--interval needs to be at least 1s"
+
+		err := options.validateConflictingFlags()
+		if err == nil || err.Error() != expectedError {
+			t.Fatalf("Expected error [%s] instead got [%s]", expectedError, err)
+		}
+	})
+
+	t.Run("Renders the history of the stats", func(t *testing.T) {
+		options := newStatOptions()
+		watcher := newStatWatcher(options)
+
+		resp := api.GenStatSummaryResponse("emoji", k8s.Deployment, []string{"emojivoto"}, &api.PodCounts{MeshedPods: 1, RunningPods: 2}, true, false)
+		watcher.update(respToRows(resp))
+		watcher.update(respToRows(api.GenStatSummaryResponse("emoji", k8s.Deployment, []string{}, nil, true, false)))
+		if len(watcher.history) != 0 {
+			t.Fatalf("Expected the history of resources without stats to be dropped, got %d entries", len(watcher.history))
+		}
+
+		watcher.update(respToRows(resp))
+		watcher.update(respToRows(resp))
+		h, ok := watcher.history["deployment/emojivoto/emoji/"]
+		if !ok {
+			t.Fatalf("Expected a history for deploy/emoji, got %v", watcher.history)
+		}
+		if len(h.successRate) != 4 {
+			t.Fatalf("Expected the history to be padded to 4 polls, got %d", len(h.successRate))
+		}
+
+		var buf bytes.Buffer
+		watcher.render(&buf)
+		expected := "NAME    SUCCESS          RPS             LATENCY_P99   \n" +
+			"emoji   100.00%     ▁▁   2.0rps     ▁▁   123ms           ▁▁\n"
+		if buf.String() != expected {
+			t.Fatalf("Expected:\n%s\nGot:\n%s", expected, buf.String())
+		}
+	})
+
+	t.Run("Streams the stats as JSON lines", func(t *testing.T) {
+		options := newStatOptions()
+		resp := api.GenStatSummaryResponse("emoji", k8s.Deployment, []string{"emojivoto"}, &api.PodCounts{MeshedPods: 1, RunningPods: 2}, true, false)
+		statTables, _ := buildStatTables(respToRows(resp), options)
+
+		var buf bytes.Buffer
+		printStatJSONL(statTables, time.Date(2021, 9, 1, 12, 0, 0, 0, time.UTC), &buf)
+		expected := `{"timestamp":"2021-09-01T12:00:00Z","namespace":"emojivoto","kind":"deployment","name":"emoji","meshed":"1/2","success":1,"rps":2.05,"latency_ms_p50":123,"latency_ms_p95":123,"latency_ms_p99":123,"tcp_open_connections":0,"tcp_read_bytes_rate":0,"tcp_write_bytes_rate":0}
+`
+		if buf.String() != expected {
+			t.Fatalf("Expected:\n%s\nGot:\n%s", expected, buf.String())
+		}
+	})
+}
+
+func TestLatencyQuantiles(t *testing.T) {
+	options := newStatOptions()
+	options.percentiles = []float64{50, 99.9, 99.99, 0.1}
+	expected := []float64{0.5, 0.999, 0.9999, 0.001}
+	if quantiles := options.latencyQuantiles(); !reflect.DeepEqual(quantiles, expected) {
+		t.Fatalf("Expected quantiles %v, got %v", expected, quantiles)
+	}
+}
+
+func TestSparkline(t *testing.T) {
+	testCases := []struct {
+		values   []float64
+		expected string
+	}{
+		{[]float64{}, ""},
+		{[]float64{1, 1, 1}, "▁▁▁"},
+		{[]float64{0, 1, 2, 3, 4, 5, 6, 7}, "▁▂▃▄▅▆▇█"},
+		{[]float64{math.NaN(), 10, 20}, " ▁█"},
+	}
+
+	for i, tc := range testCases {
+		tc := tc // pin
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			actual := sparkline(tc.values)
+			if actual != tc.expected {
+				t.Fatalf("Expected sparkline %q, got %q", tc.expected, actual)
+			}
+		})
+	}
+}
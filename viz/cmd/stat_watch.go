@@ -0,0 +1,219 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	pb "github.com/linkerd/linkerd2/viz/metrics-api/gen/viz"
+)
+
+const (
+	// sparklineLength is the number of polls displayed in the sparklines
+	sparklineLength = 20
+
+	// clearScreen moves the cursor to the top left corner of the terminal and
+	// clears it
+	clearScreen = "\033[H\033[2J"
+)
+
+var sparklineTicks = []rune("▁▂▃▄▅▆▇█")
+
+// statHistory is the history of the stats of a resource over the polls
+type statHistory struct {
+	successRate []float64
+	requestRate []float64
+	latencyP99  []float64
+}
+
+// add appends the stats of a poll to the history, with NaN values when the
+// resource had no traffic, and drops the polls which no longer fit in the
+// sparklines
+func (h *statHistory) add(entry *jsonStats) {
+	success, rps, p99 := math.NaN(), math.NaN(), math.NaN()
+	if entry != nil && entry.Success != nil {
+		success = *entry.Success * 100
+		rps = *entry.Rps
+		p99 = float64(*entry.LatencyMSp99)
+	}
+	h.successRate = appendSample(h.successRate, success)
+	h.requestRate = appendSample(h.requestRate, rps)
+	h.latencyP99 = appendSample(h.latencyP99, p99)
+}
+
+func appendSample(samples []float64, value float64) []float64 {
+	samples = append(samples, value)
+	if len(samples) > sparklineLength {
+		samples = samples[len(samples)-sparklineLength:]
+	}
+	return samples
+}
+
+// statWatcher keeps the history of the resources polled in watch mode
+type statWatcher struct {
+	options *statOptions
+	// history is indexed by the kind, namespace, name and leaf of the resources
+	history map[string]*statHistory
+	// entries are the latest stats of the resources, in display order
+	entries []*jsonStats
+	polls   int
+}
+
+func newStatWatcher(options *statOptions) *statWatcher {
+	return &statWatcher{
+		options: options,
+		history: make(map[string]*statHistory),
+	}
+}
+
+func historyKey(entry *jsonStats) string {
+	return strings.Join([]string{entry.Kind, entry.Namespace, entry.Name, entry.Leaf}, "/")
+}
+
+// update adds the stats of a poll to the history of the resources. Resources
+// which are gone are no longer displayed.
+func (sw *statWatcher) update(rows []*pb.StatTable_PodGroup_Row) {
+	statTables, _ := buildStatTables(rows, sw.options)
+	sw.entries = statJSONEntries(statTables)
+	sw.polls++
+
+	history := make(map[string]*statHistory, len(sw.entries))
+	for _, entry := range sw.entries {
+		key := historyKey(entry)
+		h, ok := sw.history[key]
+		if !ok {
+			// pad the history of new resources so that all the sparklines
+			// are aligned on the latest poll
+			h = &statHistory{}
+			for i := 1; i < sw.polls && i < sparklineLength; i++ {
+				h.add(nil)
+			}
+		}
+		h.add(entry)
+		history[key] = h
+	}
+	sw.history = history
+}
+
+// render writes the latest stats of the resources, along with the sparklines
+// of their history
+func (sw *statWatcher) render(w io.Writer) {
+	if len(sw.entries) == 0 {
+		fmt.Fprintln(w, "No traffic found.")
+		return
+	}
+
+	kinds := make(map[string]bool)
+	for _, entry := range sw.entries {
+		kinds[entry.Kind] = true
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, padding, ' ', 0)
+	headers := []string{}
+	if sw.options.allNamespaces {
+		headers = append(headers, namespaceHeader)
+	}
+	headers = append(headers, nameHeader, "SUCCESS", "", "RPS", "", "LATENCY_P99", "")
+	fmt.Fprintln(tw, strings.Join(headers, "\t"))
+
+	for _, entry := range sw.entries {
+		h := sw.history[historyKey(entry)]
+
+		name := entry.Name
+		if len(kinds) > 1 {
+			name = getNamePrefix(entry.Kind) + name
+		}
+		if entry.Kind == k8s.TrafficSplit {
+			name = fmt.Sprintf("%s (%s)", name, entry.Leaf)
+		}
+
+		values := []string{}
+		if sw.options.allNamespaces {
+			values = append(values, entry.Namespace)
+		}
+		values = append(values, name)
+		if entry.Success != nil {
+			values = append(values,
+				fmt.Sprintf("%.2f%%", *entry.Success*100), sparkline(h.successRate),
+				fmt.Sprintf("%.1frps", *entry.Rps), sparkline(h.requestRate),
+				fmt.Sprintf("%dms", *entry.LatencyMSp99), sparkline(h.latencyP99),
+			)
+		} else {
+			values = append(values,
+				"-", sparkline(h.successRate),
+				"-", sparkline(h.requestRate),
+				"-", sparkline(h.latencyP99),
+			)
+		}
+		fmt.Fprintln(tw, strings.Join(values, "\t"))
+	}
+	tw.Flush()
+}
+
+// sparkline renders the values scaled between their minimum and maximum, and
+// the missing (NaN) values as blanks
+func sparkline(values []float64) string {
+	min, max := math.Inf(1), math.Inf(-1)
+	for _, v := range values {
+		if !math.IsNaN(v) {
+			min = math.Min(min, v)
+			max = math.Max(max, v)
+		}
+	}
+
+	var b strings.Builder
+	for _, v := range values {
+		switch {
+		case math.IsNaN(v):
+			b.WriteRune(' ')
+		case max == min:
+			b.WriteRune(sparklineTicks[0])
+		default:
+			tick := int(math.Round((v - min) / (max - min) * float64(len(sparklineTicks)-1)))
+			b.WriteRune(sparklineTicks[tick])
+		}
+	}
+	return b.String()
+}
+
+// watchStats polls the stats every interval until the context is canceled,
+// and either redraws them along with their history, or streams them as JSON
+// lines
+func watchStats(ctx context.Context, client pb.ApiClient, reqs []*pb.StatSummaryRequest, options *statOptions, w io.Writer) error {
+	watcher := newStatWatcher(options)
+	ticker := time.NewTicker(options.interval)
+	defer ticker.Stop()
+
+	for {
+		rows := make([]*pb.StatTable_PodGroup_Row, 0)
+		for _, req := range reqs {
+			resp, err := requestStatsFromAPI(client, req)
+			if err != nil {
+				return err
+			}
+			rows = append(rows, respToRows(resp)...)
+		}
+
+		now := time.Now()
+		if options.outputFormat == jsonlOutput {
+			statTables, _ := buildStatTables(rows, options)
+			printStatJSONL(statTables, now, w)
+		} else {
+			watcher.update(rows)
+			fmt.Fprint(w, clearScreen)
+			fmt.Fprintf(w, "Every %s, over the last %s: %s\n\n", options.interval, options.timeWindow, now.Format(time.RFC1123))
+			watcher.render(w)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+
+	pb "github.com/linkerd/linkerd2/viz/metrics-api/gen/viz"
+	"google.golang.org/grpc/codes"
+)
+
+// statusBreakdownRow is a resource or route along with the breakdown of its
+// responses by status
+type statusBreakdownRow struct {
+	labels []string
+	counts []*pb.StatusCount
+}
+
+var statusBreakdownHeaders = []string{"HTTP_CLASS", "HTTP_STATUS", "GRPC_STATUS", "SUCCESS", "FAILURE", "SHARE"}
+
+// printStatusBreakdown writes a line for each status of each row, prefixed by
+// the labels of the row
+func printStatusBreakdown(labelHeaders []string, rows []statusBreakdownRow, w io.Writer) {
+	// left-align the labels as the writer aligns the cells on the right
+	widths := make([]int, len(labelHeaders))
+	for i, header := range labelHeaders {
+		widths[i] = len(header)
+	}
+	for _, row := range rows {
+		for i, label := range row.labels {
+			if len(label) > widths[i] {
+				widths[i] = len(label)
+			}
+		}
+	}
+	pad := func(values []string) []string {
+		padded := make([]string, len(values))
+		for i, value := range values {
+			padded[i] = fmt.Sprintf("%-*s", widths[i], value)
+		}
+		return padded
+	}
+
+	headers := append(pad(labelHeaders), statusBreakdownHeaders...)
+	fmt.Fprintln(w, strings.Join(headers, "\t")+"\t")
+
+	for _, row := range rows {
+		var total uint64
+		for _, sc := range row.counts {
+			total += sc.GetSuccessCount() + sc.GetFailureCount()
+		}
+		labels := pad(row.labels)
+
+		if total == 0 {
+			values := append(labels, "-", "-", "-", "-", "-", "-")
+			fmt.Fprintln(w, strings.Join(values, "\t")+"\t")
+			continue
+		}
+
+		for _, sc := range row.counts {
+			values := append(append([]string{}, labels...),
+				httpStatusClass(sc.GetHttpStatus()),
+				valueOrDash(sc.GetHttpStatus()),
+				valueOrDash(grpcStatusName(sc.GetGrpcStatus())),
+				strconv.FormatUint(sc.GetSuccessCount(), 10),
+				strconv.FormatUint(sc.GetFailureCount(), 10),
+				fmt.Sprintf("%.2f%%", float64(sc.GetSuccessCount()+sc.GetFailureCount())/float64(total)*100),
+			)
+			fmt.Fprintln(w, strings.Join(values, "\t")+"\t")
+		}
+	}
+}
+
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
+// httpStatusClass returns the class of an HTTP status, e.g. 5xx
+func httpStatusClass(status string) string {
+	if len(status) != 3 {
+		return "-"
+	}
+	return status[:1] + "xx"
+}
+
+// grpcStatusName returns the canonical name of a gRPC status code, e.g.
+// DEADLINE_EXCEEDED, or the code itself if it's unknown
+func grpcStatusName(status string) string {
+	code, err := strconv.ParseUint(status, 10, 32)
+	if err != nil || code > uint64(codes.Unauthenticated) {
+		return status
+	}
+
+	var name strings.Builder
+	prev := ' '
+	for _, r := range codes.Code(code).String() {
+		if unicode.IsUpper(r) && unicode.IsLower(prev) {
+			name.WriteRune('_')
+		}
+		name.WriteRune(unicode.ToUpper(r))
+		prev = r
+	}
+	return name.String()
+}
+
+type jsonStatusCount struct {
+	HTTPStatusClass string `json:"http_status_class,omitempty"`
+	HTTPStatus      string `json:"http_status,omitempty"`
+	GRPCStatus      string `json:"grpc_status,omitempty"`
+	Success         uint64 `json:"success"`
+	Failure         uint64 `json:"failure"`
+}
+
+func jsonStatusCounts(counts []*pb.StatusCount) []*jsonStatusCount {
+	if len(counts) == 0 {
+		return nil
+	}
+	entries := make([]*jsonStatusCount, 0, len(counts))
+	for _, sc := range counts {
+		entry := &jsonStatusCount{
+			HTTPStatus: sc.GetHttpStatus(),
+			GRPCStatus: grpcStatusName(sc.GetGrpcStatus()),
+			Success:    sc.GetSuccessCount(),
+			Failure:    sc.GetFailureCount(),
+		}
+		if class := httpStatusClass(sc.GetHttpStatus()); class != "-" {
+			entry.HTTPStatusClass = class
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
@@ -0,0 +1,32 @@
+package cmd
+
+import "testing"
+
+func TestGrpcStatusName(t *testing.T) {
+	for status, expected := range map[string]string{
+		"0":   "OK",
+		"4":   "DEADLINE_EXCEEDED",
+		"14":  "UNAVAILABLE",
+		"16":  "UNAUTHENTICATED",
+		"17":  "17",
+		"foo": "foo",
+		"":    "",
+	} {
+		if name := grpcStatusName(status); name != expected {
+			t.Errorf("Expected %q for status %q, got %q", expected, status, name)
+		}
+	}
+}
+
+func TestHTTPStatusClass(t *testing.T) {
+	for status, expected := range map[string]string{
+		"200": "2xx",
+		"503": "5xx",
+		"":    "-",
+		"42":  "-",
+	} {
+		if class := httpStatusClass(status); class != expected {
+			t.Errorf("Expected %q for status %q, got %q", expected, status, class)
+		}
+	}
+}
@@ -4,10 +4,13 @@ import (
 	"bufio"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"os/signal"
 	"strings"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/duration"
 	netPb "github.com/linkerd/linkerd2/controller/gen/common/net"
@@ -36,8 +39,14 @@ type tapOptions struct {
 	method        string
 	authority     string
 	path          string
+	status        string
+	minLatency    time.Duration
+	headers       []string
 	output        string
 	labelSelector string
+	record        string
+	otlpFile      string
+	maxEntries    int
 }
 
 type endpoint struct {
@@ -115,13 +124,23 @@ func newTapOptions() *tapOptions {
 		method:        "",
 		authority:     "",
 		path:          "",
+		status:        "",
+		minLatency:    0,
+		headers:       []string{},
 		output:        "",
 		labelSelector: "",
+		record:        "",
+		otlpFile:      "",
+		maxEntries:    defaultMaxHAREntries,
 	}
 }
 
 func (o *tapOptions) validate() error {
-	if o.output == "" || o.output == wideOutput || o.output == jsonOutput {
+	if o.maxEntries < 0 {
+		return fmt.Errorf("--max-entries can't be negative, got %d", o.maxEntries)
+	}
+
+	if o.output == "" || o.output == wideOutput || o.output == jsonOutput || o.output == harOutput {
 		return nil
 	}
 
@@ -171,7 +190,16 @@ func NewCmdTap() *cobra.Command {
   linkerd viz tap pod/web-dlbvj
 
   # tap the test namespace, filter by request to prod namespace
-  linkerd viz tap ns/test --to ns/prod`,
+  linkerd viz tap ns/test --to ns/prod
+
+  # tap the web deployment, showing only the server errors slower than 100ms
+  linkerd viz tap deploy/web --status 5xx --min-latency 100ms
+
+  # tap the web deployment and record its events to web.tap
+  linkerd viz tap deploy/web --record web.tap
+
+  # tap the web deployment and write its requests to web.har on interrupt
+  linkerd viz tap deploy/web -o har > web.har`,
 		Args: cobra.RangeArgs(1, 2),
 		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 			// This command requires at most two arguments if we already have
@@ -223,7 +251,10 @@ func NewCmdTap() *cobra.Command {
 				Method:        options.method,
 				Authority:     options.authority,
 				Path:          options.path,
-				Extract:       options.output == jsonOutput,
+				Status:        options.status,
+				MinLatency:    options.minLatency,
+				Headers:       options.headers,
+				Extract:       options.output == jsonOutput || options.output == harOutput,
 				LabelSelector: options.labelSelector,
 			}
 
@@ -244,7 +275,12 @@ func NewCmdTap() *cobra.Command {
 				os.Exit(1)
 			}
 
-			err = requestTapByResourceFromAPI(cmd.Context(), os.Stdout, k8sAPI, req, options)
+			// stop the tap on interrupt to complete the outputs written at the
+			// end, like the HAR output
+			ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt)
+			defer cancel()
+
+			err = requestTapByResourceFromAPI(ctx, os.Stdout, k8sAPI, req, options)
 			if err != nil {
 				fmt.Fprint(os.Stderr, err.Error())
 				os.Exit(1)
@@ -270,10 +306,24 @@ func NewCmdTap() *cobra.Command {
 		"Display requests with this :authority")
 	cmd.PersistentFlags().StringVar(&options.path, "path", options.path,
 		"Display requests with paths that start with this prefix")
+	cmd.PersistentFlags().StringVar(&options.status, "status", options.status,
+		"Display requests with a response status in this range; one of a status (503), a class (5xx) or a range (500-504)")
+	cmd.PersistentFlags().DurationVar(&options.minLatency, "min-latency", options.minLatency,
+		"Display requests whose response took at least this long (e.g. 100ms)")
+	cmd.PersistentFlags().StringArrayVar(&options.headers, "header", options.headers,
+		"Display requests with this header, as NAME=VALUE or NAME to match any value; may be repeated")
 	cmd.PersistentFlags().StringVarP(&options.output, "output", "o", options.output,
-		fmt.Sprintf("Output format. One of: \"%s\", \"%s\"", wideOutput, jsonOutput))
+		fmt.Sprintf("Output format. One of: \"%s\", \"%s\", \"%s\"", wideOutput, jsonOutput, harOutput))
 	cmd.PersistentFlags().StringVarP(&options.labelSelector, "selector", "l", options.labelSelector,
 		"Selector (label query) to filter on, supports '=', '==', and '!='")
+	cmd.PersistentFlags().StringVar(&options.otlpFile, "otlp-file", options.otlpFile,
+		"Export each request to this file as an OpenTelemetry span, in the OTLP JSON file format")
+	cmd.PersistentFlags().IntVar(&options.maxEntries, "max-entries", options.maxEntries,
+		"Maximum number of requests written with \"-o har\"; the requests are kept in memory until the tap ends, and only the most recent ones are written beyond this limit (0 for no limit)")
+	cmd.Flags().StringVar(&options.record, "record", options.record,
+		"Record the events to this file, to replay them with \"linkerd viz tap replay\"")
+
+	cmd.AddCommand(newCmdTapReplay(options))
 
 	pkgcmd.ConfigureNamespaceFlagCompletion(
 		cmd, []string{"namespace", "to-namespace"},
@@ -288,19 +338,89 @@ func requestTapByResourceFromAPI(ctx context.Context, w io.Writer, k8sAPI *k8s.K
 	}
 	defer body.Close()
 
-	return writeTapEventsToBuffer(w, reader, req, options)
+	var recorder *pkg.RecordingWriter
+	if options.record != "" {
+		f, err := os.Create(options.record)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		recorder, err = pkg.NewRecordingWriter(f, req)
+		if err != nil {
+			return fmt.Errorf("failed to write to %s: %s", options.record, err)
+		}
+	}
+
+	src := &tapEventSource{
+		read: readLiveTapEvent(reader),
+		req:  req,
+	}
+	return writeTapEventsToBuffer(w, src, recorder, options)
+}
+
+// tapEventSource is a stream of tap events, live or recorded
+type tapEventSource struct {
+	// read returns the next event of the stream along with when it was
+	// received
+	read func() (*tapPb.TapEvent, time.Time, error)
+	req  *tapPb.TapByResourceRequest
+	// filter filters the events of the stream, if not nil
+	filter *pkg.EventFilter
+	// received is when the last event read was received
+	received time.Time
+}
+
+// readLiveTapEvent reads the events of a live tap stream, which are received
+// as they're read
+func readLiveTapEvent(stream *bufio.Reader) func() (*tapPb.TapEvent, time.Time, error) {
+	return func() (*tapPb.TapEvent, time.Time, error) {
+		event := &tapPb.TapEvent{}
+		if err := protohttp.FromByteStreamToProtocolBuffers(stream, event); err != nil {
+			return nil, time.Time{}, err
+		}
+		return event, time.Now(), nil
+	}
+}
+
+func (s *tapEventSource) next() (*tapPb.TapEvent, error) {
+	event, received, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+	s.received = received
+	return event, nil
 }
 
-func writeTapEventsToBuffer(w io.Writer, tapByteStream *bufio.Reader, req *tapPb.TapByResourceRequest, options *tapOptions) error {
+// clock returns when the last event read was received
+func (s *tapEventSource) clock() time.Time {
+	return s.received
+}
+
+// writeTapEventsToBuffer renders the events of a tap stream or recording,
+// recording them first if recorder isn't nil
+func writeTapEventsToBuffer(w io.Writer, src *tapEventSource, recorder *pkg.RecordingWriter, options *tapOptions) error {
+	var exporters []tapEventExporter
+	if options.otlpFile != "" {
+		f, err := os.Create(options.otlpFile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		exporters = append(exporters, newOTLPExporter(f, src.req, src.clock))
+	}
+
 	var err error
 	switch options.output {
 	case "":
-		err = renderTapEvents(tapByteStream, w, renderTapEvent, "")
+		err = renderTapEvents(src, w, renderTapEvent, "", recorder, exporters)
 	case wideOutput:
-		resource := req.GetTarget().GetResource().GetType()
-		err = renderTapEvents(tapByteStream, w, renderTapEvent, resource)
+		resource := src.req.GetTarget().GetResource().GetType()
+		err = renderTapEvents(src, w, renderTapEvent, resource, recorder, exporters)
 	case jsonOutput:
-		err = renderTapEvents(tapByteStream, w, renderTapEventJSON, "")
+		err = renderTapEvents(src, w, renderTapEventJSON, "", recorder, exporters)
+	case harOutput:
+		exporters = append(exporters, newHARExporter(w, src.clock, options.maxEntries))
+		err = renderTapEvents(src, w, nil, "", recorder, exporters)
 	}
 	if err != nil {
 		return err
@@ -309,20 +429,44 @@ func writeTapEventsToBuffer(w io.Writer, tapByteStream *bufio.Reader, req *tapPb
 	return nil
 }
 
-func renderTapEvents(tapByteStream *bufio.Reader, w io.Writer, render renderTapEventFunc, resource string) error {
+// renderTapEvents renders the events of the source one by one, unless render
+// is nil, and passes them to the exporters
+func renderTapEvents(src *tapEventSource, w io.Writer, render renderTapEventFunc, resource string, recorder *pkg.RecordingWriter, exporters []tapEventExporter) error {
 	for {
 		log.Debug("Waiting for data...")
-		event := tapPb.TapEvent{}
-		err := protohttp.FromByteStreamToProtocolBuffers(tapByteStream, &event)
-		if err == io.EOF {
+		event, err := src.next()
+		if errors.Is(err, io.EOF) {
 			break
 		}
 		if err != nil {
-			fmt.Fprintln(os.Stderr, err)
+			// the stream is closed when the tap is interrupted
+			if !errors.Is(err, context.Canceled) && !strings.HasSuffix(err.Error(), pkg.ErrClosedResponseBody) {
+				fmt.Fprintln(os.Stderr, err)
+			}
 			break
 		}
-		_, err = fmt.Fprintln(w, render(&event, resource))
-		if err != nil {
+		if recorder != nil {
+			if err := recorder.Write(event, src.received); err != nil {
+				return fmt.Errorf("failed to record event: %s", err)
+			}
+		}
+		for _, event := range src.filter.Filter(event) {
+			if render != nil {
+				_, err = fmt.Fprintln(w, render(event, resource))
+				if err != nil {
+					return err
+				}
+			}
+			for _, exporter := range exporters {
+				if err := exporter.add(event); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	for _, exporter := range exporters {
+		if err := exporter.close(); err != nil {
 			return err
 		}
 	}
@@ -481,34 +625,14 @@ func getRequestInitEvent(pubEv *tapPb.TapEvent_Http) *requestInitEvent {
 	}
 	return &requestInitEvent{
 		ID:        sid,
-		Method:    formatMethod(reqI.GetMethod()),
-		Scheme:    formatScheme(reqI.GetScheme()),
+		Method:    pkg.FormatMethod(reqI.GetMethod()),
+		Scheme:    pkg.FormatScheme(reqI.GetScheme()),
 		Authority: reqI.GetAuthority(),
 		Path:      reqI.GetPath(),
 		Headers:   formatHeadersTrailers(reqI.GetHeaders()),
 	}
 }
 
-func formatMethod(m *metricsPb.HttpMethod) string {
-	if x, ok := m.GetType().(*metricsPb.HttpMethod_Registered_); ok {
-		return x.Registered.String()
-	}
-	if s, ok := m.GetType().(*metricsPb.HttpMethod_Unregistered); ok {
-		return s.Unregistered
-	}
-	return ""
-}
-
-func formatScheme(s *metricsPb.Scheme) string {
-	if x, ok := s.GetType().(*metricsPb.Scheme_Registered_); ok {
-		return x.Registered.String()
-	}
-	if str, ok := s.GetType().(*metricsPb.Scheme_Unregistered); ok {
-		return str.Unregistered
-	}
-	return ""
-}
-
 // Attempt to map a `TapEvent_Http_ResponseInit` event to a `responseInitEvent`
 func getResponseInitEvent(pubEv *tapPb.TapEvent_Http) *responseInitEvent {
 	resI := pubEv.GetResponseInit()
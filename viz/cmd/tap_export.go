@@ -0,0 +1,524 @@
+package cmd
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/linkerd/linkerd2/pkg/addr"
+	"github.com/linkerd/linkerd2/pkg/version"
+	metricsPb "github.com/linkerd/linkerd2/viz/metrics-api/gen/viz"
+	tapPb "github.com/linkerd/linkerd2/viz/tap/gen/tap"
+	"github.com/linkerd/linkerd2/viz/tap/pkg"
+)
+
+const (
+	tapExporterName = "linkerd viz tap"
+
+	// defaultMaxHAREntries bounds the memory used by "-o har", which can only
+	// write the document when the tap ends
+	defaultMaxHAREntries = 10000
+)
+
+// tapEventExporter exports the requests of the events of a tap stream
+type tapEventExporter interface {
+	// add adds the next event of the stream
+	add(event *tapPb.TapEvent) error
+	// close exports the requests that haven't ended yet, at the end of the
+	// stream
+	close() error
+}
+
+// timedTapRequests pairs the events of the requests observed by tap, keeping
+// track of when they started
+type timedTapRequests struct {
+	*tapRequests
+	clock  func() time.Time
+	starts map[*tapPb.TapEvent_Http_RequestInit]time.Time
+}
+
+func newTimedTapRequests(clock func() time.Time) *timedTapRequests {
+	return &timedTapRequests{
+		tapRequests: newTapRequests(),
+		clock:       clock,
+		starts:      make(map[*tapPb.TapEvent_Http_RequestInit]time.Time),
+	}
+}
+
+func (r *timedTapRequests) add(event *tapPb.TapEvent) (tapRequest, bool) {
+	if reqInit := event.GetHttp().GetRequestInit(); reqInit != nil {
+		r.starts[reqInit] = r.clock()
+	}
+	return r.tapRequests.add(event)
+}
+
+// start returns when the request started, and forgets it
+func (r *timedTapRequests) start(req tapRequest) time.Time {
+	start := r.starts[req.reqInit]
+	delete(r.starts, req.reqInit)
+	return start
+}
+
+// requestURL returns the URL of a request
+func requestURL(reqInit *tapPb.TapEvent_Http_RequestInit) string {
+	scheme := strings.ToLower(pkg.FormatScheme(reqInit.GetScheme()))
+	if scheme == "" {
+		scheme = "http"
+	}
+	return fmt.Sprintf("%s://%s%s", scheme, reqInit.GetAuthority(), reqInit.GetPath())
+}
+
+// headerValue returns the value of a header, base64-encoded if it's binary
+func headerValue(h *metricsPb.Headers_Header) string {
+	if bin := h.GetValueBin(); bin != nil {
+		if utf8.Valid(bin) {
+			return string(bin)
+		}
+		return base64.StdEncoding.EncodeToString(bin)
+	}
+	return h.GetValueStr()
+}
+
+func durationMs(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// harExporter writes the requests as the entries of an HTTP Archive (HAR)
+// document once the stream ends, see
+// http://www.softwareishard.com/blog/har-12-spec/
+type harExporter struct {
+	w        io.Writer
+	requests *timedTapRequests
+	entries  []*harEntry
+	// maxEntries is the number of most recent entries kept, or 0 to keep
+	// them all
+	maxEntries int
+	dropped    int
+}
+
+type harDocument struct {
+	Log harLog `json:"log"`
+}
+
+type harLog struct {
+	Version string      `json:"version"`
+	Creator harCreator  `json:"creator"`
+	Entries []*harEntry `json:"entries"`
+}
+
+type harCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type harEntry struct {
+	StartedDateTime string      `json:"startedDateTime"`
+	Time            float64     `json:"time"`
+	Request         harRequest  `json:"request"`
+	Response        harResponse `json:"response"`
+	Cache           struct{}    `json:"cache"`
+	Timings         harTimings  `json:"timings"`
+	ServerIPAddress string      `json:"serverIPAddress,omitempty"`
+	// custom fields, prefixed with an underscore as required by the spec
+	Source         string  `json:"_source"`
+	Destination    string  `json:"_destination"`
+	ProxyDirection string  `json:"_proxyDirection"`
+	GrpcStatusCode *uint32 `json:"_grpcStatusCode,omitempty"`
+	Error          string  `json:"_error,omitempty"`
+}
+
+type harNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type harRequest struct {
+	Method      string         `json:"method"`
+	URL         string         `json:"url"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []harNameValue `json:"cookies"`
+	Headers     []harNameValue `json:"headers"`
+	QueryString []harNameValue `json:"queryString"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harResponse struct {
+	Status      uint32         `json:"status"`
+	StatusText  string         `json:"statusText"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []harNameValue `json:"cookies"`
+	Headers     []harNameValue `json:"headers"`
+	Content     harContent     `json:"content"`
+	RedirectURL string         `json:"redirectURL"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int64          `json:"bodySize"`
+}
+
+type harContent struct {
+	Size     int64  `json:"size"`
+	MimeType string `json:"mimeType"`
+}
+
+type harTimings struct {
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+}
+
+func newHARExporter(w io.Writer, clock func() time.Time, maxEntries int) *harExporter {
+	return &harExporter{
+		w:          w,
+		requests:   newTimedTapRequests(clock),
+		entries:    []*harEntry{},
+		maxEntries: maxEntries,
+	}
+}
+
+func (e *harExporter) add(event *tapPb.TapEvent) error {
+	if req, ok := e.requests.add(event); ok {
+		e.append(e.entry(req))
+	}
+	return nil
+}
+
+// append adds an entry to the document, dropping the oldest entry beyond
+// maxEntries
+func (e *harExporter) append(entry *harEntry) {
+	if e.maxEntries > 0 && len(e.entries) >= e.maxEntries {
+		e.entries = e.entries[1:]
+		e.dropped++
+	}
+	e.entries = append(e.entries, entry)
+}
+
+func (e *harExporter) close() error {
+	for _, req := range e.requests.pending() {
+		e.append(e.entry(req))
+	}
+	if e.dropped > 0 {
+		fmt.Fprintf(stderr, "The %d oldest requests were dropped from the HAR document, which is limited to %d requests by --max-entries\n", e.dropped, e.maxEntries)
+	}
+
+	doc := harDocument{
+		Log: harLog{
+			Version: "1.2",
+			Creator: harCreator{Name: tapExporterName, Version: version.Version},
+			Entries: e.entries,
+		},
+	}
+	enc := json.NewEncoder(e.w)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	return enc.Encode(doc)
+}
+
+func (e *harExporter) entry(req tapRequest) *harEntry {
+	sinceRequestInit := req.rspInit.GetSinceRequestInit().AsDuration()
+	total := req.rspEnd.GetSinceRequestInit().AsDuration()
+	if req.rspEnd == nil {
+		total = sinceRequestInit
+	}
+
+	entry := &harEntry{
+		StartedDateTime: e.requests.start(req).UTC().Format(time.RFC3339Nano),
+		Time:            durationMs(total),
+		Request: harRequest{
+			Method:      pkg.FormatMethod(req.reqInit.GetMethod()),
+			URL:         requestURL(req.reqInit),
+			Cookies:     []harNameValue{},
+			Headers:     harHeaders(req.reqInit.GetHeaders()),
+			QueryString: []harNameValue{},
+			HeadersSize: -1,
+			BodySize:    -1,
+		},
+		Response: harResponse{
+			Status:      req.rspInit.GetHttpStatus(),
+			StatusText:  http.StatusText(int(req.rspInit.GetHttpStatus())),
+			Cookies:     []harNameValue{},
+			Headers:     append(harHeaders(req.rspInit.GetHeaders()), harHeaders(req.rspEnd.GetTrailers())...),
+			HeadersSize: -1,
+			BodySize:    int64(req.rspEnd.GetResponseBytes()),
+			Content: harContent{
+				Size: int64(req.rspEnd.GetResponseBytes()),
+			},
+		},
+		Timings: harTimings{
+			Wait:    durationMs(sinceRequestInit),
+			Receive: durationMs(req.rspEnd.GetSinceResponseInit().AsDuration()),
+		},
+		ServerIPAddress: addr.PublicIPToString(req.event.GetDestination().GetIp()),
+		Source:          addr.PublicAddressToString(req.event.GetSource()),
+		Destination:     addr.PublicAddressToString(req.event.GetDestination()),
+		ProxyDirection:  req.event.GetProxyDirection().String(),
+	}
+
+	if u, err := url.Parse(req.reqInit.GetPath()); err == nil {
+		keys := []string{}
+		query := u.Query()
+		for key := range query {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			for _, value := range query[key] {
+				entry.Request.QueryString = append(entry.Request.QueryString, harNameValue{Name: key, Value: value})
+			}
+		}
+	}
+	for _, h := range entry.Response.Headers {
+		if strings.EqualFold(h.Name, "content-type") {
+			entry.Response.Content.MimeType = h.Value
+		}
+	}
+
+	switch eos := req.rspEnd.GetEos().GetEnd().(type) {
+	case *metricsPb.Eos_GrpcStatusCode:
+		entry.GrpcStatusCode = &eos.GrpcStatusCode
+	case *metricsPb.Eos_ResetErrorCode:
+		entry.Error = fmt.Sprintf("stream reset with error code %d", eos.ResetErrorCode)
+	}
+	if req.rspEnd == nil {
+		entry.Error = "the response didn't end"
+	}
+
+	return entry
+}
+
+func harHeaders(hs *metricsPb.Headers) []harNameValue {
+	headers := []harNameValue{}
+	for _, h := range hs.GetHeaders() {
+		headers = append(headers, harNameValue{Name: h.GetName(), Value: headerValue(h)})
+	}
+	return headers
+}
+
+// otlpExporter writes each request as an OpenTelemetry span once it ends, in
+// the OTLP JSON file format: one ExportTraceServiceRequest per line, see
+// https://github.com/open-telemetry/opentelemetry-proto/blob/main/docs/specification.md#json-protobuf-encoding
+type otlpExporter struct {
+	w        io.Writer
+	requests *timedTapRequests
+	resource otlpResource
+}
+
+type otlpExport struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpAttribute `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope   `json:"scope"`
+	Spans []*otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type otlpSpan struct {
+	TraceID           string          `json:"traceId"`
+	SpanID            string          `json:"spanId"`
+	ParentSpanID      string          `json:"parentSpanId,omitempty"`
+	Name              string          `json:"name"`
+	Kind              int             `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []otlpAttribute `json:"attributes"`
+	Status            otlpStatus      `json:"status"`
+}
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue *string `json:"stringValue,omitempty"`
+	// 64-bit integers are encoded as strings
+	IntValue *string `json:"intValue,omitempty"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// span kinds and status codes of OpenTelemetry
+const (
+	otlpSpanKindUnspecified = 0
+	otlpSpanKindServer      = 2
+	otlpSpanKindClient      = 3
+	otlpStatusCodeError     = 2
+)
+
+func newOTLPExporter(w io.Writer, req *tapPb.TapByResourceRequest, clock func() time.Time) *otlpExporter {
+	target := req.GetTarget().GetResource()
+	return &otlpExporter{
+		w:        w,
+		requests: newTimedTapRequests(clock),
+		resource: otlpResource{
+			Attributes: []otlpAttribute{
+				stringAttribute("service.name", "linkerd-tap"),
+				stringAttribute("linkerd.tap.target", fmt.Sprintf("%s/%s", target.GetType(), target.GetName())),
+				stringAttribute("linkerd.tap.namespace", target.GetNamespace()),
+			},
+		},
+	}
+}
+
+func (e *otlpExporter) add(event *tapPb.TapEvent) error {
+	if req, ok := e.requests.add(event); ok {
+		return e.export(req)
+	}
+	return nil
+}
+
+func (e *otlpExporter) close() error {
+	for _, req := range e.requests.pending() {
+		if err := e.export(req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *otlpExporter) export(req tapRequest) error {
+	export := otlpExport{
+		ResourceSpans: []otlpResourceSpans{
+			{
+				Resource: e.resource,
+				ScopeSpans: []otlpScopeSpans{
+					{
+						Scope: otlpScope{Name: tapExporterName, Version: version.Version},
+						Spans: []*otlpSpan{e.span(req)},
+					},
+				},
+			},
+		},
+	}
+	enc := json.NewEncoder(e.w)
+	enc.SetEscapeHTML(false)
+	return enc.Encode(export)
+}
+
+func (e *otlpExporter) span(req tapRequest) *otlpSpan {
+	src := addr.PublicAddressToString(req.event.GetSource())
+	dst := addr.PublicAddressToString(req.event.GetDestination())
+
+	// the IDs are derived from the stream of the request, unless the request
+	// is part of a trace
+	id := sha256.Sum256([]byte(fmt.Sprintf("%s->%s/%d/%d", src, dst, req.reqInit.GetId().GetBase(), req.reqInit.GetId().GetStream())))
+	span := &otlpSpan{
+		TraceID: hex.EncodeToString(id[:16]),
+		SpanID:  hex.EncodeToString(id[16:24]),
+	}
+	for _, h := range req.reqInit.GetHeaders().GetHeaders() {
+		if strings.EqualFold(h.GetName(), "traceparent") {
+			// version-traceid-parentid-flags
+			parts := strings.Split(headerValue(h), "-")
+			if len(parts) == 4 && len(parts[1]) == 32 && len(parts[2]) == 16 {
+				span.TraceID = parts[1]
+				span.ParentSpanID = parts[2]
+			}
+		}
+	}
+
+	method := pkg.FormatMethod(req.reqInit.GetMethod())
+	path := req.reqInit.GetPath()
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+	span.Name = fmt.Sprintf("%s %s", method, path)
+
+	switch req.event.GetProxyDirection() {
+	case tapPb.TapEvent_INBOUND:
+		span.Kind = otlpSpanKindServer
+	case tapPb.TapEvent_OUTBOUND:
+		span.Kind = otlpSpanKindClient
+	default:
+		span.Kind = otlpSpanKindUnspecified
+	}
+
+	start := e.requests.start(req)
+	end := start.Add(req.rspInit.GetSinceRequestInit().AsDuration())
+	if req.rspEnd != nil {
+		end = start.Add(req.rspEnd.GetSinceRequestInit().AsDuration())
+	}
+	span.StartTimeUnixNano = strconv.FormatInt(start.UnixNano(), 10)
+	span.EndTimeUnixNano = strconv.FormatInt(end.UnixNano(), 10)
+
+	span.Attributes = []otlpAttribute{
+		stringAttribute("http.method", method),
+		stringAttribute("http.url", requestURL(req.reqInit)),
+		stringAttribute("http.host", req.reqInit.GetAuthority()),
+		stringAttribute("http.target", req.reqInit.GetPath()),
+	}
+	if req.rspInit != nil {
+		span.Attributes = append(span.Attributes, intAttribute("http.status_code", int64(req.rspInit.GetHttpStatus())))
+	}
+	if req.rspEnd != nil {
+		span.Attributes = append(span.Attributes, intAttribute("http.response_content_length", int64(req.rspEnd.GetResponseBytes())))
+	}
+	if eos, ok := req.rspEnd.GetEos().GetEnd().(*metricsPb.Eos_GrpcStatusCode); ok {
+		span.Attributes = append(span.Attributes, intAttribute("rpc.grpc.status_code", int64(eos.GrpcStatusCode)))
+	}
+	span.Attributes = append(span.Attributes,
+		stringAttribute("linkerd.src.addr", src),
+		stringAttribute("linkerd.dst.addr", dst),
+	)
+	span.Attributes = append(span.Attributes, labelAttributes("linkerd.src.", req.event.GetSourceMeta().GetLabels())...)
+	span.Attributes = append(span.Attributes, labelAttributes("linkerd.dst.", req.event.GetDestinationMeta().GetLabels())...)
+	span.Attributes = append(span.Attributes, labelAttributes("linkerd.route.", req.event.GetRouteMeta().GetLabels())...)
+
+	switch {
+	case req.rspEnd == nil:
+		span.Status = otlpStatus{Code: otlpStatusCodeError, Message: "the response didn't end"}
+	case !req.succeeded():
+		span.Status = otlpStatus{Code: otlpStatusCodeError}
+	}
+
+	return span
+}
+
+func stringAttribute(key, value string) otlpAttribute {
+	return otlpAttribute{Key: key, Value: otlpValue{StringValue: &value}}
+}
+
+func intAttribute(key string, value int64) otlpAttribute {
+	s := strconv.FormatInt(value, 10)
+	return otlpAttribute{Key: key, Value: otlpValue{IntValue: &s}}
+}
+
+// labelAttributes returns the labels as attributes sorted by key
+func labelAttributes(prefix string, labels map[string]string) []otlpAttribute {
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	attributes := make([]otlpAttribute, 0, len(keys))
+	for _, key := range keys {
+		attributes = append(attributes, stringAttribute(prefix+key, labels[key]))
+	}
+	return attributes
+}
@@ -0,0 +1,159 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	metricsPb "github.com/linkerd/linkerd2/viz/metrics-api/gen/viz"
+	tapPb "github.com/linkerd/linkerd2/viz/tap/gen/tap"
+	"github.com/linkerd/linkerd2/viz/tap/pkg"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func exportRequestInit(stream uint64, method metricsPb.HttpMethod_Registered, path string, headers ...*metricsPb.Headers_Header) *tapPb.TapEvent {
+	return pkg.CreateTapEvent(&tapPb.TapEvent_Http{
+		Event: &tapPb.TapEvent_Http_RequestInit_{
+			RequestInit: &tapPb.TapEvent_Http_RequestInit{
+				Id: &tapPb.TapEvent_Http_StreamId{Base: 1, Stream: stream},
+				Method: &metricsPb.HttpMethod{
+					Type: &metricsPb.HttpMethod_Registered_{Registered: method},
+				},
+				Scheme: &metricsPb.Scheme{
+					Type: &metricsPb.Scheme_Registered_{Registered: metricsPb.Scheme_HTTP},
+				},
+				Authority: "web.emojivoto.svc.cluster.local:8080",
+				Path:      path,
+				Headers:   &metricsPb.Headers{Headers: headers},
+			},
+		},
+	}, map[string]string{"deployment": "web", "namespace": "emojivoto"}, tapPb.TapEvent_OUTBOUND)
+}
+
+func exportResponseInit(stream uint64, status uint32, latency time.Duration) *tapPb.TapEvent {
+	return pkg.CreateTapEvent(&tapPb.TapEvent_Http{
+		Event: &tapPb.TapEvent_Http_ResponseInit_{
+			ResponseInit: &tapPb.TapEvent_Http_ResponseInit{
+				Id:               &tapPb.TapEvent_Http_StreamId{Base: 1, Stream: stream},
+				SinceRequestInit: durationpb.New(latency),
+				HttpStatus:       status,
+				Headers: &metricsPb.Headers{
+					Headers: []*metricsPb.Headers_Header{
+						{
+							Name:  "content-type",
+							Value: &metricsPb.Headers_Header_ValueStr{ValueStr: "application/grpc"},
+						},
+					},
+				},
+			},
+		},
+	}, map[string]string{"deployment": "web", "namespace": "emojivoto"}, tapPb.TapEvent_OUTBOUND)
+}
+
+func exportResponseEnd(stream uint64, grpcStatus uint32, latency time.Duration, bytes uint64) *tapPb.TapEvent {
+	return pkg.CreateTapEvent(&tapPb.TapEvent_Http{
+		Event: &tapPb.TapEvent_Http_ResponseEnd_{
+			ResponseEnd: &tapPb.TapEvent_Http_ResponseEnd{
+				Id:                &tapPb.TapEvent_Http_StreamId{Base: 1, Stream: stream},
+				SinceRequestInit:  durationpb.New(latency),
+				SinceResponseInit: durationpb.New(latency / 4),
+				ResponseBytes:     bytes,
+				Eos: &metricsPb.Eos{
+					End: &metricsPb.Eos_GrpcStatusCode{GrpcStatusCode: grpcStatus},
+				},
+			},
+		},
+	}, map[string]string{"deployment": "web", "namespace": "emojivoto"}, tapPb.TapEvent_OUTBOUND)
+}
+
+func TestTapExport(t *testing.T) {
+	req, err := pkg.BuildTapByResourceRequest(pkg.TapRequestParams{
+		Resource:  "deploy/vote-bot",
+		Namespace: "emojivoto",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	events := []*tapPb.TapEvent{
+		exportRequestInit(1, metricsPb.HttpMethod_POST, "/emojivoto.v1.VotingService/VoteDoughnut",
+			&metricsPb.Headers_Header{
+				Name:  "traceparent",
+				Value: &metricsPb.Headers_Header_ValueStr{ValueStr: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"},
+			},
+			&metricsPb.Headers_Header{
+				Name:  "grpc-trace-bin",
+				Value: &metricsPb.Headers_Header_ValueBin{ValueBin: []byte{0, 0xff, 0x10}},
+			},
+		),
+		exportRequestInit(2, metricsPb.HttpMethod_GET, "/api/list?sort=name&page=2"),
+		exportResponseInit(1, 200, 2*time.Millisecond),
+		exportResponseEnd(1, 2, 8*time.Millisecond, 1337),
+		exportRequestInit(3, metricsPb.HttpMethod_GET, "/api/vote?choice=:doughnut:"),
+		exportResponseInit(3, 503, time.Millisecond),
+		exportResponseEnd(3, 0, 3*time.Millisecond, 42),
+	}
+	// the events are recorded a millisecond apart, so that the requests are
+	// dated when their events were received
+	recording := filepath.Join(t.TempDir(), "web.tap")
+	f, err := os.Create(recording)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	recorder, err := pkg.NewRecordingWriter(f, req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	start := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	for i, event := range events {
+		if err := recorder.Write(event, start.Add(time.Duration(i)*time.Millisecond)); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	}
+	f.Close()
+
+	t.Run("Writes the requests as a HAR document", func(t *testing.T) {
+		options := newTapOptions()
+		options.output = harOutput
+		var output bytes.Buffer
+		if err := replayTapRecording(recording, &output, options); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		testDataDiffer.DiffTestdata(t, "tap_har_output.golden", output.String())
+	})
+
+	t.Run("Keeps the most recent requests beyond --max-entries", func(t *testing.T) {
+		options := newTapOptions()
+		options.output = harOutput
+		options.maxEntries = 1
+		var output bytes.Buffer
+		if err := replayTapRecording(recording, &output, options); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		var doc harDocument
+		if err := json.Unmarshal(output.Bytes(), &doc); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if len(doc.Log.Entries) != 1 || doc.Log.Entries[0].Request.URL != "http://web.emojivoto.svc.cluster.local:8080/api/list?sort=name&page=2" {
+			t.Fatalf("Expected only the last request, got %+v", doc.Log.Entries)
+		}
+	})
+
+	t.Run("Writes the requests as OTLP spans", func(t *testing.T) {
+		options := newTapOptions()
+		options.output = jsonOutput
+		options.otlpFile = filepath.Join(t.TempDir(), "spans.json")
+		if err := replayTapRecording(recording, &bytes.Buffer{}, options); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		spans, err := ioutil.ReadFile(options.otlpFile)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		testDataDiffer.DiffTestdata(t, "tap_otlp_output.golden", string(spans))
+	})
+}
@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	tapPb "github.com/linkerd/linkerd2/viz/tap/gen/tap"
+	"github.com/linkerd/linkerd2/viz/tap/pkg"
+	"github.com/spf13/cobra"
+)
+
+// newCmdTapReplay creates a new cobra command `replay` for replaying tap
+// recordings, sharing the flags of the `tap` command
+func newCmdTapReplay(options *tapOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay [flags] FILE",
+		Short: "Replay the events of a tap recording",
+		Long: `Replay the events of a tap recording.
+
+  The events recorded with "linkerd viz tap --record" are rendered like the
+  events of a live tap, and can be filtered with the same flags. The
+  "--to" resource is looked up in the namespace of the recorded target,
+  unless "--to-namespace" or "--namespace" is set.`,
+		Example: `  # replay the events recorded to web.tap
+  linkerd viz tap replay web.tap
+
+  # replay the failed GET requests recorded to web.tap, in JSON
+  linkerd viz tap replay web.tap --method GET --status 5xx -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := options.validate()
+			if err != nil {
+				return fmt.Errorf("validation error when executing tap replay command: %v", err)
+			}
+
+			return replayTapRecording(args[0], os.Stdout, options)
+		},
+	}
+
+	return cmd
+}
+
+func replayTapRecording(path string, w io.Writer, options *tapOptions) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	metadata, reader, err := pkg.OpenRecording(f)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %s", path, err)
+	}
+
+	filter, err := newReplayFilter(metadata, pkg.TapRequestParams{
+		Namespace:   options.namespace,
+		ToResource:  options.toResource,
+		ToNamespace: options.toNamespace,
+		Scheme:      options.scheme,
+		Method:      options.method,
+		Authority:   options.authority,
+		Path:        options.path,
+		Status:      options.status,
+		MinLatency:  options.minLatency,
+		Headers:     options.headers,
+	})
+	if err != nil {
+		return err
+	}
+
+	src := &tapEventSource{
+		read:   reader.Read,
+		req:    metadata.GetRequest(),
+		filter: filter,
+	}
+	return writeTapEventsToBuffer(w, src, nil, options)
+}
+
+// newReplayFilter builds the filter of the events of a recording from the
+// matching parameters, looking the destination up in the namespace of the
+// recorded target by default
+func newReplayFilter(metadata *tapPb.TapRecordingMetadata, params pkg.TapRequestParams) (*pkg.EventFilter, error) {
+	if params.ToNamespace == "" {
+		params.ToNamespace = params.Namespace
+	}
+	if params.ToNamespace == "" {
+		target := metadata.GetRequest().GetTarget().GetResource()
+		params.ToNamespace = target.GetNamespace()
+		if target.GetType() == k8s.Namespace {
+			params.ToNamespace = target.GetName()
+		}
+	}
+
+	match, err := pkg.BuildTapMatch(params)
+	if err != nil {
+		return nil, err
+	}
+	return pkg.NewReplayFilter(match)
+}
@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	metricsPb "github.com/linkerd/linkerd2/viz/metrics-api/gen/viz"
+	tapPb "github.com/linkerd/linkerd2/viz/tap/gen/tap"
+	"github.com/linkerd/linkerd2/viz/tap/pkg"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+)
+
+// tapRequest is a request observed by tap, along with its response
+type tapRequest struct {
+	event   *tapPb.TapEvent
+	reqInit *tapPb.TapEvent_Http_RequestInit
+	rspInit *tapPb.TapEvent_Http_ResponseInit
+	rspEnd  *tapPb.TapEvent_Http_ResponseEnd
+}
+
+// tapRequests pairs the events of the requests observed by tap
+type tapRequests struct {
+	outstanding map[pkg.StreamID]tapRequest
+	// the order of the outstanding requests
+	order []pkg.StreamID
+}
+
+func newTapRequests() *tapRequests {
+	return &tapRequests{outstanding: make(map[pkg.StreamID]tapRequest)}
+}
+
+// add adds the event to its request, and returns the request once it ended
+func (r *tapRequests) add(event *tapPb.TapEvent) (tapRequest, bool) {
+	id, _ := pkg.EventStreamID(event)
+	switch ev := event.GetHttp().GetEvent().(type) {
+	case *tapPb.TapEvent_Http_RequestInit_:
+		if _, ok := r.outstanding[id]; !ok {
+			r.order = append(r.order, id)
+		}
+		r.outstanding[id] = tapRequest{
+			event:   event,
+			reqInit: ev.RequestInit,
+		}
+
+	case *tapPb.TapEvent_Http_ResponseInit_:
+		if req, ok := r.outstanding[id]; ok {
+			req.rspInit = ev.ResponseInit
+			r.outstanding[id] = req
+		} else {
+			log.Warnf("Got ResponseInit for unknown stream: %s", id)
+		}
+
+	case *tapPb.TapEvent_Http_ResponseEnd_:
+		if req, ok := r.outstanding[id]; ok {
+			req.rspEnd = ev.ResponseEnd
+			delete(r.outstanding, id)
+			return req, true
+		}
+		log.Warnf("Got ResponseEnd for unknown stream: %s", id)
+	}
+
+	// drop the order of the requests that ended once they're the majority
+	if len(r.order) > 2*len(r.outstanding)+100 {
+		order := make([]pkg.StreamID, 0, len(r.outstanding))
+		for _, id := range r.order {
+			if _, ok := r.outstanding[id]; ok {
+				order = append(order, id)
+			}
+		}
+		r.order = order
+	}
+
+	return tapRequest{}, false
+}
+
+// pending returns the requests that haven't ended, in the order they started
+func (r *tapRequests) pending() []tapRequest {
+	requests := []tapRequest{}
+	for _, id := range r.order {
+		if req, ok := r.outstanding[id]; ok {
+			requests = append(requests, req)
+			delete(r.outstanding, id)
+		}
+	}
+	r.order = nil
+	return requests
+}
+
+// succeeded returns true if the request succeeded
+func (req tapRequest) succeeded() bool {
+	// TODO: Once tap events have a classification field, we should use that field
+	// instead of determining success here.
+	success := req.rspInit.GetHttpStatus() < 500
+	if success {
+		switch eos := req.rspEnd.GetEos().GetEnd().(type) {
+		case *metricsPb.Eos_GrpcStatusCode:
+			switch codes.Code(eos.GrpcStatusCode) {
+			case codes.Unknown,
+				codes.DeadlineExceeded,
+				codes.Internal,
+				codes.Unavailable,
+				codes.DataLoss:
+				success = false
+			default:
+				success = true
+			}
+
+		case *metricsPb.Eos_ResetErrorCode:
+			success = false
+		}
+	}
+	return success
+}
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"testing"
+
+	tapPb "github.com/linkerd/linkerd2/viz/tap/gen/tap"
+	"github.com/linkerd/linkerd2/viz/tap/pkg"
+)
+
+func TestTapRequestsPairsEventsByConnection(t *testing.T) {
+	streamID := func(base uint32) *tapPb.TapEvent_Http_StreamId {
+		return &tapPb.TapEvent_Http_StreamId{Base: base, Stream: 1}
+	}
+	reqInit := func(base uint32) *tapPb.TapEvent {
+		return pkg.CreateTapEvent(&tapPb.TapEvent_Http{
+			Event: &tapPb.TapEvent_Http_RequestInit_{
+				RequestInit: &tapPb.TapEvent_Http_RequestInit{Id: streamID(base)},
+			},
+		}, nil, tapPb.TapEvent_OUTBOUND)
+	}
+	rspEnd := func(base uint32) *tapPb.TapEvent {
+		return pkg.CreateTapEvent(&tapPb.TapEvent_Http{
+			Event: &tapPb.TapEvent_Http_ResponseEnd_{
+				ResponseEnd: &tapPb.TapEvent_Http_ResponseEnd{Id: streamID(base)},
+			},
+		}, nil, tapPb.TapEvent_OUTBOUND)
+	}
+
+	// two connections between the same addresses have streams with the same
+	// number
+	requests := newTapRequests()
+	first, second := reqInit(1), reqInit(2)
+	requests.add(first)
+	requests.add(second)
+
+	req, ended := requests.add(rspEnd(2))
+	if !ended || req.event != second {
+		t.Fatalf("Expected the request of the second connection to end, got %+v", req)
+	}
+	pending := requests.pending()
+	if len(pending) != 1 || pending[0].event != first {
+		t.Fatalf("Expected the request of the first connection to be pending, got %+v", pending)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"testing"
 
 	"github.com/golang/protobuf/ptypes/duration"
@@ -132,6 +133,7 @@ func busyTest(t *testing.T, output string) {
 
 	options := newTapOptions()
 	options.output = output
+	options.record = filepath.Join(t.TempDir(), "busy.tap")
 
 	writer := bytes.NewBufferString("")
 	err = requestTapByResourceFromAPI(context.Background(), writer, kubeAPI, req, options)
@@ -158,6 +160,37 @@ func busyTest(t *testing.T, output string) {
 	if expectedContent != actual {
 		t.Fatalf("Expected function to render:\n%s\bbut got:\n%s", expectedContent, actual)
 	}
+
+	// the recorded events are replayed the same way, including when they
+	// match the filters
+	options.method = "GET"
+	options.path = "/some"
+	options.toResource = "pod/my-pod"
+	writer = bytes.NewBufferString("")
+	err = replayTapRecording(options.record, writer, options)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	actual = writer.String()
+	if expectedContent != actual {
+		t.Fatalf("Expected the replay to render:\n%s\bbut got:\n%s", expectedContent, actual)
+	}
+
+	for _, filtered := range []*tapOptions{
+		{method: "POST"},
+		{path: "/other/path"},
+		{status: "2xx"},
+		{toResource: "deploy/other"},
+	} {
+		writer = bytes.NewBufferString("")
+		err = replayTapRecording(options.record, writer, filtered)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if writer.String() != "" {
+			t.Fatalf("Expected the replay with options %+v to render nothing, got:\n%s", filtered, writer.String())
+		}
+	}
 }
 
 func TestRequestTapByResourceFromAPI(t *testing.T) {
@@ -1,10 +1,11 @@
 package cmd
 
 import (
-	"bufio"
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"os"
 	"sort"
 	"strconv"
 	"strings"
@@ -15,9 +16,7 @@ import (
 	pkgcmd "github.com/linkerd/linkerd2/pkg/cmd"
 	"github.com/linkerd/linkerd2/pkg/healthcheck"
 	"github.com/linkerd/linkerd2/pkg/k8s"
-	"github.com/linkerd/linkerd2/pkg/protohttp"
 	metricsAPI "github.com/linkerd/linkerd2/viz/metrics-api"
-	metricsPb "github.com/linkerd/linkerd2/viz/metrics-api/gen/viz"
 	"github.com/linkerd/linkerd2/viz/pkg/api"
 	tapPb "github.com/linkerd/linkerd2/viz/tap/gen/tap"
 	"github.com/linkerd/linkerd2/viz/tap/pkg"
@@ -25,7 +24,6 @@ import (
 	termbox "github.com/nsf/termbox-go"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
-	"google.golang.org/grpc/codes"
 )
 
 type topOptions struct {
@@ -37,26 +35,13 @@ type topOptions struct {
 	method        string
 	authority     string
 	path          string
+	status        string
+	minLatency    time.Duration
+	headers       []string
 	hideSources   bool
 	routes        bool
 	labelSelector string
-}
-
-type topRequest struct {
-	event   *tapPb.TapEvent
-	reqInit *tapPb.TapEvent_Http_RequestInit
-	rspInit *tapPb.TapEvent_Http_ResponseInit
-	rspEnd  *tapPb.TapEvent_Http_ResponseEnd
-}
-
-type topRequestID struct {
-	src    string
-	dst    string
-	stream uint64
-}
-
-func (id topRequestID) String() string {
-	return fmt.Sprintf("%s->%s(%d)", id.src, id.dst, id.stream)
+	fromFile      string
 }
 
 type tableColumn struct {
@@ -270,9 +255,13 @@ func newTopOptions() *topOptions {
 		method:        "",
 		authority:     "",
 		path:          "",
+		status:        "",
+		minLatency:    0,
+		headers:       []string{},
 		hideSources:   false,
 		routes:        false,
 		labelSelector: "",
+		fromFile:      "",
 	}
 }
 
@@ -318,8 +307,14 @@ func NewCmdTop() *cobra.Command {
   linkerd viz top deploy/web
 
   # display traffic for the web-dlbvj pod in the default namespace
-  linkerd viz top pod/web-dlbvj`,
-		Args: cobra.RangeArgs(1, 2),
+  linkerd viz top pod/web-dlbvj
+
+  # display the traffic of the web deployment with an x-canary header
+  linkerd viz top deploy/web --header x-canary=true
+
+  # display the traffic recorded with "linkerd viz tap --record" to web.tap
+  linkerd viz top --from-file web.tap`,
+		Args: cobra.RangeArgs(0, 2),
 		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 			// This command requires at most two arguments if we already have
 			// two after requesting autocompletion i.e. [tab][tab]
@@ -347,22 +342,8 @@ func NewCmdTop() *cobra.Command {
 			return results, cobra.ShellCompDirectiveDefault
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if options.namespace == "" {
-				options.namespace = pkgcmd.GetDefaultNamespace(kubeconfigPath, kubeContext)
-			}
-
-			api.CheckClientOrExit(healthcheck.Options{
-				ControlPlaneNamespace: controlPlaneNamespace,
-				KubeConfig:            kubeconfigPath,
-				Impersonate:           impersonate,
-				ImpersonateGroup:      impersonateGroup,
-				KubeContext:           kubeContext,
-				APIAddr:               apiAddr,
-			})
-
 			requestParams := pkg.TapRequestParams{
 				Resource:      strings.Join(args, "/"),
-				Namespace:     options.namespace,
 				ToResource:    options.toResource,
 				ToNamespace:   options.toNamespace,
 				MaxRps:        options.maxRps,
@@ -370,6 +351,9 @@ func NewCmdTop() *cobra.Command {
 				Method:        options.method,
 				Authority:     options.authority,
 				Path:          options.path,
+				Status:        options.status,
+				MinLatency:    options.minLatency,
+				Headers:       options.headers,
 				LabelSelector: options.labelSelector,
 			}
 
@@ -387,6 +371,32 @@ func NewCmdTop() *cobra.Command {
 				table.columns[routeColumn].display = true
 			}
 
+			if options.fromFile != "" {
+				if len(args) > 0 {
+					return errors.New("a resource can't be given with --from-file")
+				}
+				requestParams.Namespace = options.namespace
+				return getTrafficFromFile(options.fromFile, requestParams, table)
+			}
+			if len(args) == 0 {
+				return errors.New("a resource is required, unless --from-file is given")
+			}
+
+			if options.namespace == "" {
+				options.namespace = pkgcmd.GetDefaultNamespace(kubeconfigPath, kubeContext)
+			}
+
+			requestParams.Namespace = options.namespace
+
+			api.CheckClientOrExit(healthcheck.Options{
+				ControlPlaneNamespace: controlPlaneNamespace,
+				KubeConfig:            kubeconfigPath,
+				Impersonate:           impersonate,
+				ImpersonateGroup:      impersonateGroup,
+				KubeContext:           kubeContext,
+				APIAddr:               apiAddr,
+			})
+
 			req, err := pkg.BuildTapByResourceRequest(requestParams)
 			if err != nil {
 				return err
@@ -417,9 +427,16 @@ func NewCmdTop() *cobra.Command {
 		"Display requests with this :authority")
 	cmd.PersistentFlags().StringVar(&options.path, "path", options.path,
 		"Display requests with paths that start with this prefix")
+	cmd.PersistentFlags().StringVar(&options.status, "status", options.status,
+		"Display requests with a response status in this range; one of a status (503), a class (5xx) or a range (500-504)")
+	cmd.PersistentFlags().DurationVar(&options.minLatency, "min-latency", options.minLatency,
+		"Display requests whose response took at least this long (e.g. 100ms)")
+	cmd.PersistentFlags().StringArrayVar(&options.headers, "header", options.headers,
+		"Display requests with this header, as NAME=VALUE or NAME to match any value; may be repeated")
 	cmd.PersistentFlags().BoolVar(&options.hideSources, "hide-sources", options.hideSources, "Hide the source column")
 	cmd.PersistentFlags().BoolVar(&options.routes, "routes", options.routes, "Display data per route instead of per path")
 	cmd.PersistentFlags().StringVarP(&options.labelSelector, "selector", "l", options.labelSelector, "Selector (label query) to filter on, supports '=', '==', and '!='")
+	cmd.PersistentFlags().StringVar(&options.fromFile, "from-file", options.fromFile, "Display the traffic recorded to this file with \"linkerd viz tap --record\" instead of live traffic")
 
 	pkgcmd.ConfigureNamespaceFlagCompletion(
 		cmd, []string{"namespace", "to-namespace"},
@@ -434,7 +451,33 @@ func getTrafficByResourceFromAPI(ctx context.Context, k8sAPI *k8s.KubernetesAPI,
 	}
 	defer body.Close()
 
-	err = termbox.Init()
+	return renderTraffic(readLiveTapEvent(reader), nil, table)
+}
+
+func getTrafficFromFile(path string, params pkg.TapRequestParams, table *topTable) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	metadata, reader, err := pkg.OpenRecording(f)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %s", path, err)
+	}
+
+	filter, err := newReplayFilter(metadata, params)
+	if err != nil {
+		return err
+	}
+
+	return renderTraffic(reader.Read, filter, table)
+}
+
+// renderTraffic renders the traffic of the events of a tap stream or
+// recording that pass the filter
+func renderTraffic(read func() (*tapPb.TapEvent, time.Time, error), filter *pkg.EventFilter, table *topTable) error {
+	err := termbox.Init()
 	if err != nil {
 		return err
 	}
@@ -448,7 +491,7 @@ func getTrafficByResourceFromAPI(ctx context.Context, k8sAPI *k8s.KubernetesAPI,
 	//         requestCh ->
 	//           renderTable()
 	eventCh := make(chan *tapPb.TapEvent)
-	requestCh := make(chan topRequest, 100)
+	requestCh := make(chan tapRequest, 100)
 
 	// for closing:
 	// recvEvents() || pollInput() ->
@@ -460,7 +503,7 @@ func getTrafficByResourceFromAPI(ctx context.Context, k8sAPI *k8s.KubernetesAPI,
 	horizontalScroll := make(chan int)
 
 	go pollInput(done, horizontalScroll)
-	go recvEvents(reader, eventCh, closing)
+	go recvEvents(read, filter, eventCh, closing)
 	go processEvents(eventCh, requestCh, done)
 
 	go func() {
@@ -472,12 +515,11 @@ func getTrafficByResourceFromAPI(ctx context.Context, k8sAPI *k8s.KubernetesAPI,
 	return nil
 }
 
-func recvEvents(tapByteStream *bufio.Reader, eventCh chan<- *tapPb.TapEvent, closing chan<- struct{}) {
+func recvEvents(read func() (*tapPb.TapEvent, time.Time, error), filter *pkg.EventFilter, eventCh chan<- *tapPb.TapEvent, closing chan<- struct{}) {
 	for {
-		event := &tapPb.TapEvent{}
-		err := protohttp.FromByteStreamToProtocolBuffers(tapByteStream, event)
+		event, _, err := read()
 		if err != nil {
-			if err == io.EOF {
+			if errors.Is(err, io.EOF) {
 				fmt.Println("Tap stream terminated")
 			} else if !strings.HasSuffix(err.Error(), pkg.ErrClosedResponseBody) {
 				fmt.Println(err.Error())
@@ -487,47 +529,22 @@ func recvEvents(tapByteStream *bufio.Reader, eventCh chan<- *tapPb.TapEvent, clo
 			return
 		}
 
-		eventCh <- event
+		for _, event := range filter.Filter(event) {
+			eventCh <- event
+		}
 	}
 }
 
-func processEvents(eventCh <-chan *tapPb.TapEvent, requestCh chan<- topRequest, done <-chan struct{}) {
-	outstandingRequests := make(map[topRequestID]topRequest)
+func processEvents(eventCh <-chan *tapPb.TapEvent, requestCh chan<- tapRequest, done <-chan struct{}) {
+	requests := newTapRequests()
 
 	for {
 		select {
 		case <-done:
 			return
 		case event := <-eventCh:
-			id := topRequestID{
-				src: addr.PublicAddressToString(event.GetSource()),
-				dst: addr.PublicAddressToString(event.GetDestination()),
-			}
-			switch ev := event.GetHttp().GetEvent().(type) {
-			case *tapPb.TapEvent_Http_RequestInit_:
-				id.stream = ev.RequestInit.GetId().Stream
-				outstandingRequests[id] = topRequest{
-					event:   event,
-					reqInit: ev.RequestInit,
-				}
-
-			case *tapPb.TapEvent_Http_ResponseInit_:
-				id.stream = ev.ResponseInit.GetId().Stream
-				if req, ok := outstandingRequests[id]; ok {
-					req.rspInit = ev.ResponseInit
-					outstandingRequests[id] = req
-				} else {
-					log.Warnf("Got ResponseInit for unknown stream: %s", id)
-				}
-
-			case *tapPb.TapEvent_Http_ResponseEnd_:
-				id.stream = ev.ResponseEnd.GetId().Stream
-				if req, ok := outstandingRequests[id]; ok {
-					req.rspEnd = ev.ResponseEnd
-					requestCh <- req
-				} else {
-					log.Warnf("Got ResponseEnd for unknown stream: %s", id)
-				}
+			if req, ok := requests.add(event); ok {
+				requestCh <- req
 			}
 		}
 	}
@@ -551,7 +568,7 @@ func pollInput(done chan<- struct{}, horizontalScroll chan int) {
 	}
 }
 
-func renderTable(table *topTable, requestCh <-chan topRequest, done <-chan struct{}, horizontalScroll chan int) {
+func renderTable(table *topTable, requestCh <-chan tapRequest, done <-chan struct{}, horizontalScroll chan int) {
 	scrollpos := 0
 	ticker := time.NewTicker(100 * time.Millisecond)
 	width, _ := termbox.Size()
@@ -579,7 +596,7 @@ func renderTable(table *topTable, requestCh <-chan topRequest, done <-chan struc
 	}
 }
 
-func newRow(req topRequest) (tableRow, error) {
+func newRow(req tapRequest) (tableRow, error) {
 	path := req.reqInit.GetPath()
 	route := req.event.GetRouteMeta().GetLabels()["route"]
 	if route == "" {
@@ -599,27 +616,7 @@ func newRow(req topRequest) (tableRow, error) {
 	if err != nil {
 		return tableRow{}, fmt.Errorf("error parsing duration %v: %s", req.rspEnd.GetSinceRequestInit(), err)
 	}
-	// TODO: Once tap events have a classification field, we should use that field
-	// instead of determining success here.
-	success := req.rspInit.GetHttpStatus() < 500
-	if success {
-		switch eos := req.rspEnd.GetEos().GetEnd().(type) {
-		case *metricsPb.Eos_GrpcStatusCode:
-			switch codes.Code(eos.GrpcStatusCode) {
-			case codes.Unknown,
-				codes.DeadlineExceeded,
-				codes.Internal,
-				codes.Unavailable,
-				codes.DataLoss:
-				success = false
-			default:
-				success = true
-			}
-
-		case *metricsPb.Eos_ResetErrorCode:
-			success = false
-		}
-	}
+	success := req.succeeded()
 
 	successes := 0
 	failures := 0
@@ -644,7 +641,7 @@ func newRow(req topRequest) (tableRow, error) {
 	}, nil
 }
 
-func (t *topTable) insert(req topRequest) {
+func (t *topTable) insert(req tapRequest) {
 	insert, err := newRow(req)
 	if err != nil {
 		log.Error(err.Error())
@@ -79,6 +79,12 @@ func (c *grpcOverHTTPClient) ListServices(ctx context.Context, req *pb.ListServi
 	return &msg, err
 }
 
+func (c *grpcOverHTTPClient) ServiceLevelObjectives(ctx context.Context, req *pb.ServiceLevelObjectivesRequest, _ ...grpc.CallOption) (*pb.ServiceLevelObjectivesResponse, error) {
+	var msg pb.ServiceLevelObjectivesResponse
+	err := c.apiRequest(ctx, "ServiceLevelObjectives", req, &msg)
+	return &msg, err
+}
+
 func (c *grpcOverHTTPClient) apiRequest(ctx context.Context, endpoint string, req proto.Message, protoResponse proto.Message) error {
 	url := c.endpointNameToPublicAPIURL(endpoint)
 
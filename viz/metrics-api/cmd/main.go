@@ -11,10 +11,12 @@ import (
 	"github.com/linkerd/linkerd2/controller/k8s"
 	"github.com/linkerd/linkerd2/pkg/admin"
 	"github.com/linkerd/linkerd2/pkg/flags"
+	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
 	"github.com/linkerd/linkerd2/pkg/trace"
 	api "github.com/linkerd/linkerd2/viz/metrics-api"
 	promApi "github.com/prometheus/client_golang/api"
 	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/dynamic"
 )
 
 func main() {
@@ -36,9 +38,14 @@ func main() {
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
 
-	k8sAPI, err := k8s.InitializeAPI(
+	config, err := pkgK8s.GetConfig(*kubeConfigPath, "")
+	if err != nil {
+		log.Fatalf("Failed to configure the K8s API client: %s", err)
+	}
+
+	k8sAPI, err := k8s.InitializeAPIForConfig(
 		ctx,
-		*kubeConfigPath,
+		config,
 		true,
 		k8s.CJ, k8s.DS, k8s.Deploy, k8s.Job, k8s.NS, k8s.Pod, k8s.RC, k8s.RS, k8s.Svc, k8s.SS, k8s.SP, k8s.TS,
 	)
@@ -46,6 +53,13 @@ func main() {
 		log.Fatalf("Failed to initialize K8s API: %s", err)
 	}
 
+	// the ServiceLevelObjectives are listed with a dynamic client as they
+	// don't have generated clients
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		log.Fatalf("Failed to initialize K8s dynamic client: %s", err)
+	}
+
 	var prometheusClient promApi.Client
 	if *prometheusURL != "" {
 		prometheusClient, err = promApi.NewClient(promApi.Config{Address: *prometheusURL})
@@ -67,6 +81,7 @@ func main() {
 		*addr,
 		prometheusClient,
 		k8sAPI,
+		dynamicClient,
 		*controllerNamespace,
 		*clusterDomain,
 		strings.Split(*ignoredNamespaces, ","),
@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/linkerd/linkerd2/pkg/k8s"
 	pb "github.com/linkerd/linkerd2/viz/metrics-api/gen/viz"
@@ -121,7 +122,7 @@ func (s *grpcServer) getGatewaysMetrics(ctx context.Context, req *pb.GatewaysReq
 	}
 
 	quantileQueries := generateQuantileQueries(gatewayLatencyQuantileQuery, labels.String(), timeWindow, groupBy.String())
-	metricsResp, err := s.getPrometheusMetrics(ctx, promQueries, quantileQueries)
+	metricsResp, err := s.getPrometheusMetrics(ctx, promQueries, quantileQueries, time.Time{})
 
 	if err != nil {
 		return nil, err
@@ -1438,6 +1438,16 @@ type StatSummaryRequest struct {
 	Outbound  isStatSummaryRequest_Outbound `protobuf_oneof:"outbound"`
 	SkipStats bool                          `protobuf:"varint,6,opt,name=skip_stats,json=skipStats,proto3" json:"skip_stats,omitempty"` // true if we want to skip stats from Prometheus
 	TcpStats  bool                          `protobuf:"varint,7,opt,name=tcp_stats,json=tcpStats,proto3" json:"tcp_stats,omitempty"`
+	// If set, the stats are computed over this range instead of the time
+	// window ending now.
+	TimeRange *TimeRange `protobuf:"bytes,8,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	// Latency quantiles to compute in addition to the p50, p95 and p99, between
+	// 0 and 1 exclusive.
+	LatencyQuantiles []float64 `protobuf:"fixed64,9,rep,packed,name=latency_quantiles,json=latencyQuantiles,proto3" json:"latency_quantiles,omitempty"`
+	// true if we want the histogram of the latencies
+	LatencyHistogram bool `protobuf:"varint,10,opt,name=latency_histogram,json=latencyHistogram,proto3" json:"latency_histogram,omitempty"`
+	// true if we want the breakdown of the responses by status
+	StatusBreakdown bool `protobuf:"varint,11,opt,name=status_breakdown,json=statusBreakdown,proto3" json:"status_breakdown,omitempty"`
 }
 
 func (x *StatSummaryRequest) Reset() {
@@ -1528,6 +1538,34 @@ func (x *StatSummaryRequest) GetTcpStats() bool {
 	return false
 }
 
+func (x *StatSummaryRequest) GetTimeRange() *TimeRange {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+func (x *StatSummaryRequest) GetLatencyQuantiles() []float64 {
+	if x != nil {
+		return x.LatencyQuantiles
+	}
+	return nil
+}
+
+func (x *StatSummaryRequest) GetLatencyHistogram() bool {
+	if x != nil {
+		return x.LatencyHistogram
+	}
+	return false
+}
+
+func (x *StatSummaryRequest) GetStatusBreakdown() bool {
+	if x != nil {
+		return x.StatusBreakdown
+	}
+	return false
+}
+
 type isStatSummaryRequest_Outbound interface {
 	isStatSummaryRequest_Outbound()
 }
@@ -1550,6 +1588,131 @@ func (*StatSummaryRequest_ToResource) isStatSummaryRequest_Outbound() {}
 
 func (*StatSummaryRequest_FromResource) isStatSummaryRequest_Outbound() {}
 
+// An absolute time range.
+type TimeRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Start of the range, in seconds since the epoch.
+	Start int64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	// End of the range, in seconds since the epoch.
+	End int64 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	// If set, the stats over the range are also returned as a series of
+	// samples, one for each step.
+	Step *duration.Duration `protobuf:"bytes,3,opt,name=step,proto3" json:"step,omitempty"`
+}
+
+func (x *TimeRange) Reset() {
+	*x = TimeRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_viz_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeRange) ProtoMessage() {}
+
+func (x *TimeRange) ProtoReflect() protoreflect.Message {
+	mi := &file_viz_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeRange.ProtoReflect.Descriptor instead.
+func (*TimeRange) Descriptor() ([]byte, []int) {
+	return file_viz_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TimeRange) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *TimeRange) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *TimeRange) GetStep() *duration.Duration {
+	if x != nil {
+		return x.Step
+	}
+	return nil
+}
+
+// The stats of a time series sample, computed over the step preceding it.
+type StatsSample struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Time of the sample, in seconds since the epoch.
+	Timestamp int64       `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Stats     *BasicStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (x *StatsSample) Reset() {
+	*x = StatsSample{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_viz_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsSample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsSample) ProtoMessage() {}
+
+func (x *StatsSample) ProtoReflect() protoreflect.Message {
+	mi := &file_viz_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsSample.ProtoReflect.Descriptor instead.
+func (*StatsSample) Descriptor() ([]byte, []int) {
+	return file_viz_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *StatsSample) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *StatsSample) GetStats() *BasicStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
 type StatSummaryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1564,7 +1727,7 @@ type StatSummaryResponse struct {
 func (x *StatSummaryResponse) Reset() {
 	*x = StatSummaryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_proto_msgTypes[20]
+		mi := &file_viz_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1577,7 +1740,7 @@ func (x *StatSummaryResponse) String() string {
 func (*StatSummaryResponse) ProtoMessage() {}
 
 func (x *StatSummaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_proto_msgTypes[20]
+	mi := &file_viz_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1590,7 +1753,7 @@ func (x *StatSummaryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatSummaryResponse.ProtoReflect.Descriptor instead.
 func (*StatSummaryResponse) Descriptor() ([]byte, []int) {
-	return file_viz_proto_rawDescGZIP(), []int{20}
+	return file_viz_proto_rawDescGZIP(), []int{22}
 }
 
 func (m *StatSummaryResponse) GetResponse() isStatSummaryResponse_Response {
@@ -1642,12 +1805,19 @@ type BasicStats struct {
 	LatencyMsP99       uint64 `protobuf:"varint,5,opt,name=latency_ms_p99,json=latencyMsP99,proto3" json:"latency_ms_p99,omitempty"`
 	ActualSuccessCount uint64 `protobuf:"varint,6,opt,name=actual_success_count,json=actualSuccessCount,proto3" json:"actual_success_count,omitempty"`
 	ActualFailureCount uint64 `protobuf:"varint,7,opt,name=actual_failure_count,json=actualFailureCount,proto3" json:"actual_failure_count,omitempty"`
+	// latencies of the quantiles requested, sorted by quantile
+	LatencyQuantiles []*LatencyQuantile `protobuf:"bytes,8,rep,name=latency_quantiles,json=latencyQuantiles,proto3" json:"latency_quantiles,omitempty"`
+	// cumulative histogram of the latencies if requested, sorted by bucket
+	LatencyHistogram []*LatencyBucket `protobuf:"bytes,9,rep,name=latency_histogram,json=latencyHistogram,proto3" json:"latency_histogram,omitempty"`
+	// breakdown of the responses by status if requested, sorted by HTTP and
+	// gRPC status
+	StatusCounts []*StatusCount `protobuf:"bytes,10,rep,name=status_counts,json=statusCounts,proto3" json:"status_counts,omitempty"`
 }
 
 func (x *BasicStats) Reset() {
 	*x = BasicStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_proto_msgTypes[21]
+		mi := &file_viz_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1660,7 +1830,7 @@ func (x *BasicStats) String() string {
 func (*BasicStats) ProtoMessage() {}
 
 func (x *BasicStats) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_proto_msgTypes[21]
+	mi := &file_viz_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1673,7 +1843,7 @@ func (x *BasicStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BasicStats.ProtoReflect.Descriptor instead.
 func (*BasicStats) Descriptor() ([]byte, []int) {
-	return file_viz_proto_rawDescGZIP(), []int{21}
+	return file_viz_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *BasicStats) GetSuccessCount() uint64 {
@@ -1704,23 +1874,231 @@ func (x *BasicStats) GetLatencyMsP95() uint64 {
 	return 0
 }
 
-func (x *BasicStats) GetLatencyMsP99() uint64 {
-	if x != nil {
-		return x.LatencyMsP99
-	}
-	return 0
+func (x *BasicStats) GetLatencyMsP99() uint64 {
+	if x != nil {
+		return x.LatencyMsP99
+	}
+	return 0
+}
+
+func (x *BasicStats) GetActualSuccessCount() uint64 {
+	if x != nil {
+		return x.ActualSuccessCount
+	}
+	return 0
+}
+
+func (x *BasicStats) GetActualFailureCount() uint64 {
+	if x != nil {
+		return x.ActualFailureCount
+	}
+	return 0
+}
+
+func (x *BasicStats) GetLatencyQuantiles() []*LatencyQuantile {
+	if x != nil {
+		return x.LatencyQuantiles
+	}
+	return nil
+}
+
+func (x *BasicStats) GetLatencyHistogram() []*LatencyBucket {
+	if x != nil {
+		return x.LatencyHistogram
+	}
+	return nil
+}
+
+func (x *BasicStats) GetStatusCounts() []*StatusCount {
+	if x != nil {
+		return x.StatusCounts
+	}
+	return nil
+}
+
+type LatencyQuantile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Quantile  float64 `protobuf:"fixed64,1,opt,name=quantile,proto3" json:"quantile,omitempty"`
+	LatencyMs uint64  `protobuf:"varint,2,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+}
+
+func (x *LatencyQuantile) Reset() {
+	*x = LatencyQuantile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_viz_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LatencyQuantile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatencyQuantile) ProtoMessage() {}
+
+func (x *LatencyQuantile) ProtoReflect() protoreflect.Message {
+	mi := &file_viz_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LatencyQuantile.ProtoReflect.Descriptor instead.
+func (*LatencyQuantile) Descriptor() ([]byte, []int) {
+	return file_viz_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *LatencyQuantile) GetQuantile() float64 {
+	if x != nil {
+		return x.Quantile
+	}
+	return 0
+}
+
+func (x *LatencyQuantile) GetLatencyMs() uint64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+// The responses with a given status.
+type StatusCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// HTTP status code of the responses, empty if unknown
+	HttpStatus string `protobuf:"bytes,1,opt,name=http_status,json=httpStatus,proto3" json:"http_status,omitempty"`
+	// gRPC status code of the responses, empty if they are not gRPC responses
+	GrpcStatus string `protobuf:"bytes,2,opt,name=grpc_status,json=grpcStatus,proto3" json:"grpc_status,omitempty"`
+	// responses classified as successful or failed
+	SuccessCount uint64 `protobuf:"varint,3,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	FailureCount uint64 `protobuf:"varint,4,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+}
+
+func (x *StatusCount) Reset() {
+	*x = StatusCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_viz_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusCount) ProtoMessage() {}
+
+func (x *StatusCount) ProtoReflect() protoreflect.Message {
+	mi := &file_viz_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusCount.ProtoReflect.Descriptor instead.
+func (*StatusCount) Descriptor() ([]byte, []int) {
+	return file_viz_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *StatusCount) GetHttpStatus() string {
+	if x != nil {
+		return x.HttpStatus
+	}
+	return ""
+}
+
+func (x *StatusCount) GetGrpcStatus() string {
+	if x != nil {
+		return x.GrpcStatus
+	}
+	return ""
+}
+
+func (x *StatusCount) GetSuccessCount() uint64 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
+}
+
+func (x *StatusCount) GetFailureCount() uint64 {
+	if x != nil {
+		return x.FailureCount
+	}
+	return 0
+}
+
+type LatencyBucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// upper bound of the bucket in milliseconds, +Inf for the last bucket
+	Le float64 `protobuf:"fixed64,1,opt,name=le,proto3" json:"le,omitempty"`
+	// number of requests with a latency lower than or equal to the bound
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *LatencyBucket) Reset() {
+	*x = LatencyBucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_viz_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LatencyBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatencyBucket) ProtoMessage() {}
+
+func (x *LatencyBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_viz_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LatencyBucket.ProtoReflect.Descriptor instead.
+func (*LatencyBucket) Descriptor() ([]byte, []int) {
+	return file_viz_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *BasicStats) GetActualSuccessCount() uint64 {
+func (x *LatencyBucket) GetLe() float64 {
 	if x != nil {
-		return x.ActualSuccessCount
+		return x.Le
 	}
 	return 0
 }
 
-func (x *BasicStats) GetActualFailureCount() uint64 {
+func (x *LatencyBucket) GetCount() uint64 {
 	if x != nil {
-		return x.ActualFailureCount
+		return x.Count
 	}
 	return 0
 }
@@ -1741,7 +2119,7 @@ type TcpStats struct {
 func (x *TcpStats) Reset() {
 	*x = TcpStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_proto_msgTypes[22]
+		mi := &file_viz_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1754,7 +2132,7 @@ func (x *TcpStats) String() string {
 func (*TcpStats) ProtoMessage() {}
 
 func (x *TcpStats) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_proto_msgTypes[22]
+	mi := &file_viz_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1767,7 +2145,7 @@ func (x *TcpStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TcpStats.ProtoReflect.Descriptor instead.
 func (*TcpStats) Descriptor() ([]byte, []int) {
-	return file_viz_proto_rawDescGZIP(), []int{22}
+	return file_viz_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *TcpStats) GetOpenConnections() uint64 {
@@ -1804,7 +2182,7 @@ type TrafficSplitStats struct {
 func (x *TrafficSplitStats) Reset() {
 	*x = TrafficSplitStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_proto_msgTypes[23]
+		mi := &file_viz_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1817,7 +2195,7 @@ func (x *TrafficSplitStats) String() string {
 func (*TrafficSplitStats) ProtoMessage() {}
 
 func (x *TrafficSplitStats) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_proto_msgTypes[23]
+	mi := &file_viz_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1830,7 +2208,7 @@ func (x *TrafficSplitStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TrafficSplitStats.ProtoReflect.Descriptor instead.
 func (*TrafficSplitStats) Descriptor() ([]byte, []int) {
-	return file_viz_proto_rawDescGZIP(), []int{23}
+	return file_viz_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *TrafficSplitStats) GetApex() string {
@@ -1867,7 +2245,7 @@ type StatTable struct {
 func (x *StatTable) Reset() {
 	*x = StatTable{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_proto_msgTypes[24]
+		mi := &file_viz_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1880,7 +2258,7 @@ func (x *StatTable) String() string {
 func (*StatTable) ProtoMessage() {}
 
 func (x *StatTable) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_proto_msgTypes[24]
+	mi := &file_viz_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1893,7 +2271,7 @@ func (x *StatTable) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatTable.ProtoReflect.Descriptor instead.
 func (*StatTable) Descriptor() ([]byte, []int) {
-	return file_viz_proto_rawDescGZIP(), []int{24}
+	return file_viz_proto_rawDescGZIP(), []int{29}
 }
 
 func (m *StatTable) GetTable() isStatTable_Table {
@@ -1931,7 +2309,7 @@ type EdgesRequest struct {
 func (x *EdgesRequest) Reset() {
 	*x = EdgesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_proto_msgTypes[25]
+		mi := &file_viz_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1944,7 +2322,7 @@ func (x *EdgesRequest) String() string {
 func (*EdgesRequest) ProtoMessage() {}
 
 func (x *EdgesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_proto_msgTypes[25]
+	mi := &file_viz_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1957,7 +2335,7 @@ func (x *EdgesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EdgesRequest.ProtoReflect.Descriptor instead.
 func (*EdgesRequest) Descriptor() ([]byte, []int) {
-	return file_viz_proto_rawDescGZIP(), []int{25}
+	return file_viz_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *EdgesRequest) GetSelector() *ResourceSelection {
@@ -1981,7 +2359,7 @@ type EdgesResponse struct {
 func (x *EdgesResponse) Reset() {
 	*x = EdgesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_proto_msgTypes[26]
+		mi := &file_viz_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1994,7 +2372,7 @@ func (x *EdgesResponse) String() string {
 func (*EdgesResponse) ProtoMessage() {}
 
 func (x *EdgesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_proto_msgTypes[26]
+	mi := &file_viz_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2007,7 +2385,7 @@ func (x *EdgesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EdgesResponse.ProtoReflect.Descriptor instead.
 func (*EdgesResponse) Descriptor() ([]byte, []int) {
-	return file_viz_proto_rawDescGZIP(), []int{26}
+	return file_viz_proto_rawDescGZIP(), []int{31}
 }
 
 func (m *EdgesResponse) GetResponse() isEdgesResponse_Response {
@@ -2062,7 +2440,7 @@ type Edge struct {
 func (x *Edge) Reset() {
 	*x = Edge{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_proto_msgTypes[27]
+		mi := &file_viz_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2075,7 +2453,7 @@ func (x *Edge) String() string {
 func (*Edge) ProtoMessage() {}
 
 func (x *Edge) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_proto_msgTypes[27]
+	mi := &file_viz_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2088,7 +2466,7 @@ func (x *Edge) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Edge.ProtoReflect.Descriptor instead.
 func (*Edge) Descriptor() ([]byte, []int) {
-	return file_viz_proto_rawDescGZIP(), []int{27}
+	return file_viz_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *Edge) GetSrc() *Resource {
@@ -2137,12 +2515,22 @@ type TopRoutesRequest struct {
 	//	*TopRoutesRequest_None
 	//	*TopRoutesRequest_ToResource
 	Outbound isTopRoutesRequest_Outbound `protobuf_oneof:"outbound"`
+	// If set, the routes stats are computed over this range instead of the time
+	// window ending now.
+	TimeRange *TimeRange `protobuf:"bytes,8,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	// Latency quantiles to compute in addition to the p50, p95 and p99, between
+	// 0 and 1 exclusive.
+	LatencyQuantiles []float64 `protobuf:"fixed64,9,rep,packed,name=latency_quantiles,json=latencyQuantiles,proto3" json:"latency_quantiles,omitempty"`
+	// true if we want the histogram of the latencies
+	LatencyHistogram bool `protobuf:"varint,10,opt,name=latency_histogram,json=latencyHistogram,proto3" json:"latency_histogram,omitempty"`
+	// true if we want the breakdown of the responses by status
+	StatusBreakdown bool `protobuf:"varint,11,opt,name=status_breakdown,json=statusBreakdown,proto3" json:"status_breakdown,omitempty"`
 }
 
 func (x *TopRoutesRequest) Reset() {
 	*x = TopRoutesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_proto_msgTypes[28]
+		mi := &file_viz_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2155,7 +2543,7 @@ func (x *TopRoutesRequest) String() string {
 func (*TopRoutesRequest) ProtoMessage() {}
 
 func (x *TopRoutesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_proto_msgTypes[28]
+	mi := &file_viz_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2168,7 +2556,7 @@ func (x *TopRoutesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TopRoutesRequest.ProtoReflect.Descriptor instead.
 func (*TopRoutesRequest) Descriptor() ([]byte, []int) {
-	return file_viz_proto_rawDescGZIP(), []int{28}
+	return file_viz_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *TopRoutesRequest) GetSelector() *ResourceSelection {
@@ -2206,6 +2594,34 @@ func (x *TopRoutesRequest) GetToResource() *Resource {
 	return nil
 }
 
+func (x *TopRoutesRequest) GetTimeRange() *TimeRange {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+func (x *TopRoutesRequest) GetLatencyQuantiles() []float64 {
+	if x != nil {
+		return x.LatencyQuantiles
+	}
+	return nil
+}
+
+func (x *TopRoutesRequest) GetLatencyHistogram() bool {
+	if x != nil {
+		return x.LatencyHistogram
+	}
+	return false
+}
+
+func (x *TopRoutesRequest) GetStatusBreakdown() bool {
+	if x != nil {
+		return x.StatusBreakdown
+	}
+	return false
+}
+
 type isTopRoutesRequest_Outbound interface {
 	isTopRoutesRequest_Outbound()
 }
@@ -2236,7 +2652,7 @@ type TopRoutesResponse struct {
 func (x *TopRoutesResponse) Reset() {
 	*x = TopRoutesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_proto_msgTypes[29]
+		mi := &file_viz_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2249,7 +2665,7 @@ func (x *TopRoutesResponse) String() string {
 func (*TopRoutesResponse) ProtoMessage() {}
 
 func (x *TopRoutesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_proto_msgTypes[29]
+	mi := &file_viz_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2262,7 +2678,7 @@ func (x *TopRoutesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TopRoutesResponse.ProtoReflect.Descriptor instead.
 func (*TopRoutesResponse) Descriptor() ([]byte, []int) {
-	return file_viz_proto_rawDescGZIP(), []int{29}
+	return file_viz_proto_rawDescGZIP(), []int{34}
 }
 
 func (m *TopRoutesResponse) GetResponse() isTopRoutesResponse_Response {
@@ -2314,7 +2730,7 @@ type RouteTable struct {
 func (x *RouteTable) Reset() {
 	*x = RouteTable{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_proto_msgTypes[30]
+		mi := &file_viz_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2327,7 +2743,7 @@ func (x *RouteTable) String() string {
 func (*RouteTable) ProtoMessage() {}
 
 func (x *RouteTable) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_proto_msgTypes[30]
+	mi := &file_viz_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2340,7 +2756,7 @@ func (x *RouteTable) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RouteTable.ProtoReflect.Descriptor instead.
 func (*RouteTable) Descriptor() ([]byte, []int) {
-	return file_viz_proto_rawDescGZIP(), []int{30}
+	return file_viz_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *RouteTable) GetRows() []*RouteTable_Row {
@@ -2368,7 +2784,7 @@ type GatewaysTable struct {
 func (x *GatewaysTable) Reset() {
 	*x = GatewaysTable{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_proto_msgTypes[31]
+		mi := &file_viz_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2381,7 +2797,7 @@ func (x *GatewaysTable) String() string {
 func (*GatewaysTable) ProtoMessage() {}
 
 func (x *GatewaysTable) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_proto_msgTypes[31]
+	mi := &file_viz_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2394,7 +2810,7 @@ func (x *GatewaysTable) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GatewaysTable.ProtoReflect.Descriptor instead.
 func (*GatewaysTable) Descriptor() ([]byte, []int) {
-	return file_viz_proto_rawDescGZIP(), []int{31}
+	return file_viz_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *GatewaysTable) GetRows() []*GatewaysTable_Row {
@@ -2417,7 +2833,7 @@ type GatewaysRequest struct {
 func (x *GatewaysRequest) Reset() {
 	*x = GatewaysRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_proto_msgTypes[32]
+		mi := &file_viz_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2430,7 +2846,7 @@ func (x *GatewaysRequest) String() string {
 func (*GatewaysRequest) ProtoMessage() {}
 
 func (x *GatewaysRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_proto_msgTypes[32]
+	mi := &file_viz_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2443,7 +2859,7 @@ func (x *GatewaysRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GatewaysRequest.ProtoReflect.Descriptor instead.
 func (*GatewaysRequest) Descriptor() ([]byte, []int) {
-	return file_viz_proto_rawDescGZIP(), []int{32}
+	return file_viz_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *GatewaysRequest) GetRemoteClusterName() string {
@@ -2478,23 +2894,236 @@ type GatewaysResponse struct {
 	Response isGatewaysResponse_Response `protobuf_oneof:"response"`
 }
 
-func (x *GatewaysResponse) Reset() {
-	*x = GatewaysResponse{}
+func (x *GatewaysResponse) Reset() {
+	*x = GatewaysResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_viz_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewaysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewaysResponse) ProtoMessage() {}
+
+func (x *GatewaysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_viz_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewaysResponse.ProtoReflect.Descriptor instead.
+func (*GatewaysResponse) Descriptor() ([]byte, []int) {
+	return file_viz_proto_rawDescGZIP(), []int{38}
+}
+
+func (m *GatewaysResponse) GetResponse() isGatewaysResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *GatewaysResponse) GetOk() *GatewaysResponse_Ok {
+	if x, ok := x.GetResponse().(*GatewaysResponse_Ok_); ok {
+		return x.Ok
+	}
+	return nil
+}
+
+func (x *GatewaysResponse) GetError() *ResourceError {
+	if x, ok := x.GetResponse().(*GatewaysResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isGatewaysResponse_Response interface {
+	isGatewaysResponse_Response()
+}
+
+type GatewaysResponse_Ok_ struct {
+	Ok *GatewaysResponse_Ok `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+}
+
+type GatewaysResponse_Error struct {
+	Error *ResourceError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*GatewaysResponse_Ok_) isGatewaysResponse_Response() {}
+
+func (*GatewaysResponse_Error) isGatewaysResponse_Response() {}
+
+type ServiceLevelObjectivesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// namespace of the objectives, or all the namespaces if empty
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (x *ServiceLevelObjectivesRequest) Reset() {
+	*x = ServiceLevelObjectivesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_viz_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceLevelObjectivesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceLevelObjectivesRequest) ProtoMessage() {}
+
+func (x *ServiceLevelObjectivesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_viz_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceLevelObjectivesRequest.ProtoReflect.Descriptor instead.
+func (*ServiceLevelObjectivesRequest) Descriptor() ([]byte, []int) {
+	return file_viz_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ServiceLevelObjectivesRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type ServiceLevelObjectivesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*ServiceLevelObjectivesResponse_Ok_
+	//	*ServiceLevelObjectivesResponse_Error
+	Response isServiceLevelObjectivesResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *ServiceLevelObjectivesResponse) Reset() {
+	*x = ServiceLevelObjectivesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_viz_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceLevelObjectivesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceLevelObjectivesResponse) ProtoMessage() {}
+
+func (x *ServiceLevelObjectivesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_viz_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceLevelObjectivesResponse.ProtoReflect.Descriptor instead.
+func (*ServiceLevelObjectivesResponse) Descriptor() ([]byte, []int) {
+	return file_viz_proto_rawDescGZIP(), []int{40}
+}
+
+func (m *ServiceLevelObjectivesResponse) GetResponse() isServiceLevelObjectivesResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *ServiceLevelObjectivesResponse) GetOk() *ServiceLevelObjectivesResponse_Ok {
+	if x, ok := x.GetResponse().(*ServiceLevelObjectivesResponse_Ok_); ok {
+		return x.Ok
+	}
+	return nil
+}
+
+func (x *ServiceLevelObjectivesResponse) GetError() *ResourceError {
+	if x, ok := x.GetResponse().(*ServiceLevelObjectivesResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isServiceLevelObjectivesResponse_Response interface {
+	isServiceLevelObjectivesResponse_Response()
+}
+
+type ServiceLevelObjectivesResponse_Ok_ struct {
+	Ok *ServiceLevelObjectivesResponse_Ok `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+}
+
+type ServiceLevelObjectivesResponse_Error struct {
+	Error *ResourceError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*ServiceLevelObjectivesResponse_Ok_) isServiceLevelObjectivesResponse_Response() {}
+
+func (*ServiceLevelObjectivesResponse_Error) isServiceLevelObjectivesResponse_Response() {}
+
+// The current state of a ServiceLevelObjective, evaluated over its window.
+type ServiceLevelObjective struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace string    `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Target    *Resource `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Window    string    `protobuf:"bytes,4,opt,name=window,proto3" json:"window,omitempty"`
+	// set if the objective couldn't be evaluated
+	Error       string                                      `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	SuccessRate *ServiceLevelObjective_SuccessRateObjective `protobuf:"bytes,6,opt,name=success_rate,json=successRate,proto3" json:"success_rate,omitempty"`
+	Latency     *ServiceLevelObjective_LatencyObjective     `protobuf:"bytes,7,opt,name=latency,proto3" json:"latency,omitempty"`
+}
+
+func (x *ServiceLevelObjective) Reset() {
+	*x = ServiceLevelObjective{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_proto_msgTypes[33]
+		mi := &file_viz_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GatewaysResponse) String() string {
+func (x *ServiceLevelObjective) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GatewaysResponse) ProtoMessage() {}
+func (*ServiceLevelObjective) ProtoMessage() {}
 
-func (x *GatewaysResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_proto_msgTypes[33]
+func (x *ServiceLevelObjective) ProtoReflect() protoreflect.Message {
+	mi := &file_viz_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2505,47 +3134,59 @@ func (x *GatewaysResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GatewaysResponse.ProtoReflect.Descriptor instead.
-func (*GatewaysResponse) Descriptor() ([]byte, []int) {
-	return file_viz_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use ServiceLevelObjective.ProtoReflect.Descriptor instead.
+func (*ServiceLevelObjective) Descriptor() ([]byte, []int) {
+	return file_viz_proto_rawDescGZIP(), []int{41}
 }
 
-func (m *GatewaysResponse) GetResponse() isGatewaysResponse_Response {
-	if m != nil {
-		return m.Response
+func (x *ServiceLevelObjective) GetName() string {
+	if x != nil {
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *GatewaysResponse) GetOk() *GatewaysResponse_Ok {
-	if x, ok := x.GetResponse().(*GatewaysResponse_Ok_); ok {
-		return x.Ok
+func (x *ServiceLevelObjective) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
 	}
-	return nil
+	return ""
 }
 
-func (x *GatewaysResponse) GetError() *ResourceError {
-	if x, ok := x.GetResponse().(*GatewaysResponse_Error); ok {
-		return x.Error
+func (x *ServiceLevelObjective) GetTarget() *Resource {
+	if x != nil {
+		return x.Target
 	}
 	return nil
 }
 
-type isGatewaysResponse_Response interface {
-	isGatewaysResponse_Response()
+func (x *ServiceLevelObjective) GetWindow() string {
+	if x != nil {
+		return x.Window
+	}
+	return ""
 }
 
-type GatewaysResponse_Ok_ struct {
-	Ok *GatewaysResponse_Ok `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+func (x *ServiceLevelObjective) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
 }
 
-type GatewaysResponse_Error struct {
-	Error *ResourceError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+func (x *ServiceLevelObjective) GetSuccessRate() *ServiceLevelObjective_SuccessRateObjective {
+	if x != nil {
+		return x.SuccessRate
+	}
+	return nil
 }
 
-func (*GatewaysResponse_Ok_) isGatewaysResponse_Response() {}
-
-func (*GatewaysResponse_Error) isGatewaysResponse_Response() {}
+func (x *ServiceLevelObjective) GetLatency() *ServiceLevelObjective_LatencyObjective {
+	if x != nil {
+		return x.Latency
+	}
+	return nil
+}
 
 type Headers_Header struct {
 	state         protoimpl.MessageState
@@ -2567,7 +3208,7 @@ type Headers_Header struct {
 func (x *Headers_Header) Reset() {
 	*x = Headers_Header{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_proto_msgTypes[34]
+		mi := &file_viz_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2580,7 +3221,7 @@ func (x *Headers_Header) String() string {
 func (*Headers_Header) ProtoMessage() {}
 
 func (x *Headers_Header) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_proto_msgTypes[34]
+	mi := &file_viz_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2653,7 +3294,7 @@ type PodErrors_PodError struct {
 func (x *PodErrors_PodError) Reset() {
 	*x = PodErrors_PodError{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_proto_msgTypes[35]
+		mi := &file_viz_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2666,7 +3307,7 @@ func (x *PodErrors_PodError) String() string {
 func (*PodErrors_PodError) ProtoMessage() {}
 
 func (x *PodErrors_PodError) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_proto_msgTypes[35]
+	mi := &file_viz_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2721,7 +3362,7 @@ type PodErrors_PodError_ContainerError struct {
 func (x *PodErrors_PodError_ContainerError) Reset() {
 	*x = PodErrors_PodError_ContainerError{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_proto_msgTypes[36]
+		mi := &file_viz_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2734,7 +3375,7 @@ func (x *PodErrors_PodError_ContainerError) String() string {
 func (*PodErrors_PodError_ContainerError) ProtoMessage() {}
 
 func (x *PodErrors_PodError_ContainerError) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_proto_msgTypes[36]
+	mi := &file_viz_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2789,7 +3430,7 @@ type StatSummaryResponse_Ok struct {
 func (x *StatSummaryResponse_Ok) Reset() {
 	*x = StatSummaryResponse_Ok{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_proto_msgTypes[37]
+		mi := &file_viz_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2802,7 +3443,7 @@ func (x *StatSummaryResponse_Ok) String() string {
 func (*StatSummaryResponse_Ok) ProtoMessage() {}
 
 func (x *StatSummaryResponse_Ok) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_proto_msgTypes[37]
+	mi := &file_viz_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2815,7 +3456,7 @@ func (x *StatSummaryResponse_Ok) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatSummaryResponse_Ok.ProtoReflect.Descriptor instead.
 func (*StatSummaryResponse_Ok) Descriptor() ([]byte, []int) {
-	return file_viz_proto_rawDescGZIP(), []int{20, 0}
+	return file_viz_proto_rawDescGZIP(), []int{22, 0}
 }
 
 func (x *StatSummaryResponse_Ok) GetStatTables() []*StatTable {
@@ -2836,7 +3477,7 @@ type StatTable_PodGroup struct {
 func (x *StatTable_PodGroup) Reset() {
 	*x = StatTable_PodGroup{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_proto_msgTypes[38]
+		mi := &file_viz_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2849,7 +3490,7 @@ func (x *StatTable_PodGroup) String() string {
 func (*StatTable_PodGroup) ProtoMessage() {}
 
 func (x *StatTable_PodGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_proto_msgTypes[38]
+	mi := &file_viz_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2862,7 +3503,7 @@ func (x *StatTable_PodGroup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatTable_PodGroup.ProtoReflect.Descriptor instead.
 func (*StatTable_PodGroup) Descriptor() ([]byte, []int) {
-	return file_viz_proto_rawDescGZIP(), []int{24, 0}
+	return file_viz_proto_rawDescGZIP(), []int{29, 0}
 }
 
 func (x *StatTable_PodGroup) GetRows() []*StatTable_PodGroup_Row {
@@ -2892,12 +3533,14 @@ type StatTable_PodGroup_Row struct {
 	TsStats        *TrafficSplitStats `protobuf:"bytes,10,opt,name=ts_stats,json=tsStats,proto3" json:"ts_stats,omitempty"`
 	// Stores a set of errors for each pod name. If a pod has no errors, it may be omitted.
 	ErrorsByPod map[string]*PodErrors `protobuf:"bytes,7,rep,name=errors_by_pod,json=errorsByPod,proto3" json:"errors_by_pod,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Stats over each step of the requested time range, if any.
+	Series []*StatsSample `protobuf:"bytes,11,rep,name=series,proto3" json:"series,omitempty"`
 }
 
 func (x *StatTable_PodGroup_Row) Reset() {
 	*x = StatTable_PodGroup_Row{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_proto_msgTypes[39]
+		mi := &file_viz_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2910,7 +3553,7 @@ func (x *StatTable_PodGroup_Row) String() string {
 func (*StatTable_PodGroup_Row) ProtoMessage() {}
 
 func (x *StatTable_PodGroup_Row) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_proto_msgTypes[39]
+	mi := &file_viz_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2923,7 +3566,7 @@ func (x *StatTable_PodGroup_Row) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatTable_PodGroup_Row.ProtoReflect.Descriptor instead.
 func (*StatTable_PodGroup_Row) Descriptor() ([]byte, []int) {
-	return file_viz_proto_rawDescGZIP(), []int{24, 0, 0}
+	return file_viz_proto_rawDescGZIP(), []int{29, 0, 0}
 }
 
 func (x *StatTable_PodGroup_Row) GetResource() *Resource {
@@ -2996,6 +3639,13 @@ func (x *StatTable_PodGroup_Row) GetErrorsByPod() map[string]*PodErrors {
 	return nil
 }
 
+func (x *StatTable_PodGroup_Row) GetSeries() []*StatsSample {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
 type EdgesResponse_Ok struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3007,7 +3657,7 @@ type EdgesResponse_Ok struct {
 func (x *EdgesResponse_Ok) Reset() {
 	*x = EdgesResponse_Ok{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_proto_msgTypes[41]
+		mi := &file_viz_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3020,7 +3670,7 @@ func (x *EdgesResponse_Ok) String() string {
 func (*EdgesResponse_Ok) ProtoMessage() {}
 
 func (x *EdgesResponse_Ok) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_proto_msgTypes[41]
+	mi := &file_viz_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3033,7 +3683,7 @@ func (x *EdgesResponse_Ok) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EdgesResponse_Ok.ProtoReflect.Descriptor instead.
 func (*EdgesResponse_Ok) Descriptor() ([]byte, []int) {
-	return file_viz_proto_rawDescGZIP(), []int{26, 0}
+	return file_viz_proto_rawDescGZIP(), []int{31, 0}
 }
 
 func (x *EdgesResponse_Ok) GetEdges() []*Edge {
@@ -3054,7 +3704,7 @@ type TopRoutesResponse_Ok struct {
 func (x *TopRoutesResponse_Ok) Reset() {
 	*x = TopRoutesResponse_Ok{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_proto_msgTypes[42]
+		mi := &file_viz_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3067,7 +3717,7 @@ func (x *TopRoutesResponse_Ok) String() string {
 func (*TopRoutesResponse_Ok) ProtoMessage() {}
 
 func (x *TopRoutesResponse_Ok) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_proto_msgTypes[42]
+	mi := &file_viz_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3080,7 +3730,7 @@ func (x *TopRoutesResponse_Ok) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TopRoutesResponse_Ok.ProtoReflect.Descriptor instead.
 func (*TopRoutesResponse_Ok) Descriptor() ([]byte, []int) {
-	return file_viz_proto_rawDescGZIP(), []int{29, 0}
+	return file_viz_proto_rawDescGZIP(), []int{34, 0}
 }
 
 func (x *TopRoutesResponse_Ok) GetRoutes() []*RouteTable {
@@ -3099,12 +3749,14 @@ type RouteTable_Row struct {
 	TimeWindow string      `protobuf:"bytes,2,opt,name=time_window,json=timeWindow,proto3" json:"time_window,omitempty"`
 	Authority  string      `protobuf:"bytes,6,opt,name=authority,proto3" json:"authority,omitempty"`
 	Stats      *BasicStats `protobuf:"bytes,5,opt,name=stats,proto3" json:"stats,omitempty"`
+	// Stats over each step of the requested time range, if any.
+	Series []*StatsSample `protobuf:"bytes,7,rep,name=series,proto3" json:"series,omitempty"`
 }
 
 func (x *RouteTable_Row) Reset() {
 	*x = RouteTable_Row{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_proto_msgTypes[43]
+		mi := &file_viz_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3117,7 +3769,7 @@ func (x *RouteTable_Row) String() string {
 func (*RouteTable_Row) ProtoMessage() {}
 
 func (x *RouteTable_Row) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_proto_msgTypes[43]
+	mi := &file_viz_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3130,7 +3782,7 @@ func (x *RouteTable_Row) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RouteTable_Row.ProtoReflect.Descriptor instead.
 func (*RouteTable_Row) Descriptor() ([]byte, []int) {
-	return file_viz_proto_rawDescGZIP(), []int{30, 0}
+	return file_viz_proto_rawDescGZIP(), []int{35, 0}
 }
 
 func (x *RouteTable_Row) GetRoute() string {
@@ -3161,6 +3813,13 @@ func (x *RouteTable_Row) GetStats() *BasicStats {
 	return nil
 }
 
+func (x *RouteTable_Row) GetSeries() []*StatsSample {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
 type GatewaysTable_Row struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3179,7 +3838,7 @@ type GatewaysTable_Row struct {
 func (x *GatewaysTable_Row) Reset() {
 	*x = GatewaysTable_Row{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_proto_msgTypes[44]
+		mi := &file_viz_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3192,7 +3851,209 @@ func (x *GatewaysTable_Row) String() string {
 func (*GatewaysTable_Row) ProtoMessage() {}
 
 func (x *GatewaysTable_Row) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_proto_msgTypes[44]
+	mi := &file_viz_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewaysTable_Row.ProtoReflect.Descriptor instead.
+func (*GatewaysTable_Row) Descriptor() ([]byte, []int) {
+	return file_viz_proto_rawDescGZIP(), []int{36, 0}
+}
+
+func (x *GatewaysTable_Row) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GatewaysTable_Row) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GatewaysTable_Row) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *GatewaysTable_Row) GetPairedServices() uint64 {
+	if x != nil {
+		return x.PairedServices
+	}
+	return 0
+}
+
+func (x *GatewaysTable_Row) GetAlive() bool {
+	if x != nil {
+		return x.Alive
+	}
+	return false
+}
+
+func (x *GatewaysTable_Row) GetLatencyMsP50() uint64 {
+	if x != nil {
+		return x.LatencyMsP50
+	}
+	return 0
+}
+
+func (x *GatewaysTable_Row) GetLatencyMsP95() uint64 {
+	if x != nil {
+		return x.LatencyMsP95
+	}
+	return 0
+}
+
+func (x *GatewaysTable_Row) GetLatencyMsP99() uint64 {
+	if x != nil {
+		return x.LatencyMsP99
+	}
+	return 0
+}
+
+type GatewaysResponse_Ok struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GatewaysTable *GatewaysTable `protobuf:"bytes,1,opt,name=gateways_table,json=gatewaysTable,proto3" json:"gateways_table,omitempty"`
+}
+
+func (x *GatewaysResponse_Ok) Reset() {
+	*x = GatewaysResponse_Ok{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_viz_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewaysResponse_Ok) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewaysResponse_Ok) ProtoMessage() {}
+
+func (x *GatewaysResponse_Ok) ProtoReflect() protoreflect.Message {
+	mi := &file_viz_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewaysResponse_Ok.ProtoReflect.Descriptor instead.
+func (*GatewaysResponse_Ok) Descriptor() ([]byte, []int) {
+	return file_viz_proto_rawDescGZIP(), []int{38, 0}
+}
+
+func (x *GatewaysResponse_Ok) GetGatewaysTable() *GatewaysTable {
+	if x != nil {
+		return x.GatewaysTable
+	}
+	return nil
+}
+
+type ServiceLevelObjectivesResponse_Ok struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Objectives []*ServiceLevelObjective `protobuf:"bytes,1,rep,name=objectives,proto3" json:"objectives,omitempty"`
+}
+
+func (x *ServiceLevelObjectivesResponse_Ok) Reset() {
+	*x = ServiceLevelObjectivesResponse_Ok{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_viz_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceLevelObjectivesResponse_Ok) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceLevelObjectivesResponse_Ok) ProtoMessage() {}
+
+func (x *ServiceLevelObjectivesResponse_Ok) ProtoReflect() protoreflect.Message {
+	mi := &file_viz_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceLevelObjectivesResponse_Ok.ProtoReflect.Descriptor instead.
+func (*ServiceLevelObjectivesResponse_Ok) Descriptor() ([]byte, []int) {
+	return file_viz_proto_rawDescGZIP(), []int{40, 0}
+}
+
+func (x *ServiceLevelObjectivesResponse_Ok) GetObjectives() []*ServiceLevelObjective {
+	if x != nil {
+		return x.Objectives
+	}
+	return nil
+}
+
+type ServiceLevelObjective_SuccessRateObjective struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// objective as a ratio of successful requests
+	Objective    float64 `protobuf:"fixed64,1,opt,name=objective,proto3" json:"objective,omitempty"`
+	SuccessCount uint64  `protobuf:"varint,2,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	FailureCount uint64  `protobuf:"varint,3,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	// ratio of successful requests over the window
+	SuccessRate float64 `protobuf:"fixed64,4,opt,name=success_rate,json=successRate,proto3" json:"success_rate,omitempty"`
+	// fraction of the error budget left over the window, negative when
+	// overspent
+	ErrorBudgetRemaining float64 `protobuf:"fixed64,5,opt,name=error_budget_remaining,json=errorBudgetRemaining,proto3" json:"error_budget_remaining,omitempty"`
+	// rate at which the error budget was consumed over the burn rate window,
+	// 1 exhausting the budget at the end of the window
+	BurnRate       float64 `protobuf:"fixed64,6,opt,name=burn_rate,json=burnRate,proto3" json:"burn_rate,omitempty"`
+	BurnRateWindow string  `protobuf:"bytes,7,opt,name=burn_rate_window,json=burnRateWindow,proto3" json:"burn_rate_window,omitempty"`
+}
+
+func (x *ServiceLevelObjective_SuccessRateObjective) Reset() {
+	*x = ServiceLevelObjective_SuccessRateObjective{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_viz_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceLevelObjective_SuccessRateObjective) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceLevelObjective_SuccessRateObjective) ProtoMessage() {}
+
+func (x *ServiceLevelObjective_SuccessRateObjective) ProtoReflect() protoreflect.Message {
+	mi := &file_viz_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3203,92 +4064,93 @@ func (x *GatewaysTable_Row) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GatewaysTable_Row.ProtoReflect.Descriptor instead.
-func (*GatewaysTable_Row) Descriptor() ([]byte, []int) {
-	return file_viz_proto_rawDescGZIP(), []int{31, 0}
-}
-
-func (x *GatewaysTable_Row) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
-	}
-	return ""
+// Deprecated: Use ServiceLevelObjective_SuccessRateObjective.ProtoReflect.Descriptor instead.
+func (*ServiceLevelObjective_SuccessRateObjective) Descriptor() ([]byte, []int) {
+	return file_viz_proto_rawDescGZIP(), []int{41, 0}
 }
 
-func (x *GatewaysTable_Row) GetName() string {
+func (x *ServiceLevelObjective_SuccessRateObjective) GetObjective() float64 {
 	if x != nil {
-		return x.Name
+		return x.Objective
 	}
-	return ""
+	return 0
 }
 
-func (x *GatewaysTable_Row) GetClusterName() string {
+func (x *ServiceLevelObjective_SuccessRateObjective) GetSuccessCount() uint64 {
 	if x != nil {
-		return x.ClusterName
+		return x.SuccessCount
 	}
-	return ""
+	return 0
 }
 
-func (x *GatewaysTable_Row) GetPairedServices() uint64 {
+func (x *ServiceLevelObjective_SuccessRateObjective) GetFailureCount() uint64 {
 	if x != nil {
-		return x.PairedServices
+		return x.FailureCount
 	}
 	return 0
 }
 
-func (x *GatewaysTable_Row) GetAlive() bool {
+func (x *ServiceLevelObjective_SuccessRateObjective) GetSuccessRate() float64 {
 	if x != nil {
-		return x.Alive
+		return x.SuccessRate
 	}
-	return false
+	return 0
 }
 
-func (x *GatewaysTable_Row) GetLatencyMsP50() uint64 {
+func (x *ServiceLevelObjective_SuccessRateObjective) GetErrorBudgetRemaining() float64 {
 	if x != nil {
-		return x.LatencyMsP50
+		return x.ErrorBudgetRemaining
 	}
 	return 0
 }
 
-func (x *GatewaysTable_Row) GetLatencyMsP95() uint64 {
+func (x *ServiceLevelObjective_SuccessRateObjective) GetBurnRate() float64 {
 	if x != nil {
-		return x.LatencyMsP95
+		return x.BurnRate
 	}
 	return 0
 }
 
-func (x *GatewaysTable_Row) GetLatencyMsP99() uint64 {
+func (x *ServiceLevelObjective_SuccessRateObjective) GetBurnRateWindow() string {
 	if x != nil {
-		return x.LatencyMsP99
+		return x.BurnRateWindow
 	}
-	return 0
+	return ""
 }
 
-type GatewaysResponse_Ok struct {
+type ServiceLevelObjective_LatencyObjective struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GatewaysTable *GatewaysTable `protobuf:"bytes,1,opt,name=gateways_table,json=gatewaysTable,proto3" json:"gateways_table,omitempty"`
+	// percentile of the requests, between 0 and 100 exclusive
+	Percentile  float64 `protobuf:"fixed64,1,opt,name=percentile,proto3" json:"percentile,omitempty"`
+	ThresholdMs uint64  `protobuf:"varint,2,opt,name=threshold_ms,json=thresholdMs,proto3" json:"threshold_ms,omitempty"`
+	// latency of the percentile over the window
+	LatencyMs uint64 `protobuf:"varint,3,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	Met       bool   `protobuf:"varint,4,opt,name=met,proto3" json:"met,omitempty"`
+	// set when no request was received over the window, in which case the
+	// latency and whether the objective is met are unknown
+	NoData bool `protobuf:"varint,5,opt,name=no_data,json=noData,proto3" json:"no_data,omitempty"`
 }
 
-func (x *GatewaysResponse_Ok) Reset() {
-	*x = GatewaysResponse_Ok{}
+func (x *ServiceLevelObjective_LatencyObjective) Reset() {
+	*x = ServiceLevelObjective_LatencyObjective{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_proto_msgTypes[45]
+		mi := &file_viz_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GatewaysResponse_Ok) String() string {
+func (x *ServiceLevelObjective_LatencyObjective) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GatewaysResponse_Ok) ProtoMessage() {}
+func (*ServiceLevelObjective_LatencyObjective) ProtoMessage() {}
 
-func (x *GatewaysResponse_Ok) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_proto_msgTypes[45]
+func (x *ServiceLevelObjective_LatencyObjective) ProtoReflect() protoreflect.Message {
+	mi := &file_viz_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3299,16 +4161,44 @@ func (x *GatewaysResponse_Ok) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GatewaysResponse_Ok.ProtoReflect.Descriptor instead.
-func (*GatewaysResponse_Ok) Descriptor() ([]byte, []int) {
-	return file_viz_proto_rawDescGZIP(), []int{33, 0}
+// Deprecated: Use ServiceLevelObjective_LatencyObjective.ProtoReflect.Descriptor instead.
+func (*ServiceLevelObjective_LatencyObjective) Descriptor() ([]byte, []int) {
+	return file_viz_proto_rawDescGZIP(), []int{41, 1}
 }
 
-func (x *GatewaysResponse_Ok) GetGatewaysTable() *GatewaysTable {
+func (x *ServiceLevelObjective_LatencyObjective) GetPercentile() float64 {
 	if x != nil {
-		return x.GatewaysTable
+		return x.Percentile
 	}
-	return nil
+	return 0
+}
+
+func (x *ServiceLevelObjective_LatencyObjective) GetThresholdMs() uint64 {
+	if x != nil {
+		return x.ThresholdMs
+	}
+	return 0
+}
+
+func (x *ServiceLevelObjective_LatencyObjective) GetLatencyMs() uint64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+func (x *ServiceLevelObjective_LatencyObjective) GetMet() bool {
+	if x != nil {
+		return x.Met
+	}
+	return false
+}
+
+func (x *ServiceLevelObjective_LatencyObjective) GetNoData() bool {
+	if x != nil {
+		return x.NoData
+	}
+	return false
 }
 
 var File_viz_proto protoreflect.FileDescriptor
@@ -3479,7 +4369,7 @@ var file_viz_proto_rawDesc = []byte{
 	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69,
 	0x7a, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f,
 	0x75, 0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xdf, 0x02, 0x0a, 0x12, 0x53,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x9c, 0x04, 0x0a, 0x12, 0x53,
 	0x74, 0x61, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x3b, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76,
@@ -3501,255 +4391,405 @@ var file_viz_proto_rawDesc = []byte{
 	0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x6b, 0x69, 0x70, 0x53, 0x74,
 	0x61, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x63, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73,
 	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x63, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73,
-	0x42, 0x0a, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0xce, 0x01, 0x0a,
-	0x13, 0x53, 0x74, 0x61, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x24, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x6b, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x33, 0x0a, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69,
-	0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x1a, 0x3e, 0x0a, 0x02, 0x4f, 0x6b, 0x12, 0x38, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x5f,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c,
-	0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xac, 0x02,
-	0x0a, 0x0a, 0x42, 0x61, 0x73, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
-	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63,
-	0x79, 0x5f, 0x6d, 0x73, 0x5f, 0x70, 0x35, 0x30, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
-	0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x50, 0x35, 0x30, 0x12, 0x24, 0x0a, 0x0e,
-	0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x73, 0x5f, 0x70, 0x39, 0x35, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x50,
-	0x39, 0x35, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x73,
-	0x5f, 0x70, 0x39, 0x39, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6c, 0x61, 0x74, 0x65,
-	0x6e, 0x63, 0x79, 0x4d, 0x73, 0x50, 0x39, 0x39, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x63, 0x74, 0x75,
-	0x61, 0x6c, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x53, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x63,
-	0x74, 0x75, 0x61, 0x6c, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c,
-	0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8b, 0x01, 0x0a,
-	0x08, 0x54, 0x63, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x6f, 0x70, 0x65,
-	0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x79, 0x74,
-	0x65, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
-	0x72, 0x65, 0x61, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2a,
-	0x0a, 0x11, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x77, 0x72, 0x69, 0x74, 0x65,
-	0x42, 0x79, 0x74, 0x65, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x53, 0x0a, 0x11, 0x54, 0x72,
-	0x61, 0x66, 0x66, 0x69, 0x63, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
-	0x12, 0x0a, 0x04, 0x61, 0x70, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61,
-	0x70, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x65, 0x61, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6c, 0x65, 0x61, 0x66, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
-	0xe6, 0x05, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x3f, 0x0a,
-	0x09, 0x70, 0x6f, 0x64, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x20, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x50, 0x6f, 0x64, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x48, 0x00, 0x52, 0x08, 0x70, 0x6f, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x1a, 0x8e,
-	0x05, 0x0a, 0x08, 0x50, 0x6f, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x38, 0x0a, 0x04, 0x72,
-	0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x6e, 0x6b,
-	0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x54, 0x61, 0x62,
-	0x6c, 0x65, 0x2e, 0x50, 0x6f, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x52, 0x6f, 0x77, 0x52,
-	0x04, 0x72, 0x6f, 0x77, 0x73, 0x1a, 0xc7, 0x04, 0x0a, 0x03, 0x52, 0x6f, 0x77, 0x12, 0x32, 0x0a,
-	0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64,
-	0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x65,
-	0x73, 0x68, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x64, 0x50, 0x6f, 0x64, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
-	0x70, 0x6f, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0f, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x28, 0x0a, 0x10, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x64, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x66, 0x61, 0x69, 0x6c,
-	0x65, 0x64, 0x50, 0x6f, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x12, 0x36, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e,
+	0x76, 0x69, 0x7a, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x6c, 0x61, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x01, 0x52, 0x10, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x51, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x10, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72,
+	0x61, 0x6d, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x62, 0x72, 0x65,
+	0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x42, 0x0a, 0x0a,
+	0x08, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x62, 0x0a, 0x09, 0x54, 0x69, 0x6d,
+	0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x2d,
+	0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x22, 0x5b, 0x0a,
+	0x0b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2e, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x69, 0x6e, 0x6b,
 	0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x42, 0x61, 0x73, 0x69, 0x63, 0x53, 0x74,
-	0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x09, 0x74, 0x63,
-	0x70, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x54, 0x63, 0x70,
-	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x08, 0x74, 0x63, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
-	0x3a, 0x0a, 0x08, 0x74, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a,
-	0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x73, 0x52, 0x07, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x59, 0x0a, 0x0d, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x70, 0x6f, 0x64, 0x18, 0x07, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69,
-	0x7a, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x50, 0x6f, 0x64, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x2e, 0x52, 0x6f, 0x77, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x42,
-	0x79, 0x50, 0x6f, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x73, 0x42, 0x79, 0x50, 0x6f, 0x64, 0x1a, 0x57, 0x0a, 0x10, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73,
-	0x42, 0x79, 0x50, 0x6f, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69,
-	0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x50, 0x6f, 0x64, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42,
-	0x07, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x4b, 0x0a, 0x0c, 0x45, 0x64, 0x67, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x69, 0x6e,
-	0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x6c,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0xb2, 0x01, 0x0a, 0x0d, 0x45, 0x64, 0x67, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76,
-	0x69, 0x7a, 0x2e, 0x45, 0x64, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x2e, 0x4f, 0x6b, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x33, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0xce, 0x01, 0x0a, 0x13, 0x53,
+	0x74, 0x61, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x36, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x4f, 0x6b, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x33, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a,
+	0x3e, 0x0a, 0x02, 0x4f, 0x6b, 0x12, 0x38, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x6e,
+	0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x42,
+	0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x82, 0x04, 0x0a, 0x0a,
+	0x42, 0x61, 0x73, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x6d, 0x73, 0x5f, 0x70, 0x35, 0x30, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6c, 0x61,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x50, 0x35, 0x30, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x61,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x73, 0x5f, 0x70, 0x39, 0x35, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x50, 0x39, 0x35,
+	0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x73, 0x5f, 0x70,
+	0x39, 0x39, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x4d, 0x73, 0x50, 0x39, 0x39, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c,
+	0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x63, 0x74, 0x75,
+	0x61, 0x6c, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4a, 0x0a, 0x11, 0x6c, 0x61,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32,
+	0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x51, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x6c, 0x65, 0x52, 0x10, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x51, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x11, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a,
+	0x2e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x10,
+	0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d,
+	0x12, 0x3e, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72,
+	0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x22, 0x4c, 0x0a, 0x0f, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x51, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x22, 0x99,
+	0x01, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x74, 0x74, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x72, 0x70, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x66, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x35, 0x0a, 0x0d, 0x4c, 0x61,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x8b, 0x01, 0x0a, 0x08, 0x54, 0x63, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x29,
+	0x0a, 0x10, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x6e, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x61,
+	0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x61, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
+	0x77, 0x72, 0x69, 0x74, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x22,
+	0x53, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x70, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x61, 0x70, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x65, 0x61, 0x66,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x65, 0x61, 0x66, 0x12, 0x16, 0x0a, 0x06,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x22, 0x99, 0x06, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x74, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x70, 0x6f, 0x64, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32,
+	0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x50,
+	0x6f, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x48, 0x00, 0x52, 0x08, 0x70, 0x6f, 0x64, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x1a, 0xc1, 0x05, 0x0a, 0x08, 0x50, 0x6f, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x12, 0x38, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x50, 0x6f, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x2e, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x1a, 0xfa, 0x04, 0x0a, 0x03, 0x52,
+	0x6f, 0x77, 0x12, 0x32, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e,
+	0x76, 0x69, 0x7a, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x69, 0x6d,
+	0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x28, 0x0a, 0x10, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x64, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x65, 0x73, 0x68, 0x65,
+	0x64, 0x50, 0x6f, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x75, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x64,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f,
+	0x70, 0x6f, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x50, 0x6f, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x2e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x42, 0x61,
+	0x73, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x33, 0x0a, 0x09, 0x74, 0x63, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69,
+	0x7a, 0x2e, 0x54, 0x63, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x08, 0x74, 0x63, 0x70, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x08, 0x74, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64,
+	0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x53, 0x70, 0x6c,
+	0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x07, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x59, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x70, 0x6f,
+	0x64, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72,
+	0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x2e, 0x50, 0x6f, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x52, 0x6f, 0x77, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x42, 0x79, 0x50, 0x6f, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x42, 0x79, 0x50, 0x6f, 0x64, 0x12, 0x31, 0x0a, 0x06, 0x73,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x57,
+	0x0a, 0x10, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x42, 0x79, 0x50, 0x6f, 0x64, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76,
+	0x69, 0x7a, 0x2e, 0x50, 0x6f, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x22, 0x4b, 0x0a, 0x0c, 0x45, 0x64, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x3b, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69,
+	0x7a, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0xb2, 0x01,
+	0x0a, 0x0d, 0x45, 0x64, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x30, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x45, 0x64, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x6b, 0x48, 0x00, 0x52, 0x02, 0x6f,
+	0x6b, 0x12, 0x33, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x2e, 0x0a, 0x02, 0x4f, 0x6b, 0x12, 0x28, 0x0a, 0x05,
+	0x65, 0x64, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x45, 0x64, 0x67, 0x65, 0x52,
+	0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xbc, 0x01, 0x0a, 0x04, 0x45, 0x64, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x03, 0x73,
+	0x72, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65,
+	0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x03, 0x73, 0x72, 0x63, 0x12, 0x28, 0x0a, 0x03, 0x64, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69,
+	0x7a, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x03, 0x64, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x6f, 0x5f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6e, 0x6f, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4d, 0x73,
+	0x67, 0x22, 0x9f, 0x03, 0x0a, 0x10, 0x54, 0x6f, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65,
+	0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x12, 0x29, 0x0a, 0x04, 0x6e, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69,
+	0x7a, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x6f, 0x6e, 0x65, 0x12,
+	0x39, 0x0a, 0x0b, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e,
+	0x76, 0x69, 0x7a, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x0a,
+	0x74, 0x6f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x01, 0x52, 0x10, 0x6c,
+	0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x12,
+	0x2b, 0x0a, 0x11, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x67, 0x72, 0x61, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6c, 0x61, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x29, 0x0a, 0x10,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x72,
+	0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x22, 0xc2, 0x01, 0x0a, 0x11, 0x54, 0x6f, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x65, 0x72, 0x72,
 	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65,
 	0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x2e,
-	0x0a, 0x02, 0x4f, 0x6b, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76,
-	0x69, 0x7a, 0x2e, 0x45, 0x64, 0x67, 0x65, 0x52, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x42, 0x0a,
-	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbc, 0x01, 0x0a, 0x04, 0x45,
-	0x64, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x03, 0x73, 0x72, 0x63, 0x12, 0x28, 0x0a,
-	0x03, 0x64, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x69, 0x6e,
-	0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x52, 0x03, 0x64, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x6f, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x5f, 0x6d, 0x73, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x6f, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4d, 0x73, 0x67, 0x22, 0xe2, 0x01, 0x0a, 0x10, 0x54, 0x6f,
-	0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b,
-	0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
-	0x69, 0x6d, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x29, 0x0a, 0x04,
-	0x6e, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x69, 0x6e,
-	0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48,
-	0x00, 0x52, 0x04, 0x6e, 0x6f, 0x6e, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x74, 0x6f, 0x5f, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c,
-	0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0xc2,
-	0x01, 0x0a, 0x11, 0x54, 0x6f, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76,
-	0x69, 0x7a, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x34, 0x0a, 0x02, 0x6f, 0x6b, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32,
-	0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x54, 0x6f, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x6b, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x1a,
-	0x36, 0x0a, 0x02, 0x4f, 0x6b, 0x12, 0x30, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32,
-	0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52,
-	0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0xe7, 0x01, 0x0a, 0x0a, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x61, 0x62,
-	0x6c, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e,
-	0x52, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x52, 0x6f, 0x77, 0x52, 0x04,
-	0x72, 0x6f, 0x77, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x1a, 0x8a, 0x01, 0x0a, 0x03, 0x52, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x1f,
-	0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12,
-	0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2e, 0x0a,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c,
-	0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x42, 0x61, 0x73, 0x69,
-	0x63, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0xd2, 0x02,
-	0x0a, 0x0d, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12,
-	0x33, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x34,
+	0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c, 0x69, 0x6e,
+	0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x54, 0x6f, 0x70, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x6b, 0x48, 0x00,
+	0x52, 0x02, 0x6f, 0x6b, 0x1a, 0x36, 0x0a, 0x02, 0x4f, 0x6b, 0x12, 0x30, 0x0a, 0x06, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x69, 0x6e,
+	0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x42, 0x0a, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9a, 0x02, 0x0a, 0x0a, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32,
+	0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x2e,
+	0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x1a, 0xbd, 0x01, 0x0a, 0x03, 0x52, 0x6f, 0x77, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a,
+	0x2e, 0x42, 0x61, 0x73, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69,
+	0x7a, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x06, 0x73,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0xd2, 0x02, 0x0a, 0x0d, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x73, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32,
+	0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x2e, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x1a, 0x8b, 0x02, 0x0a,
+	0x03, 0x52, 0x6f, 0x77, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x69,
+	0x72, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x61, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x73, 0x5f, 0x70, 0x35, 0x30, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x50, 0x35, 0x30, 0x12, 0x24,
+	0x0a, 0x0e, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x73, 0x5f, 0x70, 0x39, 0x35,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d,
+	0x73, 0x50, 0x39, 0x35, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x6d, 0x73, 0x5f, 0x70, 0x39, 0x39, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6c, 0x61,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x50, 0x39, 0x39, 0x22, 0x8f, 0x01, 0x0a, 0x0f, 0x47,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e,
+	0x0a, 0x13, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b,
+	0x0a, 0x11, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0xd2, 0x01, 0x0a,
+	0x10, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x33, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
 	0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x47, 0x61, 0x74,
-	0x65, 0x77, 0x61, 0x79, 0x73, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x52, 0x6f, 0x77, 0x52, 0x04,
-	0x72, 0x6f, 0x77, 0x73, 0x1a, 0x8b, 0x02, 0x0a, 0x03, 0x52, 0x6f, 0x77, 0x12, 0x1c, 0x0a, 0x09,
-	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21,
-	0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x70, 0x61, 0x69, 0x72,
-	0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c,
-	0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x76, 0x65,
-	0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x73, 0x5f, 0x70,
-	0x35, 0x30, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63,
-	0x79, 0x4d, 0x73, 0x50, 0x35, 0x30, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63,
-	0x79, 0x5f, 0x6d, 0x73, 0x5f, 0x70, 0x39, 0x35, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
-	0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x50, 0x39, 0x35, 0x12, 0x24, 0x0a, 0x0e,
-	0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x73, 0x5f, 0x70, 0x39, 0x39, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x50,
-	0x39, 0x39, 0x22, 0x8f, 0x01, 0x0a, 0x0f, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
-	0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x10, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64,
-	0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x57, 0x69,
-	0x6e, 0x64, 0x6f, 0x77, 0x22, 0xd2, 0x01, 0x0a, 0x10, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x02, 0x6f, 0x6b, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32,
+	0x65, 0x77, 0x61, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x6b,
+	0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x33, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32,
+	0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x48, 0x0a, 0x02, 0x4f,
+	0x6b, 0x12, 0x42, 0x0a, 0x0e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x5f, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x73, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0d, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x3d, 0x0a, 0x1d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x22, 0xef, 0x01, 0x0a, 0x1e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x6b,
+	0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x33, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32,
+	0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x49, 0x0a, 0x02, 0x4f,
+	0x6b, 0x12, 0x43, 0x0a, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32,
+	0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x0a, 0x6f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x97, 0x06, 0x0a, 0x15, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x2e,
+	0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x5b, 0x0a, 0x0c,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x38, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69,
+	0x7a, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52,
+	0x61, 0x74, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x0b, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x61, 0x74, 0x65, 0x12, 0x4e, 0x0a, 0x07, 0x6c, 0x61, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6c, 0x69, 0x6e,
+	0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x2e,
+	0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x52, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x1a, 0x9e, 0x02, 0x0a, 0x14, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x61, 0x74, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x66, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0b, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a,
+	0x16, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65,
+	0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x75, 0x72, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x62, 0x75, 0x72, 0x6e, 0x52, 0x61, 0x74, 0x65,
+	0x12, 0x28, 0x0a, 0x10, 0x62, 0x75, 0x72, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x75, 0x72, 0x6e,
+	0x52, 0x61, 0x74, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x1a, 0x9f, 0x01, 0x0a, 0x10, 0x4c,
+	0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x6d, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x4d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d,
+	0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03,
+	0x6d, 0x65, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6e, 0x6f, 0x44, 0x61, 0x74, 0x61, 0x2a, 0x2a, 0x0a, 0x0b,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f,
+	0x4b, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x41, 0x49, 0x4c, 0x10, 0x01, 0x12, 0x09, 0x0a,
+	0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02, 0x32, 0xa9, 0x05, 0x0a, 0x03, 0x41, 0x70, 0x69,
+	0x12, 0x54, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12,
+	0x20, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x05, 0x45, 0x64, 0x67, 0x65, 0x73, 0x12,
+	0x1a, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x45,
+	0x64, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x45, 0x64, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x08, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x12, 0x1d, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64,
+	0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32,
 	0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x6b, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x33,
-	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x1a, 0x48, 0x0a, 0x02, 0x4f, 0x6b, 0x12, 0x42, 0x0a, 0x0e, 0x67, 0x61, 0x74,
-	0x65, 0x77, 0x61, 0x79, 0x73, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a,
-	0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0d,
-	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x0a, 0x0a,
-	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x2a, 0x0a, 0x0b, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00,
-	0x12, 0x08, 0x0a, 0x04, 0x46, 0x41, 0x49, 0x4c, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52,
-	0x52, 0x4f, 0x52, 0x10, 0x02, 0x32, 0xb2, 0x04, 0x0a, 0x03, 0x41, 0x70, 0x69, 0x12, 0x54, 0x0a,
-	0x0b, 0x53, 0x74, 0x61, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x20, 0x2e, 0x6c,
-	0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
-	0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x53, 0x74,
-	0x61, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x05, 0x45, 0x64, 0x67, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x6c,
-	0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x45, 0x64, 0x67, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65,
-	0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x45, 0x64, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x08, 0x47, 0x61, 0x74, 0x65, 0x77,
-	0x61, 0x79, 0x73, 0x12, 0x1d, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76,
-	0x69, 0x7a, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x09, 0x54, 0x6f, 0x70, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e,
+	0x76, 0x69, 0x7a, 0x2e, 0x54, 0x6f, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e,
+	0x76, 0x69, 0x7a, 0x2e, 0x54, 0x6f, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x6f, 0x64, 0x73, 0x12, 0x1d, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76,
+	0x69, 0x7a, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69,
-	0x7a, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x09, 0x54, 0x6f, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x73, 0x12, 0x1e, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a,
-	0x2e, 0x54, 0x6f, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a,
-	0x2e, 0x54, 0x6f, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x64, 0x73,
-	0x12, 0x1d, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1e, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x50, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x57, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x12, 0x21, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e,
+	0x7a, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e,
 	0x76, 0x69, 0x7a, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x09, 0x53, 0x65,
-	0x6c, 0x66, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x1e, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72,
-	0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x53, 0x65, 0x6c, 0x66, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72,
-	0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x53, 0x65, 0x6c, 0x66, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64,
-	0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2f, 0x76, 0x69, 0x7a, 0x2f, 0x6d, 0x65,
-	0x74, 0x72, 0x69, 0x63, 0x73, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x76, 0x69,
-	0x7a, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72,
+	0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a,
+	0x09, 0x53, 0x65, 0x6c, 0x66, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x1e, 0x2e, 0x6c, 0x69, 0x6e,
+	0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x53, 0x65, 0x6c, 0x66, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6c, 0x69, 0x6e,
+	0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x53, 0x65, 0x6c, 0x66, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x75, 0x0a,
+	0x16, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72,
+	0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e,
+	0x76, 0x69, 0x7a, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x65,
+	0x72, 0x64, 0x32, 0x2f, 0x76, 0x69, 0x7a, 0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2d,
+	0x61, 0x70, 0x69, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x76, 0x69, 0x7a, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3765,58 +4805,69 @@ func file_viz_proto_rawDescGZIP() []byte {
 }
 
 var file_viz_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_viz_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
+var file_viz_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
 var file_viz_proto_goTypes = []interface{}{
-	(CheckStatus)(0),                          // 0: linkerd2.viz.CheckStatus
-	(HttpMethod_Registered)(0),                // 1: linkerd2.viz.HttpMethod.Registered
-	(Scheme_Registered)(0),                    // 2: linkerd2.viz.Scheme.Registered
-	(*Empty)(nil),                             // 3: linkerd2.viz.Empty
-	(*CheckResult)(nil),                       // 4: linkerd2.viz.CheckResult
-	(*SelfCheckRequest)(nil),                  // 5: linkerd2.viz.SelfCheckRequest
-	(*SelfCheckResponse)(nil),                 // 6: linkerd2.viz.SelfCheckResponse
-	(*ListServicesRequest)(nil),               // 7: linkerd2.viz.ListServicesRequest
-	(*ListServicesResponse)(nil),              // 8: linkerd2.viz.ListServicesResponse
-	(*Service)(nil),                           // 9: linkerd2.viz.Service
-	(*ListPodsRequest)(nil),                   // 10: linkerd2.viz.ListPodsRequest
-	(*ListPodsResponse)(nil),                  // 11: linkerd2.viz.ListPodsResponse
-	(*Pod)(nil),                               // 12: linkerd2.viz.Pod
-	(*HttpMethod)(nil),                        // 13: linkerd2.viz.HttpMethod
-	(*Scheme)(nil),                            // 14: linkerd2.viz.Scheme
-	(*Headers)(nil),                           // 15: linkerd2.viz.Headers
-	(*Eos)(nil),                               // 16: linkerd2.viz.Eos
-	(*ApiError)(nil),                          // 17: linkerd2.viz.ApiError
-	(*PodErrors)(nil),                         // 18: linkerd2.viz.PodErrors
-	(*Resource)(nil),                          // 19: linkerd2.viz.Resource
-	(*ResourceSelection)(nil),                 // 20: linkerd2.viz.ResourceSelection
-	(*ResourceError)(nil),                     // 21: linkerd2.viz.ResourceError
-	(*StatSummaryRequest)(nil),                // 22: linkerd2.viz.StatSummaryRequest
-	(*StatSummaryResponse)(nil),               // 23: linkerd2.viz.StatSummaryResponse
-	(*BasicStats)(nil),                        // 24: linkerd2.viz.BasicStats
-	(*TcpStats)(nil),                          // 25: linkerd2.viz.TcpStats
-	(*TrafficSplitStats)(nil),                 // 26: linkerd2.viz.TrafficSplitStats
-	(*StatTable)(nil),                         // 27: linkerd2.viz.StatTable
-	(*EdgesRequest)(nil),                      // 28: linkerd2.viz.EdgesRequest
-	(*EdgesResponse)(nil),                     // 29: linkerd2.viz.EdgesResponse
-	(*Edge)(nil),                              // 30: linkerd2.viz.Edge
-	(*TopRoutesRequest)(nil),                  // 31: linkerd2.viz.TopRoutesRequest
-	(*TopRoutesResponse)(nil),                 // 32: linkerd2.viz.TopRoutesResponse
-	(*RouteTable)(nil),                        // 33: linkerd2.viz.RouteTable
-	(*GatewaysTable)(nil),                     // 34: linkerd2.viz.GatewaysTable
-	(*GatewaysRequest)(nil),                   // 35: linkerd2.viz.GatewaysRequest
-	(*GatewaysResponse)(nil),                  // 36: linkerd2.viz.GatewaysResponse
-	(*Headers_Header)(nil),                    // 37: linkerd2.viz.Headers.Header
-	(*PodErrors_PodError)(nil),                // 38: linkerd2.viz.PodErrors.PodError
-	(*PodErrors_PodError_ContainerError)(nil), // 39: linkerd2.viz.PodErrors.PodError.ContainerError
-	(*StatSummaryResponse_Ok)(nil),            // 40: linkerd2.viz.StatSummaryResponse.Ok
-	(*StatTable_PodGroup)(nil),                // 41: linkerd2.viz.StatTable.PodGroup
-	(*StatTable_PodGroup_Row)(nil),            // 42: linkerd2.viz.StatTable.PodGroup.Row
-	nil,                                       // 43: linkerd2.viz.StatTable.PodGroup.Row.ErrorsByPodEntry
-	(*EdgesResponse_Ok)(nil),                  // 44: linkerd2.viz.EdgesResponse.Ok
-	(*TopRoutesResponse_Ok)(nil),              // 45: linkerd2.viz.TopRoutesResponse.Ok
-	(*RouteTable_Row)(nil),                    // 46: linkerd2.viz.RouteTable.Row
-	(*GatewaysTable_Row)(nil),                 // 47: linkerd2.viz.GatewaysTable.Row
-	(*GatewaysResponse_Ok)(nil),               // 48: linkerd2.viz.GatewaysResponse.Ok
-	(*duration.Duration)(nil),                 // 49: google.protobuf.Duration
+	(CheckStatus)(0),                                   // 0: linkerd2.viz.CheckStatus
+	(HttpMethod_Registered)(0),                         // 1: linkerd2.viz.HttpMethod.Registered
+	(Scheme_Registered)(0),                             // 2: linkerd2.viz.Scheme.Registered
+	(*Empty)(nil),                                      // 3: linkerd2.viz.Empty
+	(*CheckResult)(nil),                                // 4: linkerd2.viz.CheckResult
+	(*SelfCheckRequest)(nil),                           // 5: linkerd2.viz.SelfCheckRequest
+	(*SelfCheckResponse)(nil),                          // 6: linkerd2.viz.SelfCheckResponse
+	(*ListServicesRequest)(nil),                        // 7: linkerd2.viz.ListServicesRequest
+	(*ListServicesResponse)(nil),                       // 8: linkerd2.viz.ListServicesResponse
+	(*Service)(nil),                                    // 9: linkerd2.viz.Service
+	(*ListPodsRequest)(nil),                            // 10: linkerd2.viz.ListPodsRequest
+	(*ListPodsResponse)(nil),                           // 11: linkerd2.viz.ListPodsResponse
+	(*Pod)(nil),                                        // 12: linkerd2.viz.Pod
+	(*HttpMethod)(nil),                                 // 13: linkerd2.viz.HttpMethod
+	(*Scheme)(nil),                                     // 14: linkerd2.viz.Scheme
+	(*Headers)(nil),                                    // 15: linkerd2.viz.Headers
+	(*Eos)(nil),                                        // 16: linkerd2.viz.Eos
+	(*ApiError)(nil),                                   // 17: linkerd2.viz.ApiError
+	(*PodErrors)(nil),                                  // 18: linkerd2.viz.PodErrors
+	(*Resource)(nil),                                   // 19: linkerd2.viz.Resource
+	(*ResourceSelection)(nil),                          // 20: linkerd2.viz.ResourceSelection
+	(*ResourceError)(nil),                              // 21: linkerd2.viz.ResourceError
+	(*StatSummaryRequest)(nil),                         // 22: linkerd2.viz.StatSummaryRequest
+	(*TimeRange)(nil),                                  // 23: linkerd2.viz.TimeRange
+	(*StatsSample)(nil),                                // 24: linkerd2.viz.StatsSample
+	(*StatSummaryResponse)(nil),                        // 25: linkerd2.viz.StatSummaryResponse
+	(*BasicStats)(nil),                                 // 26: linkerd2.viz.BasicStats
+	(*LatencyQuantile)(nil),                            // 27: linkerd2.viz.LatencyQuantile
+	(*StatusCount)(nil),                                // 28: linkerd2.viz.StatusCount
+	(*LatencyBucket)(nil),                              // 29: linkerd2.viz.LatencyBucket
+	(*TcpStats)(nil),                                   // 30: linkerd2.viz.TcpStats
+	(*TrafficSplitStats)(nil),                          // 31: linkerd2.viz.TrafficSplitStats
+	(*StatTable)(nil),                                  // 32: linkerd2.viz.StatTable
+	(*EdgesRequest)(nil),                               // 33: linkerd2.viz.EdgesRequest
+	(*EdgesResponse)(nil),                              // 34: linkerd2.viz.EdgesResponse
+	(*Edge)(nil),                                       // 35: linkerd2.viz.Edge
+	(*TopRoutesRequest)(nil),                           // 36: linkerd2.viz.TopRoutesRequest
+	(*TopRoutesResponse)(nil),                          // 37: linkerd2.viz.TopRoutesResponse
+	(*RouteTable)(nil),                                 // 38: linkerd2.viz.RouteTable
+	(*GatewaysTable)(nil),                              // 39: linkerd2.viz.GatewaysTable
+	(*GatewaysRequest)(nil),                            // 40: linkerd2.viz.GatewaysRequest
+	(*GatewaysResponse)(nil),                           // 41: linkerd2.viz.GatewaysResponse
+	(*ServiceLevelObjectivesRequest)(nil),              // 42: linkerd2.viz.ServiceLevelObjectivesRequest
+	(*ServiceLevelObjectivesResponse)(nil),             // 43: linkerd2.viz.ServiceLevelObjectivesResponse
+	(*ServiceLevelObjective)(nil),                      // 44: linkerd2.viz.ServiceLevelObjective
+	(*Headers_Header)(nil),                             // 45: linkerd2.viz.Headers.Header
+	(*PodErrors_PodError)(nil),                         // 46: linkerd2.viz.PodErrors.PodError
+	(*PodErrors_PodError_ContainerError)(nil),          // 47: linkerd2.viz.PodErrors.PodError.ContainerError
+	(*StatSummaryResponse_Ok)(nil),                     // 48: linkerd2.viz.StatSummaryResponse.Ok
+	(*StatTable_PodGroup)(nil),                         // 49: linkerd2.viz.StatTable.PodGroup
+	(*StatTable_PodGroup_Row)(nil),                     // 50: linkerd2.viz.StatTable.PodGroup.Row
+	nil,                                                // 51: linkerd2.viz.StatTable.PodGroup.Row.ErrorsByPodEntry
+	(*EdgesResponse_Ok)(nil),                           // 52: linkerd2.viz.EdgesResponse.Ok
+	(*TopRoutesResponse_Ok)(nil),                       // 53: linkerd2.viz.TopRoutesResponse.Ok
+	(*RouteTable_Row)(nil),                             // 54: linkerd2.viz.RouteTable.Row
+	(*GatewaysTable_Row)(nil),                          // 55: linkerd2.viz.GatewaysTable.Row
+	(*GatewaysResponse_Ok)(nil),                        // 56: linkerd2.viz.GatewaysResponse.Ok
+	(*ServiceLevelObjectivesResponse_Ok)(nil),          // 57: linkerd2.viz.ServiceLevelObjectivesResponse.Ok
+	(*ServiceLevelObjective_SuccessRateObjective)(nil), // 58: linkerd2.viz.ServiceLevelObjective.SuccessRateObjective
+	(*ServiceLevelObjective_LatencyObjective)(nil),     // 59: linkerd2.viz.ServiceLevelObjective.LatencyObjective
+	(*duration.Duration)(nil),                          // 60: google.protobuf.Duration
 }
 var file_viz_proto_depIdxs = []int32{
 	0,  // 0: linkerd2.viz.CheckResult.Status:type_name -> linkerd2.viz.CheckStatus
@@ -3824,67 +4875,84 @@ var file_viz_proto_depIdxs = []int32{
 	9,  // 2: linkerd2.viz.ListServicesResponse.services:type_name -> linkerd2.viz.Service
 	20, // 3: linkerd2.viz.ListPodsRequest.selector:type_name -> linkerd2.viz.ResourceSelection
 	12, // 4: linkerd2.viz.ListPodsResponse.pods:type_name -> linkerd2.viz.Pod
-	49, // 5: linkerd2.viz.Pod.sinceLastReport:type_name -> google.protobuf.Duration
-	49, // 6: linkerd2.viz.Pod.uptime:type_name -> google.protobuf.Duration
+	60, // 5: linkerd2.viz.Pod.sinceLastReport:type_name -> google.protobuf.Duration
+	60, // 6: linkerd2.viz.Pod.uptime:type_name -> google.protobuf.Duration
 	1,  // 7: linkerd2.viz.HttpMethod.registered:type_name -> linkerd2.viz.HttpMethod.Registered
 	2,  // 8: linkerd2.viz.Scheme.registered:type_name -> linkerd2.viz.Scheme.Registered
-	37, // 9: linkerd2.viz.Headers.headers:type_name -> linkerd2.viz.Headers.Header
-	38, // 10: linkerd2.viz.PodErrors.errors:type_name -> linkerd2.viz.PodErrors.PodError
+	45, // 9: linkerd2.viz.Headers.headers:type_name -> linkerd2.viz.Headers.Header
+	46, // 10: linkerd2.viz.PodErrors.errors:type_name -> linkerd2.viz.PodErrors.PodError
 	19, // 11: linkerd2.viz.ResourceSelection.resource:type_name -> linkerd2.viz.Resource
 	19, // 12: linkerd2.viz.ResourceError.resource:type_name -> linkerd2.viz.Resource
 	20, // 13: linkerd2.viz.StatSummaryRequest.selector:type_name -> linkerd2.viz.ResourceSelection
 	3,  // 14: linkerd2.viz.StatSummaryRequest.none:type_name -> linkerd2.viz.Empty
 	19, // 15: linkerd2.viz.StatSummaryRequest.to_resource:type_name -> linkerd2.viz.Resource
 	19, // 16: linkerd2.viz.StatSummaryRequest.from_resource:type_name -> linkerd2.viz.Resource
-	40, // 17: linkerd2.viz.StatSummaryResponse.ok:type_name -> linkerd2.viz.StatSummaryResponse.Ok
-	21, // 18: linkerd2.viz.StatSummaryResponse.error:type_name -> linkerd2.viz.ResourceError
-	41, // 19: linkerd2.viz.StatTable.pod_group:type_name -> linkerd2.viz.StatTable.PodGroup
-	20, // 20: linkerd2.viz.EdgesRequest.selector:type_name -> linkerd2.viz.ResourceSelection
-	44, // 21: linkerd2.viz.EdgesResponse.ok:type_name -> linkerd2.viz.EdgesResponse.Ok
-	21, // 22: linkerd2.viz.EdgesResponse.error:type_name -> linkerd2.viz.ResourceError
-	19, // 23: linkerd2.viz.Edge.src:type_name -> linkerd2.viz.Resource
-	19, // 24: linkerd2.viz.Edge.dst:type_name -> linkerd2.viz.Resource
-	20, // 25: linkerd2.viz.TopRoutesRequest.selector:type_name -> linkerd2.viz.ResourceSelection
-	3,  // 26: linkerd2.viz.TopRoutesRequest.none:type_name -> linkerd2.viz.Empty
-	19, // 27: linkerd2.viz.TopRoutesRequest.to_resource:type_name -> linkerd2.viz.Resource
-	21, // 28: linkerd2.viz.TopRoutesResponse.error:type_name -> linkerd2.viz.ResourceError
-	45, // 29: linkerd2.viz.TopRoutesResponse.ok:type_name -> linkerd2.viz.TopRoutesResponse.Ok
-	46, // 30: linkerd2.viz.RouteTable.rows:type_name -> linkerd2.viz.RouteTable.Row
-	47, // 31: linkerd2.viz.GatewaysTable.rows:type_name -> linkerd2.viz.GatewaysTable.Row
-	48, // 32: linkerd2.viz.GatewaysResponse.ok:type_name -> linkerd2.viz.GatewaysResponse.Ok
-	21, // 33: linkerd2.viz.GatewaysResponse.error:type_name -> linkerd2.viz.ResourceError
-	39, // 34: linkerd2.viz.PodErrors.PodError.container:type_name -> linkerd2.viz.PodErrors.PodError.ContainerError
-	27, // 35: linkerd2.viz.StatSummaryResponse.Ok.stat_tables:type_name -> linkerd2.viz.StatTable
-	42, // 36: linkerd2.viz.StatTable.PodGroup.rows:type_name -> linkerd2.viz.StatTable.PodGroup.Row
-	19, // 37: linkerd2.viz.StatTable.PodGroup.Row.resource:type_name -> linkerd2.viz.Resource
-	24, // 38: linkerd2.viz.StatTable.PodGroup.Row.stats:type_name -> linkerd2.viz.BasicStats
-	25, // 39: linkerd2.viz.StatTable.PodGroup.Row.tcp_stats:type_name -> linkerd2.viz.TcpStats
-	26, // 40: linkerd2.viz.StatTable.PodGroup.Row.ts_stats:type_name -> linkerd2.viz.TrafficSplitStats
-	43, // 41: linkerd2.viz.StatTable.PodGroup.Row.errors_by_pod:type_name -> linkerd2.viz.StatTable.PodGroup.Row.ErrorsByPodEntry
-	18, // 42: linkerd2.viz.StatTable.PodGroup.Row.ErrorsByPodEntry.value:type_name -> linkerd2.viz.PodErrors
-	30, // 43: linkerd2.viz.EdgesResponse.Ok.edges:type_name -> linkerd2.viz.Edge
-	33, // 44: linkerd2.viz.TopRoutesResponse.Ok.routes:type_name -> linkerd2.viz.RouteTable
-	24, // 45: linkerd2.viz.RouteTable.Row.stats:type_name -> linkerd2.viz.BasicStats
-	34, // 46: linkerd2.viz.GatewaysResponse.Ok.gateways_table:type_name -> linkerd2.viz.GatewaysTable
-	22, // 47: linkerd2.viz.Api.StatSummary:input_type -> linkerd2.viz.StatSummaryRequest
-	28, // 48: linkerd2.viz.Api.Edges:input_type -> linkerd2.viz.EdgesRequest
-	35, // 49: linkerd2.viz.Api.Gateways:input_type -> linkerd2.viz.GatewaysRequest
-	31, // 50: linkerd2.viz.Api.TopRoutes:input_type -> linkerd2.viz.TopRoutesRequest
-	10, // 51: linkerd2.viz.Api.ListPods:input_type -> linkerd2.viz.ListPodsRequest
-	7,  // 52: linkerd2.viz.Api.ListServices:input_type -> linkerd2.viz.ListServicesRequest
-	5,  // 53: linkerd2.viz.Api.SelfCheck:input_type -> linkerd2.viz.SelfCheckRequest
-	23, // 54: linkerd2.viz.Api.StatSummary:output_type -> linkerd2.viz.StatSummaryResponse
-	29, // 55: linkerd2.viz.Api.Edges:output_type -> linkerd2.viz.EdgesResponse
-	36, // 56: linkerd2.viz.Api.Gateways:output_type -> linkerd2.viz.GatewaysResponse
-	32, // 57: linkerd2.viz.Api.TopRoutes:output_type -> linkerd2.viz.TopRoutesResponse
-	11, // 58: linkerd2.viz.Api.ListPods:output_type -> linkerd2.viz.ListPodsResponse
-	8,  // 59: linkerd2.viz.Api.ListServices:output_type -> linkerd2.viz.ListServicesResponse
-	6,  // 60: linkerd2.viz.Api.SelfCheck:output_type -> linkerd2.viz.SelfCheckResponse
-	54, // [54:61] is the sub-list for method output_type
-	47, // [47:54] is the sub-list for method input_type
-	47, // [47:47] is the sub-list for extension type_name
-	47, // [47:47] is the sub-list for extension extendee
-	0,  // [0:47] is the sub-list for field type_name
+	23, // 17: linkerd2.viz.StatSummaryRequest.time_range:type_name -> linkerd2.viz.TimeRange
+	60, // 18: linkerd2.viz.TimeRange.step:type_name -> google.protobuf.Duration
+	26, // 19: linkerd2.viz.StatsSample.stats:type_name -> linkerd2.viz.BasicStats
+	48, // 20: linkerd2.viz.StatSummaryResponse.ok:type_name -> linkerd2.viz.StatSummaryResponse.Ok
+	21, // 21: linkerd2.viz.StatSummaryResponse.error:type_name -> linkerd2.viz.ResourceError
+	27, // 22: linkerd2.viz.BasicStats.latency_quantiles:type_name -> linkerd2.viz.LatencyQuantile
+	29, // 23: linkerd2.viz.BasicStats.latency_histogram:type_name -> linkerd2.viz.LatencyBucket
+	28, // 24: linkerd2.viz.BasicStats.status_counts:type_name -> linkerd2.viz.StatusCount
+	49, // 25: linkerd2.viz.StatTable.pod_group:type_name -> linkerd2.viz.StatTable.PodGroup
+	20, // 26: linkerd2.viz.EdgesRequest.selector:type_name -> linkerd2.viz.ResourceSelection
+	52, // 27: linkerd2.viz.EdgesResponse.ok:type_name -> linkerd2.viz.EdgesResponse.Ok
+	21, // 28: linkerd2.viz.EdgesResponse.error:type_name -> linkerd2.viz.ResourceError
+	19, // 29: linkerd2.viz.Edge.src:type_name -> linkerd2.viz.Resource
+	19, // 30: linkerd2.viz.Edge.dst:type_name -> linkerd2.viz.Resource
+	20, // 31: linkerd2.viz.TopRoutesRequest.selector:type_name -> linkerd2.viz.ResourceSelection
+	3,  // 32: linkerd2.viz.TopRoutesRequest.none:type_name -> linkerd2.viz.Empty
+	19, // 33: linkerd2.viz.TopRoutesRequest.to_resource:type_name -> linkerd2.viz.Resource
+	23, // 34: linkerd2.viz.TopRoutesRequest.time_range:type_name -> linkerd2.viz.TimeRange
+	21, // 35: linkerd2.viz.TopRoutesResponse.error:type_name -> linkerd2.viz.ResourceError
+	53, // 36: linkerd2.viz.TopRoutesResponse.ok:type_name -> linkerd2.viz.TopRoutesResponse.Ok
+	54, // 37: linkerd2.viz.RouteTable.rows:type_name -> linkerd2.viz.RouteTable.Row
+	55, // 38: linkerd2.viz.GatewaysTable.rows:type_name -> linkerd2.viz.GatewaysTable.Row
+	56, // 39: linkerd2.viz.GatewaysResponse.ok:type_name -> linkerd2.viz.GatewaysResponse.Ok
+	21, // 40: linkerd2.viz.GatewaysResponse.error:type_name -> linkerd2.viz.ResourceError
+	57, // 41: linkerd2.viz.ServiceLevelObjectivesResponse.ok:type_name -> linkerd2.viz.ServiceLevelObjectivesResponse.Ok
+	21, // 42: linkerd2.viz.ServiceLevelObjectivesResponse.error:type_name -> linkerd2.viz.ResourceError
+	19, // 43: linkerd2.viz.ServiceLevelObjective.target:type_name -> linkerd2.viz.Resource
+	58, // 44: linkerd2.viz.ServiceLevelObjective.success_rate:type_name -> linkerd2.viz.ServiceLevelObjective.SuccessRateObjective
+	59, // 45: linkerd2.viz.ServiceLevelObjective.latency:type_name -> linkerd2.viz.ServiceLevelObjective.LatencyObjective
+	47, // 46: linkerd2.viz.PodErrors.PodError.container:type_name -> linkerd2.viz.PodErrors.PodError.ContainerError
+	32, // 47: linkerd2.viz.StatSummaryResponse.Ok.stat_tables:type_name -> linkerd2.viz.StatTable
+	50, // 48: linkerd2.viz.StatTable.PodGroup.rows:type_name -> linkerd2.viz.StatTable.PodGroup.Row
+	19, // 49: linkerd2.viz.StatTable.PodGroup.Row.resource:type_name -> linkerd2.viz.Resource
+	26, // 50: linkerd2.viz.StatTable.PodGroup.Row.stats:type_name -> linkerd2.viz.BasicStats
+	30, // 51: linkerd2.viz.StatTable.PodGroup.Row.tcp_stats:type_name -> linkerd2.viz.TcpStats
+	31, // 52: linkerd2.viz.StatTable.PodGroup.Row.ts_stats:type_name -> linkerd2.viz.TrafficSplitStats
+	51, // 53: linkerd2.viz.StatTable.PodGroup.Row.errors_by_pod:type_name -> linkerd2.viz.StatTable.PodGroup.Row.ErrorsByPodEntry
+	24, // 54: linkerd2.viz.StatTable.PodGroup.Row.series:type_name -> linkerd2.viz.StatsSample
+	18, // 55: linkerd2.viz.StatTable.PodGroup.Row.ErrorsByPodEntry.value:type_name -> linkerd2.viz.PodErrors
+	35, // 56: linkerd2.viz.EdgesResponse.Ok.edges:type_name -> linkerd2.viz.Edge
+	38, // 57: linkerd2.viz.TopRoutesResponse.Ok.routes:type_name -> linkerd2.viz.RouteTable
+	26, // 58: linkerd2.viz.RouteTable.Row.stats:type_name -> linkerd2.viz.BasicStats
+	24, // 59: linkerd2.viz.RouteTable.Row.series:type_name -> linkerd2.viz.StatsSample
+	39, // 60: linkerd2.viz.GatewaysResponse.Ok.gateways_table:type_name -> linkerd2.viz.GatewaysTable
+	44, // 61: linkerd2.viz.ServiceLevelObjectivesResponse.Ok.objectives:type_name -> linkerd2.viz.ServiceLevelObjective
+	22, // 62: linkerd2.viz.Api.StatSummary:input_type -> linkerd2.viz.StatSummaryRequest
+	33, // 63: linkerd2.viz.Api.Edges:input_type -> linkerd2.viz.EdgesRequest
+	40, // 64: linkerd2.viz.Api.Gateways:input_type -> linkerd2.viz.GatewaysRequest
+	36, // 65: linkerd2.viz.Api.TopRoutes:input_type -> linkerd2.viz.TopRoutesRequest
+	10, // 66: linkerd2.viz.Api.ListPods:input_type -> linkerd2.viz.ListPodsRequest
+	7,  // 67: linkerd2.viz.Api.ListServices:input_type -> linkerd2.viz.ListServicesRequest
+	5,  // 68: linkerd2.viz.Api.SelfCheck:input_type -> linkerd2.viz.SelfCheckRequest
+	42, // 69: linkerd2.viz.Api.ServiceLevelObjectives:input_type -> linkerd2.viz.ServiceLevelObjectivesRequest
+	25, // 70: linkerd2.viz.Api.StatSummary:output_type -> linkerd2.viz.StatSummaryResponse
+	34, // 71: linkerd2.viz.Api.Edges:output_type -> linkerd2.viz.EdgesResponse
+	41, // 72: linkerd2.viz.Api.Gateways:output_type -> linkerd2.viz.GatewaysResponse
+	37, // 73: linkerd2.viz.Api.TopRoutes:output_type -> linkerd2.viz.TopRoutesResponse
+	11, // 74: linkerd2.viz.Api.ListPods:output_type -> linkerd2.viz.ListPodsResponse
+	8,  // 75: linkerd2.viz.Api.ListServices:output_type -> linkerd2.viz.ListServicesResponse
+	6,  // 76: linkerd2.viz.Api.SelfCheck:output_type -> linkerd2.viz.SelfCheckResponse
+	43, // 77: linkerd2.viz.Api.ServiceLevelObjectives:output_type -> linkerd2.viz.ServiceLevelObjectivesResponse
+	70, // [70:78] is the sub-list for method output_type
+	62, // [62:70] is the sub-list for method input_type
+	62, // [62:62] is the sub-list for extension type_name
+	62, // [62:62] is the sub-list for extension extendee
+	0,  // [0:62] is the sub-list for field type_name
 }
 
 func init() { file_viz_proto_init() }
@@ -4134,7 +5202,7 @@ func file_viz_proto_init() {
 			}
 		}
 		file_viz_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatSummaryResponse); i {
+			switch v := v.(*TimeRange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4146,7 +5214,7 @@ func file_viz_proto_init() {
 			}
 		}
 		file_viz_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BasicStats); i {
+			switch v := v.(*StatsSample); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4158,7 +5226,7 @@ func file_viz_proto_init() {
 			}
 		}
 		file_viz_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TcpStats); i {
+			switch v := v.(*StatSummaryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4170,7 +5238,7 @@ func file_viz_proto_init() {
 			}
 		}
 		file_viz_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrafficSplitStats); i {
+			switch v := v.(*BasicStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4182,7 +5250,7 @@ func file_viz_proto_init() {
 			}
 		}
 		file_viz_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatTable); i {
+			switch v := v.(*LatencyQuantile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4194,7 +5262,7 @@ func file_viz_proto_init() {
 			}
 		}
 		file_viz_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EdgesRequest); i {
+			switch v := v.(*StatusCount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4206,7 +5274,7 @@ func file_viz_proto_init() {
 			}
 		}
 		file_viz_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EdgesResponse); i {
+			switch v := v.(*LatencyBucket); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4218,7 +5286,7 @@ func file_viz_proto_init() {
 			}
 		}
 		file_viz_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Edge); i {
+			switch v := v.(*TcpStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4230,7 +5298,7 @@ func file_viz_proto_init() {
 			}
 		}
 		file_viz_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopRoutesRequest); i {
+			switch v := v.(*TrafficSplitStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4242,7 +5310,7 @@ func file_viz_proto_init() {
 			}
 		}
 		file_viz_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopRoutesResponse); i {
+			switch v := v.(*StatTable); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4254,7 +5322,7 @@ func file_viz_proto_init() {
 			}
 		}
 		file_viz_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RouteTable); i {
+			switch v := v.(*EdgesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4266,7 +5334,7 @@ func file_viz_proto_init() {
 			}
 		}
 		file_viz_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GatewaysTable); i {
+			switch v := v.(*EdgesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4278,7 +5346,7 @@ func file_viz_proto_init() {
 			}
 		}
 		file_viz_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GatewaysRequest); i {
+			switch v := v.(*Edge); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4290,7 +5358,7 @@ func file_viz_proto_init() {
 			}
 		}
 		file_viz_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GatewaysResponse); i {
+			switch v := v.(*TopRoutesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4302,7 +5370,7 @@ func file_viz_proto_init() {
 			}
 		}
 		file_viz_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Headers_Header); i {
+			switch v := v.(*TopRoutesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4314,7 +5382,7 @@ func file_viz_proto_init() {
 			}
 		}
 		file_viz_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PodErrors_PodError); i {
+			switch v := v.(*RouteTable); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4326,7 +5394,7 @@ func file_viz_proto_init() {
 			}
 		}
 		file_viz_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PodErrors_PodError_ContainerError); i {
+			switch v := v.(*GatewaysTable); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4338,7 +5406,7 @@ func file_viz_proto_init() {
 			}
 		}
 		file_viz_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatSummaryResponse_Ok); i {
+			switch v := v.(*GatewaysRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4350,7 +5418,7 @@ func file_viz_proto_init() {
 			}
 		}
 		file_viz_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatTable_PodGroup); i {
+			switch v := v.(*GatewaysResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4362,7 +5430,19 @@ func file_viz_proto_init() {
 			}
 		}
 		file_viz_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatTable_PodGroup_Row); i {
+			switch v := v.(*ServiceLevelObjectivesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_viz_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceLevelObjectivesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4374,7 +5454,7 @@ func file_viz_proto_init() {
 			}
 		}
 		file_viz_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EdgesResponse_Ok); i {
+			switch v := v.(*ServiceLevelObjective); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4386,7 +5466,7 @@ func file_viz_proto_init() {
 			}
 		}
 		file_viz_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopRoutesResponse_Ok); i {
+			switch v := v.(*Headers_Header); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4398,7 +5478,7 @@ func file_viz_proto_init() {
 			}
 		}
 		file_viz_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RouteTable_Row); i {
+			switch v := v.(*PodErrors_PodError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4410,7 +5490,7 @@ func file_viz_proto_init() {
 			}
 		}
 		file_viz_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GatewaysTable_Row); i {
+			switch v := v.(*PodErrors_PodError_ContainerError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4422,6 +5502,90 @@ func file_viz_proto_init() {
 			}
 		}
 		file_viz_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatSummaryResponse_Ok); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_viz_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatTable_PodGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_viz_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatTable_PodGroup_Row); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_viz_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EdgesResponse_Ok); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_viz_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopRoutesResponse_Ok); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_viz_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouteTable_Row); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_viz_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewaysTable_Row); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_viz_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GatewaysResponse_Ok); i {
 			case 0:
 				return &v.state
@@ -4433,6 +5597,42 @@ func file_viz_proto_init() {
 				return nil
 			}
 		}
+		file_viz_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceLevelObjectivesResponse_Ok); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_viz_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceLevelObjective_SuccessRateObjective); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_viz_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceLevelObjective_LatencyObjective); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_viz_proto_msgTypes[9].OneofWrappers = []interface{}{
 		(*Pod_Deployment)(nil),
@@ -4459,34 +5659,38 @@ func file_viz_proto_init() {
 		(*StatSummaryRequest_ToResource)(nil),
 		(*StatSummaryRequest_FromResource)(nil),
 	}
-	file_viz_proto_msgTypes[20].OneofWrappers = []interface{}{
+	file_viz_proto_msgTypes[22].OneofWrappers = []interface{}{
 		(*StatSummaryResponse_Ok_)(nil),
 		(*StatSummaryResponse_Error)(nil),
 	}
-	file_viz_proto_msgTypes[24].OneofWrappers = []interface{}{
+	file_viz_proto_msgTypes[29].OneofWrappers = []interface{}{
 		(*StatTable_PodGroup_)(nil),
 	}
-	file_viz_proto_msgTypes[26].OneofWrappers = []interface{}{
+	file_viz_proto_msgTypes[31].OneofWrappers = []interface{}{
 		(*EdgesResponse_Ok_)(nil),
 		(*EdgesResponse_Error)(nil),
 	}
-	file_viz_proto_msgTypes[28].OneofWrappers = []interface{}{
+	file_viz_proto_msgTypes[33].OneofWrappers = []interface{}{
 		(*TopRoutesRequest_None)(nil),
 		(*TopRoutesRequest_ToResource)(nil),
 	}
-	file_viz_proto_msgTypes[29].OneofWrappers = []interface{}{
+	file_viz_proto_msgTypes[34].OneofWrappers = []interface{}{
 		(*TopRoutesResponse_Error)(nil),
 		(*TopRoutesResponse_Ok_)(nil),
 	}
-	file_viz_proto_msgTypes[33].OneofWrappers = []interface{}{
+	file_viz_proto_msgTypes[38].OneofWrappers = []interface{}{
 		(*GatewaysResponse_Ok_)(nil),
 		(*GatewaysResponse_Error)(nil),
 	}
-	file_viz_proto_msgTypes[34].OneofWrappers = []interface{}{
+	file_viz_proto_msgTypes[40].OneofWrappers = []interface{}{
+		(*ServiceLevelObjectivesResponse_Ok_)(nil),
+		(*ServiceLevelObjectivesResponse_Error)(nil),
+	}
+	file_viz_proto_msgTypes[42].OneofWrappers = []interface{}{
 		(*Headers_Header_ValueStr)(nil),
 		(*Headers_Header_ValueBin)(nil),
 	}
-	file_viz_proto_msgTypes[35].OneofWrappers = []interface{}{
+	file_viz_proto_msgTypes[43].OneofWrappers = []interface{}{
 		(*PodErrors_PodError_Container)(nil),
 	}
 	type x struct{}
@@ -4495,7 +5699,7 @@ func file_viz_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_viz_proto_rawDesc,
 			NumEnums:      3,
-			NumMessages:   46,
+			NumMessages:   57,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
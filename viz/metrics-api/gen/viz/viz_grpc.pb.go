@@ -25,6 +25,7 @@ type ApiClient interface {
 	ListPods(ctx context.Context, in *ListPodsRequest, opts ...grpc.CallOption) (*ListPodsResponse, error)
 	ListServices(ctx context.Context, in *ListServicesRequest, opts ...grpc.CallOption) (*ListServicesResponse, error)
 	SelfCheck(ctx context.Context, in *SelfCheckRequest, opts ...grpc.CallOption) (*SelfCheckResponse, error)
+	ServiceLevelObjectives(ctx context.Context, in *ServiceLevelObjectivesRequest, opts ...grpc.CallOption) (*ServiceLevelObjectivesResponse, error)
 }
 
 type apiClient struct {
@@ -98,6 +99,15 @@ func (c *apiClient) SelfCheck(ctx context.Context, in *SelfCheckRequest, opts ..
 	return out, nil
 }
 
+func (c *apiClient) ServiceLevelObjectives(ctx context.Context, in *ServiceLevelObjectivesRequest, opts ...grpc.CallOption) (*ServiceLevelObjectivesResponse, error) {
+	out := new(ServiceLevelObjectivesResponse)
+	err := c.cc.Invoke(ctx, "/linkerd2.viz.Api/ServiceLevelObjectives", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApiServer is the server API for Api service.
 // All implementations must embed UnimplementedApiServer
 // for forward compatibility
@@ -109,6 +119,7 @@ type ApiServer interface {
 	ListPods(context.Context, *ListPodsRequest) (*ListPodsResponse, error)
 	ListServices(context.Context, *ListServicesRequest) (*ListServicesResponse, error)
 	SelfCheck(context.Context, *SelfCheckRequest) (*SelfCheckResponse, error)
+	ServiceLevelObjectives(context.Context, *ServiceLevelObjectivesRequest) (*ServiceLevelObjectivesResponse, error)
 	mustEmbedUnimplementedApiServer()
 }
 
@@ -137,6 +148,9 @@ func (UnimplementedApiServer) ListServices(context.Context, *ListServicesRequest
 func (UnimplementedApiServer) SelfCheck(context.Context, *SelfCheckRequest) (*SelfCheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SelfCheck not implemented")
 }
+func (UnimplementedApiServer) ServiceLevelObjectives(context.Context, *ServiceLevelObjectivesRequest) (*ServiceLevelObjectivesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceLevelObjectives not implemented")
+}
 func (UnimplementedApiServer) mustEmbedUnimplementedApiServer() {}
 
 // UnsafeApiServer may be embedded to opt out of forward compatibility for this service.
@@ -276,6 +290,24 @@ func _Api_SelfCheck_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Api_ServiceLevelObjectives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceLevelObjectivesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).ServiceLevelObjectives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkerd2.viz.Api/ServiceLevelObjectives",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).ServiceLevelObjectives(ctx, req.(*ServiceLevelObjectivesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Api_ServiceDesc is the grpc.ServiceDesc for Api service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -311,6 +343,10 @@ var Api_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SelfCheck",
 			Handler:    _Api_SelfCheck_Handler,
 		},
+		{
+			MethodName: "ServiceLevelObjectives",
+			Handler:    _Api_ServiceLevelObjectives_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "viz.proto",
@@ -16,6 +16,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/dynamic"
 )
 
 // Server specifies the interface the Viz metric API server should implement
@@ -30,6 +31,8 @@ type grpcServer struct {
 	controllerNamespace string
 	clusterDomain       string
 	ignoredNamespaces   []string
+	// dynamicClient lists the ServiceLevelObjectives
+	dynamicClient dynamic.Interface
 }
 
 type podReport struct {
@@ -48,6 +51,7 @@ const (
 func newGrpcServer(
 	promAPI promv1.API,
 	k8sAPI *k8s.API,
+	dynamicClient dynamic.Interface,
 	controllerNamespace string,
 	clusterDomain string,
 	ignoredNamespaces []string,
@@ -56,6 +60,7 @@ func newGrpcServer(
 	grpcServer := &grpcServer{
 		prometheusAPI:       promAPI,
 		k8sAPI:              k8sAPI,
+		dynamicClient:       dynamicClient,
 		controllerNamespace: controllerNamespace,
 		clusterDomain:       clusterDomain,
 		ignoredNamespaces:   ignoredNamespaces,
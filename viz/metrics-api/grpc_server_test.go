@@ -400,6 +400,7 @@ status:
 			fakeGrpcServer := newGrpcServer(
 				&mProm,
 				k8sAPI,
+				nil,
 				"linkerd",
 				"mycluster.local",
 				[]string{},
@@ -501,6 +502,7 @@ metadata:
 			fakeGrpcServer := newGrpcServer(
 				&prometheus.MockProm{},
 				k8sAPI,
+				nil,
 				"linkerd",
 				"mycluster.local",
 				[]string{},
@@ -12,6 +12,7 @@ import (
 	promApi "github.com/prometheus/client_golang/api"
 	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/dynamic"
 )
 
 var (
@@ -22,6 +23,7 @@ var (
 	listServicesPath = fullURLPathFor("ListServices")
 	selfCheckPath    = fullURLPathFor("SelfCheck")
 	edgesPath        = fullURLPathFor("Edges")
+	slosPath         = fullURLPathFor("ServiceLevelObjectives")
 )
 
 type handler struct {
@@ -54,6 +56,8 @@ func (h *handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		h.handleSelfCheck(w, req)
 	case edgesPath:
 		h.handleEdges(w, req)
+	case slosPath:
+		h.handleServiceLevelObjectives(w, req)
 	default:
 		http.NotFound(w, req)
 	}
@@ -123,6 +127,27 @@ func (h *handler) handleEdges(w http.ResponseWriter, req *http.Request) {
 	}
 }
 
+func (h *handler) handleServiceLevelObjectives(w http.ResponseWriter, req *http.Request) {
+	var protoRequest pb.ServiceLevelObjectivesRequest
+
+	err := protohttp.HTTPRequestToProto(req, &protoRequest)
+	if err != nil {
+		protohttp.WriteErrorToHTTPResponse(w, err)
+		return
+	}
+
+	rsp, err := h.grpcServer.ServiceLevelObjectives(req.Context(), &protoRequest)
+	if err != nil {
+		protohttp.WriteErrorToHTTPResponse(w, err)
+		return
+	}
+	err = protohttp.WriteProtoToHTTPResponse(w, rsp)
+	if err != nil {
+		protohttp.WriteErrorToHTTPResponse(w, err)
+		return
+	}
+}
+
 func (h *handler) handleTopRoutes(w http.ResponseWriter, req *http.Request) {
 	var protoRequest pb.TopRoutesRequest
 
@@ -217,6 +242,7 @@ func NewServer(
 	addr string,
 	prometheusClient promApi.Client,
 	k8sAPI *k8s.API,
+	dynamicClient dynamic.Interface,
 	controllerNamespace string,
 	clusterDomain string,
 	ignoredNamespaces []string,
@@ -230,6 +256,7 @@ func NewServer(
 	grpcServer := newGrpcServer(
 		promAPI,
 		k8sAPI,
+		dynamicClient,
 		controllerNamespace,
 		clusterDomain,
 		ignoredNamespaces,
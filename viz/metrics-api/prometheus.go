@@ -6,11 +6,13 @@ import (
 	"fmt"
 	"math"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/linkerd/linkerd2/pkg/k8s"
 	pb "github.com/linkerd/linkerd2/viz/metrics-api/gen/viz"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	"github.com/prometheus/common/model"
 	log "github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -30,6 +32,8 @@ const (
 	promTCPConnections = promType("QUERY_TCP_CONNECTIONS")
 	promTCPReadBytes   = promType("QUERY_TCP_READ_BYTES")
 	promTCPWriteBytes  = promType("QUERY_TCP_WRITE_BYTES")
+	promLatencyBuckets = promType("QUERY_LATENCY_BUCKETS")
+	promStatusCounts   = promType("QUERY_STATUS_COUNTS")
 	promLatencyP50     = promType("0.5")
 	promLatencyP95     = promType("0.95")
 	promLatencyP99     = promType("0.99")
@@ -39,6 +43,14 @@ const (
 	gatewayNameLabel       = model.LabelName("gateway_name")
 	gatewayNamespaceLabel  = model.LabelName("gateway_namespace")
 	remoteClusterNameLabel = model.LabelName("target_cluster_name")
+
+	// maxSeriesSamples is the maximum number of samples of a series, which
+	// is the limit of points per time series of the Prometheus range queries
+	maxSeriesSamples = 11000
+
+	// maxLatencyQuantiles is the maximum number of latency quantiles of a
+	// request, each of them requiring its own query
+	maxLatencyQuantiles = 10
 )
 
 var (
@@ -55,6 +67,12 @@ func extractSampleValue(sample *model.Sample) uint64 {
 }
 
 func (s *grpcServer) queryProm(ctx context.Context, query string) (model.Vector, error) {
+	// single data point (aka summary) query
+	return s.queryPromAt(ctx, query, time.Time{})
+}
+
+// queryPromAt evaluates a query at the given time, or now if it's zero
+func (s *grpcServer) queryPromAt(ctx context.Context, query string, ts time.Time) (model.Vector, error) {
 	log.Debugf("Query request:\n\t%+v", query)
 
 	_, span := trace.StartSpan(ctx, "query.prometheus")
@@ -65,8 +83,7 @@ func (s *grpcServer) queryProm(ctx context.Context, query string) (model.Vector,
 		return nil, ErrNoPrometheusInstance
 	}
 
-	// single data point (aka summary) query
-	res, warn, err := s.prometheusAPI.Query(ctx, query, time.Time{})
+	res, warn, err := s.prometheusAPI.Query(ctx, query, ts)
 	if err != nil {
 		log.Errorf("Query(%+v) failed with: %+v", query, err)
 		return nil, err
@@ -85,6 +102,83 @@ func (s *grpcServer) queryProm(ctx context.Context, query string) (model.Vector,
 	return res.(model.Vector), nil
 }
 
+// queryPromRange evaluates a query at each step of the given range
+func (s *grpcServer) queryPromRange(ctx context.Context, query string, r promv1.Range) (model.Matrix, error) {
+	log.Debugf("Query range request:\n\t%+v %+v", query, r)
+
+	_, span := trace.StartSpan(ctx, "query_range.prometheus")
+	defer span.End()
+	span.AddAttributes(trace.StringAttribute("queryString", query))
+
+	if s.prometheusAPI == nil {
+		return nil, ErrNoPrometheusInstance
+	}
+
+	res, warn, err := s.prometheusAPI.QueryRange(ctx, query, r)
+	if err != nil {
+		log.Errorf("QueryRange(%+v) failed with: %+v", query, err)
+		return nil, err
+	}
+	if warn != nil {
+		log.Warnf("%v", warn)
+	}
+	log.Debugf("Query range response:\n\t%+v", res)
+
+	if res.Type() != model.ValMatrix {
+		err = fmt.Errorf("Unexpected query result type (expected Matrix): %s", res.Type())
+		log.Error(err)
+		return nil, err
+	}
+
+	return res.(model.Matrix), nil
+}
+
+// queryTime describes when the queries of a request are evaluated
+type queryTime struct {
+	// window is the range of the queries' range vectors
+	window string
+	// ts is the time the queries are evaluated at; the zero value means now
+	ts time.Time
+	// series, if set, is the range over which the queries are also evaluated
+	// at each step, with the step as their window
+	series *promv1.Range
+}
+
+// newQueryTime returns the time the queries of a request are evaluated at,
+// which is over the time window ending now unless an absolute time range is
+// requested
+func newQueryTime(timeWindow string, timeRange *pb.TimeRange) (queryTime, error) {
+	if timeRange == nil {
+		return queryTime{window: timeWindow}, nil
+	}
+
+	start := time.Unix(timeRange.GetStart(), 0)
+	end := time.Unix(timeRange.GetEnd(), 0)
+	if !end.After(start) {
+		return queryTime{}, fmt.Errorf("invalid time range: the end (%s) must be after the start (%s)", end.UTC().Format(time.RFC3339), start.UTC().Format(time.RFC3339))
+	}
+	qt := queryTime{window: promDuration(end.Sub(start)), ts: end}
+
+	if timeRange.GetStep() != nil {
+		step := timeRange.GetStep().AsDuration()
+		if step < time.Second {
+			return queryTime{}, fmt.Errorf("invalid time range: the step (%s) must be at least 1s", step)
+		}
+		if samples := end.Sub(start) / step; samples > maxSeriesSamples {
+			return queryTime{}, fmt.Errorf("invalid time range: the step (%s) yields %d samples, the maximum is %d", step, samples, maxSeriesSamples)
+		}
+		// each sample covers the step preceding it
+		qt.series = &promv1.Range{Start: start.Add(step), End: end, Step: step}
+	}
+	return qt, nil
+}
+
+// promDuration formats a duration as a Prometheus duration, which is also
+// parsable by time.ParseDuration
+func promDuration(d time.Duration) string {
+	return fmt.Sprintf("%ds", int64(d.Round(time.Second)/time.Second))
+}
+
 // add filtering by resource type
 // note that metricToKey assumes the label ordering (namespace, name)
 func promGroupByLabelNames(resource *pb.Resource) model.LabelNames {
@@ -168,13 +262,105 @@ func generateLabelStringWithRegex(l model.LabelSet, labelName string, stringToMa
 }
 
 // generate Prometheus queries for latency quantiles, based on a quantile query
-// template, query labels, a time window and grouping.
-func generateQuantileQueries(quantileQuery, labels, timeWindow, groupBy string) map[promType]string {
-	return map[promType]string{
+// template, query labels, a time window and grouping. The p50, p95 and p99
+// quantiles are always queried, in addition to the given quantiles.
+func generateQuantileQueries(quantileQuery, labels, timeWindow, groupBy string, quantiles ...float64) map[promType]string {
+	queries := map[promType]string{
 		promLatencyP50: fmt.Sprintf(quantileQuery, promLatencyP50, labels, timeWindow, groupBy),
 		promLatencyP95: fmt.Sprintf(quantileQuery, promLatencyP95, labels, timeWindow, groupBy),
 		promLatencyP99: fmt.Sprintf(quantileQuery, promLatencyP99, labels, timeWindow, groupBy),
 	}
+	for quantile := range latencyQuantileTypes(quantiles) {
+		queries[quantile] = fmt.Sprintf(quantileQuery, quantile, labels, timeWindow, groupBy)
+	}
+	return queries
+}
+
+// latencyQuantileTypes indexes the latency quantiles by the type of their
+// query, which is the quantile itself
+func latencyQuantileTypes(quantiles []float64) map[promType]float64 {
+	types := make(map[promType]float64, len(quantiles))
+	for _, quantile := range quantiles {
+		types[promType(strconv.FormatFloat(quantile, 'f', -1, 64))] = quantile
+	}
+	return types
+}
+
+func validateLatencyQuantiles(quantiles []float64) error {
+	if len(quantiles) > maxLatencyQuantiles {
+		return fmt.Errorf("at most %d latency quantiles can be requested", maxLatencyQuantiles)
+	}
+	for _, quantile := range quantiles {
+		if math.IsNaN(quantile) || quantile <= 0 || quantile >= 1 {
+			return fmt.Errorf("latency quantile %v needs to be between 0 and 1 exclusive", quantile)
+		}
+	}
+	return nil
+}
+
+// addLatencyQuantile sets the latency of a quantile in the stats, keeping the
+// quantiles sorted
+func addLatencyQuantile(stats *pb.BasicStats, quantile float64, value uint64) {
+	i := sort.Search(len(stats.LatencyQuantiles), func(i int) bool {
+		return stats.LatencyQuantiles[i].Quantile >= quantile
+	})
+	if i < len(stats.LatencyQuantiles) && stats.LatencyQuantiles[i].Quantile == quantile {
+		stats.LatencyQuantiles[i].LatencyMs = value
+		return
+	}
+	stats.LatencyQuantiles = append(stats.LatencyQuantiles, nil)
+	copy(stats.LatencyQuantiles[i+1:], stats.LatencyQuantiles[i:])
+	stats.LatencyQuantiles[i] = &pb.LatencyQuantile{Quantile: quantile, LatencyMs: value}
+}
+
+// addLatencyBucket adds the count of a latency histogram bucket sample to the
+// stats, keeping the buckets sorted
+func addLatencyBucket(stats *pb.BasicStats, sample *model.Sample) {
+	le, err := strconv.ParseFloat(string(sample.Metric[model.LabelName("le")]), 64)
+	if err != nil {
+		log.Warnf("Found latency bucket with invalid bound: %s", sample.Metric)
+		return
+	}
+	value := extractSampleValue(sample)
+
+	i := sort.Search(len(stats.LatencyHistogram), func(i int) bool {
+		return stats.LatencyHistogram[i].Le >= le
+	})
+	if i < len(stats.LatencyHistogram) && stats.LatencyHistogram[i].Le == le {
+		stats.LatencyHistogram[i].Count += value
+		return
+	}
+	stats.LatencyHistogram = append(stats.LatencyHistogram, nil)
+	copy(stats.LatencyHistogram[i+1:], stats.LatencyHistogram[i:])
+	stats.LatencyHistogram[i] = &pb.LatencyBucket{Le: le, Count: value}
+}
+
+// addStatusCount adds the responses of a status sample to the stats, keeping
+// the statuses sorted
+func addStatusCount(stats *pb.BasicStats, sample *model.Sample) {
+	httpStatus := string(sample.Metric[model.LabelName("status_code")])
+	grpcStatus := string(sample.Metric[model.LabelName("grpc_status")])
+	value := extractSampleValue(sample)
+
+	i := sort.Search(len(stats.StatusCounts), func(i int) bool {
+		sc := stats.StatusCounts[i]
+		if sc.HttpStatus != httpStatus {
+			return sc.HttpStatus > httpStatus
+		}
+		return sc.GrpcStatus >= grpcStatus
+	})
+	if i == len(stats.StatusCounts) || stats.StatusCounts[i].HttpStatus != httpStatus || stats.StatusCounts[i].GrpcStatus != grpcStatus {
+		stats.StatusCounts = append(stats.StatusCounts, nil)
+		copy(stats.StatusCounts[i+1:], stats.StatusCounts[i:])
+		stats.StatusCounts[i] = &pb.StatusCount{HttpStatus: httpStatus, GrpcStatus: grpcStatus}
+	}
+
+	switch string(sample.Metric[model.LabelName("classification")]) {
+	case success:
+		stats.StatusCounts[i].SuccessCount += value
+	case failure:
+		stats.StatusCounts[i].FailureCount += value
+	}
 }
 
 // determine if we should add "namespace=<namespace>" to a named query
@@ -200,12 +386,12 @@ func promResourceType(resource *pb.Resource) model.LabelName {
 	return model.LabelName(l5dLabel)
 }
 
-func (s *grpcServer) getPrometheusMetrics(ctx context.Context, requestQueries map[promType]string, latencyQueries map[promType]string) ([]promResult, error) {
+func (s *grpcServer) getPrometheusMetrics(ctx context.Context, requestQueries map[promType]string, latencyQueries map[promType]string, ts time.Time) ([]promResult, error) {
 	resultChan := make(chan promResult)
 
 	for pt, query := range requestQueries {
 		go func(typ promType, promQuery string) {
-			resultVector, err := s.queryProm(ctx, promQuery)
+			resultVector, err := s.queryPromAt(ctx, promQuery, ts)
 			resultChan <- promResult{
 				prom: typ,
 				vec:  resultVector,
@@ -216,7 +402,7 @@ func (s *grpcServer) getPrometheusMetrics(ctx context.Context, requestQueries ma
 
 	for quantile, query := range latencyQueries {
 		go func(qt promType, promQuery string) {
-			resultVector, err := s.queryProm(ctx, promQuery)
+			resultVector, err := s.queryPromAt(ctx, promQuery, ts)
 			resultChan <- promResult{
 				prom: qt,
 				vec:  resultVector,
@@ -242,3 +428,80 @@ func (s *grpcServer) getPrometheusMetrics(ctx context.Context, requestQueries ma
 
 	return results, nil
 }
+
+// getPrometheusSeries evaluates the queries at each step of the range, and
+// returns the results of each step indexed by their time
+func (s *grpcServer) getPrometheusSeries(ctx context.Context, queries map[promType]string, r promv1.Range) (map[model.Time][]promResult, error) {
+	type promRangeResult struct {
+		prom   promType
+		matrix model.Matrix
+		err    error
+	}
+	resultChan := make(chan promRangeResult)
+
+	for pt, query := range queries {
+		go func(typ promType, promQuery string) {
+			matrix, err := s.queryPromRange(ctx, promQuery, r)
+			resultChan <- promRangeResult{
+				prom:   typ,
+				matrix: matrix,
+				err:    err,
+			}
+		}(pt, query)
+	}
+
+	var err error
+	vectors := map[model.Time]map[promType]model.Vector{}
+	for i := 0; i < len(queries); i++ {
+		result := <-resultChan
+		if result.err != nil {
+			log.Errorf("queryPromRange failed with: %s", result.err)
+			err = result.err
+			continue
+		}
+		for _, stream := range result.matrix {
+			for _, pair := range stream.Values {
+				if vectors[pair.Timestamp] == nil {
+					vectors[pair.Timestamp] = map[promType]model.Vector{}
+				}
+				vectors[pair.Timestamp][result.prom] = append(vectors[pair.Timestamp][result.prom], &model.Sample{
+					Metric:    stream.Metric,
+					Value:     pair.Value,
+					Timestamp: pair.Timestamp,
+				})
+			}
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	series := make(map[model.Time][]promResult, len(vectors))
+	for ts, byType := range vectors {
+		for typ, vec := range byType {
+			series[ts] = append(series[ts], promResult{prom: typ, vec: vec})
+		}
+	}
+	return series, nil
+}
+
+// sortedSeriesTimes returns the times of the series in ascending order
+func sortedSeriesTimes(series map[model.Time][]promResult) []model.Time {
+	times := make([]model.Time, 0, len(series))
+	for ts := range series {
+		times = append(times, ts)
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i] < times[j] })
+	return times
+}
+
+// mergeQueries returns all the queries of the given sets
+func mergeQueries(queries ...map[promType]string) map[promType]string {
+	merged := map[promType]string{}
+	for _, q := range queries {
+		for typ, query := range q {
+			merged[typ] = query
+		}
+	}
+	return merged
+}
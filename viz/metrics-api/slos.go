@@ -0,0 +1,189 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
+	pb "github.com/linkerd/linkerd2/viz/metrics-api/gen/viz"
+	"github.com/linkerd/linkerd2/viz/pkg/slo"
+	log "github.com/sirupsen/logrus"
+)
+
+// sloLatencyQuery is the latency of a quantile of the inbound requests of the
+// target of an objective over its whole window. The quantile of the stat
+// summaries is computed from the instant rate of the last samples instead,
+// which doesn't reflect the window.
+const sloLatencyQuery = "histogram_quantile(%s, sum(increase(response_latency_ms_bucket%s[%s])) by (le))"
+
+// ServiceLevelObjectives evaluates the ServiceLevelObjectives of a namespace
+// over their windows, using the stats of their targets
+func (s *grpcServer) ServiceLevelObjectives(ctx context.Context, req *pb.ServiceLevelObjectivesRequest) (*pb.ServiceLevelObjectivesResponse, error) {
+	if s.dynamicClient == nil {
+		return slosError("ServiceLevelObjectives are not available"), nil
+	}
+
+	objectives, err := slo.List(ctx, s.dynamicClient, req.GetNamespace())
+	if err != nil {
+		return slosError(err.Error()), nil
+	}
+
+	rows := make([]*pb.ServiceLevelObjective, 0, len(objectives))
+	for _, objective := range objectives {
+		rows = append(rows, s.evaluateSLO(ctx, objective))
+	}
+
+	return &pb.ServiceLevelObjectivesResponse{
+		Response: &pb.ServiceLevelObjectivesResponse_Ok_{
+			Ok: &pb.ServiceLevelObjectivesResponse_Ok{
+				Objectives: rows,
+			},
+		},
+	}, nil
+}
+
+func slosError(message string) *pb.ServiceLevelObjectivesResponse {
+	return &pb.ServiceLevelObjectivesResponse{
+		Response: &pb.ServiceLevelObjectivesResponse_Error{
+			Error: &pb.ResourceError{
+				Error: message,
+			},
+		},
+	}
+}
+
+// evaluateSLO computes the SLIs of an objective along with its error budget,
+// reporting the errors in the result so that an invalid objective doesn't
+// prevent the others from being reported
+func (s *grpcServer) evaluateSLO(ctx context.Context, objective *slo.ServiceLevelObjective) *pb.ServiceLevelObjective {
+	result := &pb.ServiceLevelObjective{
+		Name:      objective.Name,
+		Namespace: objective.Namespace,
+		Target: &pb.Resource{
+			Namespace: objective.Namespace,
+			Type:      objective.Spec.Target.Kind,
+			Name:      objective.Spec.Target.Name,
+		},
+		Window: objective.Spec.Window,
+	}
+
+	if err := objective.Validate(); err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	// the kind was validated above
+	result.Target.Type, _ = pkgK8s.CanonicalResourceNameFromFriendlyName(objective.Spec.Target.Kind)
+
+	stats, err := s.getSLOStats(ctx, result.Target, objective.Spec.Window)
+	if err != nil {
+		log.Errorf("Failed to get the stats of ServiceLevelObjective %s/%s: %s", objective.Namespace, objective.Name, err)
+		result.Error = err.Error()
+		return result
+	}
+
+	if sr := objective.Spec.SuccessRate; sr != nil {
+		srObjective := &pb.ServiceLevelObjective_SuccessRateObjective{
+			Objective:            *sr / 100,
+			SuccessCount:         stats.GetSuccessCount(),
+			FailureCount:         stats.GetFailureCount(),
+			ErrorBudgetRemaining: 1,
+			BurnRateWindow:       objective.BurnRateWindow(),
+		}
+		if successRate, ok := statsSuccessRate(stats); ok {
+			srObjective.SuccessRate = successRate
+			srObjective.ErrorBudgetRemaining = slo.ErrorBudgetRemaining(srObjective.Objective, successRate)
+		}
+
+		burnStats, err := s.getSLOStats(ctx, result.Target, objective.BurnRateWindow())
+		if err != nil {
+			log.Errorf("Failed to get the burn rate of ServiceLevelObjective %s/%s: %s", objective.Namespace, objective.Name, err)
+			result.Error = err.Error()
+			return result
+		}
+		if successRate, ok := statsSuccessRate(burnStats); ok {
+			srObjective.BurnRate = slo.BurnRate(srObjective.Objective, successRate)
+		}
+		result.SuccessRate = srObjective
+	}
+
+	if l := objective.Spec.Latency; l != nil {
+		lObjective := &pb.ServiceLevelObjective_LatencyObjective{
+			Percentile:  l.Percentile,
+			ThresholdMs: l.ThresholdMs,
+		}
+		latencyMs, ok, err := s.getSLOLatency(ctx, result.Target, objective.Spec.Window, l.Percentile/100)
+		if err != nil {
+			log.Errorf("Failed to get the latency of ServiceLevelObjective %s/%s: %s", objective.Namespace, objective.Name, err)
+			result.Error = err.Error()
+			return result
+		}
+		if ok {
+			lObjective.LatencyMs = latencyMs
+			lObjective.Met = latencyMs <= lObjective.ThresholdMs
+		} else {
+			lObjective.NoData = true
+		}
+		result.Latency = lObjective
+	}
+
+	return result
+}
+
+// getSLOStats returns the inbound stats of the target of an objective over a
+// window
+func (s *grpcServer) getSLOStats(ctx context.Context, target *pb.Resource, window string) (*pb.BasicStats, error) {
+	rsp, err := s.StatSummary(ctx, sloStatSummaryRequest(target, window))
+	if err != nil {
+		return nil, err
+	}
+	if e := rsp.GetError(); e != nil {
+		return nil, errors.New(e.GetError())
+	}
+
+	for _, table := range rsp.GetOk().GetStatTables() {
+		for _, row := range table.GetPodGroup().GetRows() {
+			if row.GetResource().GetName() == target.GetName() && row.GetStats() != nil {
+				return row.GetStats(), nil
+			}
+		}
+	}
+	// no traffic over the window
+	return &pb.BasicStats{}, nil
+}
+
+// getSLOLatency returns the latency of the quantile of the inbound requests
+// of the target of an objective over a window, and false if there were no
+// requests over the window
+func (s *grpcServer) getSLOLatency(ctx context.Context, target *pb.Resource, window string, quantile float64) (uint64, bool, error) {
+	labels, _ := buildRequestLabels(sloStatSummaryRequest(target, window))
+	query := fmt.Sprintf(sloLatencyQuery, strconv.FormatFloat(quantile, 'f', -1, 64), labels.String(), window)
+	vector, err := s.queryProm(ctx, query)
+	if err != nil {
+		return 0, false, err
+	}
+	if len(vector) == 0 || math.IsNaN(float64(vector[0].Value)) {
+		return 0, false, nil
+	}
+	return extractSampleValue(vector[0]), true, nil
+}
+
+func sloStatSummaryRequest(target *pb.Resource, window string) *pb.StatSummaryRequest {
+	return &pb.StatSummaryRequest{
+		Selector: &pb.ResourceSelection{
+			Resource: target,
+		},
+		TimeWindow: window,
+		Outbound:   &pb.StatSummaryRequest_None{None: &pb.Empty{}},
+	}
+}
+
+func statsSuccessRate(stats *pb.BasicStats) (float64, bool) {
+	total := stats.GetSuccessCount() + stats.GetFailureCount()
+	if total == 0 {
+		return 0, false
+	}
+	return float64(stats.GetSuccessCount()) / float64(total), true
+}
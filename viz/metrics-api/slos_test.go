@@ -0,0 +1,188 @@
+package api
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+
+	pb "github.com/linkerd/linkerd2/viz/metrics-api/gen/viz"
+	"github.com/linkerd/linkerd2/viz/pkg/slo"
+	"github.com/prometheus/common/model"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+)
+
+func genSLO(name string, spec map[string]interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "viz.linkerd.io/v1alpha1",
+			"kind":       "ServiceLevelObjective",
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": "emojivoto",
+			},
+			"spec": spec,
+		},
+	}
+}
+
+func TestServiceLevelObjectives(t *testing.T) {
+	success := genPromSample("emoji", "pod", "emojivoto", false)
+	success.Value = 999
+	failure := genPromSample("emoji", "pod", "emojivoto", false)
+	failure.Metric["classification"] = "failure"
+	failure.Value = 1
+
+	mockProm, fakeGrpcServer, err := newMockGrpcServer(expectedStatRPC{
+		k8sConfigs: []string{`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: emoji
+  namespace: emojivoto
+  labels:
+    app: emoji-svc
+    linkerd.io/control-plane-ns: linkerd
+status:
+  phase: Running
+`,
+		},
+		mockPromResponse: model.Vector{success, failure},
+	})
+	if err != nil {
+		t.Fatalf("Error creating mock grpc server: %s", err)
+	}
+
+	scheme := runtime.NewScheme()
+	fakeGrpcServer.dynamicClient = fake.NewSimpleDynamicClientWithCustomListKinds(
+		scheme,
+		map[schema.GroupVersionResource]string{slo.GroupVersionResource: "ServiceLevelObjectiveList"},
+		genSLO("emoji-availability", map[string]interface{}{
+			"target":      map[string]interface{}{"kind": "po", "name": "emoji"},
+			"window":      "7d",
+			"successRate": 99.0,
+			"latency":     map[string]interface{}{"percentile": 99.0, "thresholdMs": int64(1000)},
+		}),
+		genSLO("emoji-broken", map[string]interface{}{
+			"target": map[string]interface{}{"kind": "po", "name": "emoji"},
+			"window": int64(7),
+		}),
+		genSLO("emoji-invalid", map[string]interface{}{
+			"target": map[string]interface{}{"kind": "po", "name": "emoji"},
+			"window": "7d",
+		}),
+	)
+
+	rsp, err := fakeGrpcServer.ServiceLevelObjectives(context.TODO(), &pb.ServiceLevelObjectivesRequest{Namespace: "emojivoto"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if e := rsp.GetError(); e != nil {
+		t.Fatalf("Unexpected error: %s", e.GetError())
+	}
+
+	objectives := rsp.GetOk().GetObjectives()
+	if len(objectives) != 3 {
+		t.Fatalf("Expected 3 objectives, got %d", len(objectives))
+	}
+
+	available := objectives[0]
+	if available.GetName() != "emoji-availability" || available.GetError() != "" {
+		t.Fatalf("Unexpected objective: %+v", available)
+	}
+	if available.GetTarget().GetType() != "pod" {
+		t.Fatalf("Expected the target type to be canonicalized, got %s", available.GetTarget().GetType())
+	}
+
+	sr := available.GetSuccessRate()
+	if sr.GetSuccessCount() != 999 || sr.GetFailureCount() != 1 {
+		t.Fatalf("Unexpected counts: %d successes, %d failures", sr.GetSuccessCount(), sr.GetFailureCount())
+	}
+	for name, values := range map[string][2]float64{
+		"success rate":           {sr.GetSuccessRate(), 0.999},
+		"error budget remaining": {sr.GetErrorBudgetRemaining(), 0.9},
+		"burn rate":              {sr.GetBurnRate(), 0.1},
+	} {
+		if math.Abs(values[0]-values[1]) > 1e-9 {
+			t.Fatalf("Expected %s to be %v, got %v", name, values[1], values[0])
+		}
+	}
+	if sr.GetBurnRateWindow() != slo.DefaultBurnRateWindow {
+		t.Fatalf("Expected the default burn rate window, got %s", sr.GetBurnRateWindow())
+	}
+
+	latency := available.GetLatency()
+	if latency.GetLatencyMs() != 999 || !latency.GetMet() || latency.GetNoData() {
+		t.Fatalf("Expected the latency objective to be met, got %+v", latency)
+	}
+	expectedQuery := `histogram_quantile(0.99, sum(increase(response_latency_ms_bucket{direction="inbound", namespace="emojivoto", pod="emoji"}[7d])) by (le))`
+	found := false
+	for _, q := range mockProm.QueriesExecuted {
+		if q == expectedQuery {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("Expected query %s, got %v", expectedQuery, mockProm.QueriesExecuted)
+	}
+
+	broken := objectives[1]
+	if broken.GetName() != "emoji-broken" || !strings.HasPrefix(broken.GetError(), "invalid ServiceLevelObjective emojivoto/emoji-broken: ") {
+		t.Fatalf("Expected a conversion error for the broken objective, got %+v", broken)
+	}
+
+	invalid := objectives[2]
+	if invalid.GetError() != "either a success rate or a latency objective is required" {
+		t.Fatalf("Unexpected error for the invalid objective: %q", invalid.GetError())
+	}
+}
+
+func TestServiceLevelObjectivesNoData(t *testing.T) {
+	_, fakeGrpcServer, err := newMockGrpcServer(expectedStatRPC{
+		k8sConfigs: []string{`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: emoji
+  namespace: emojivoto
+  labels:
+    app: emoji-svc
+    linkerd.io/control-plane-ns: linkerd
+status:
+  phase: Running
+`,
+		},
+		mockPromResponse: model.Vector{},
+	})
+	if err != nil {
+		t.Fatalf("Error creating mock grpc server: %s", err)
+	}
+
+	scheme := runtime.NewScheme()
+	fakeGrpcServer.dynamicClient = fake.NewSimpleDynamicClientWithCustomListKinds(
+		scheme,
+		map[schema.GroupVersionResource]string{slo.GroupVersionResource: "ServiceLevelObjectiveList"},
+		genSLO("emoji-latency", map[string]interface{}{
+			"target":  map[string]interface{}{"kind": "po", "name": "emoji"},
+			"window":  "1h",
+			"latency": map[string]interface{}{"percentile": 99.0, "thresholdMs": int64(300)},
+		}),
+	)
+
+	rsp, err := fakeGrpcServer.ServiceLevelObjectives(context.TODO(), &pb.ServiceLevelObjectivesRequest{Namespace: "emojivoto"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	objectives := rsp.GetOk().GetObjectives()
+	if len(objectives) != 1 || objectives[0].GetError() != "" {
+		t.Fatalf("Unexpected objectives: %+v", objectives)
+	}
+	latency := objectives[0].GetLatency()
+	if !latency.GetNoData() || latency.GetMet() {
+		t.Fatalf("Expected the latency objective to have no data, got %+v", latency)
+	}
+}
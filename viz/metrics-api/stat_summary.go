@@ -10,6 +10,7 @@ import (
 	"github.com/linkerd/linkerd2/controller/api/util"
 	"github.com/linkerd/linkerd2/pkg/k8s"
 	pb "github.com/linkerd/linkerd2/viz/metrics-api/gen/viz"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	"github.com/prometheus/common/model"
 	"github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
@@ -50,6 +51,8 @@ const (
 
 	reqQuery             = "sum(increase(response_total%s[%s])) by (%s, classification, tls)"
 	latencyQuantileQuery = "histogram_quantile(%s, sum(irate(response_latency_ms_bucket%s[%s])) by (le, %s))"
+	latencyBucketsQuery  = "sum(increase(response_latency_ms_bucket%s[%s])) by (le, %s)"
+	statusCountsQuery    = "sum(increase(response_total%s[%s])) by (%s, classification, status_code, grpc_status)"
 	tcpConnectionsQuery  = "sum(tcp_open_connections%s) by (%s)"
 	tcpReadBytesQuery    = "sum(increase(tcp_read_bytes_total%s[%s])) by (%s)"
 	tcpWriteBytesQuery   = "sum(increase(tcp_write_bytes_total%s[%s])) by (%s)"
@@ -92,6 +95,15 @@ func (s *grpcServer) StatSummary(ctx context.Context, req *pb.StatSummaryRequest
 		}
 	}
 
+	qt, err := newQueryTime(req.TimeWindow, req.GetTimeRange())
+	if err != nil {
+		return statSummaryError(req, err.Error()), nil
+	}
+
+	if err := validateLatencyQuantiles(req.GetLatencyQuantiles()); err != nil {
+		return statSummaryError(req, err.Error()), nil
+	}
+
 	statTables := make([]*pb.StatTable, 0)
 
 	var resourcesToQuery []string
@@ -110,11 +122,11 @@ func (s *grpcServer) StatSummary(ctx context.Context, req *pb.StatSummaryRequest
 
 		go func() {
 			if isNonK8sResourceQuery(statReq.GetSelector().GetResource().GetType()) {
-				resultChan <- s.nonK8sResourceQuery(ctx, statReq)
+				resultChan <- s.nonK8sResourceQuery(ctx, statReq, qt)
 			} else if isTrafficSplitQuery(statReq.GetSelector().GetResource().GetType()) {
-				resultChan <- s.trafficSplitResourceQuery(ctx, statReq)
+				resultChan <- s.trafficSplitResourceQuery(ctx, statReq, qt)
 			} else {
-				resultChan <- s.k8sResourceQuery(ctx, statReq)
+				resultChan <- s.k8sResourceQuery(ctx, statReq, qt)
 			}
 		}()
 	}
@@ -197,7 +209,7 @@ func (s *grpcServer) getKubernetesObjectStats(req *pb.StatSummaryRequest) (map[r
 	return objectMap, nil
 }
 
-func (s *grpcServer) k8sResourceQuery(ctx context.Context, req *pb.StatSummaryRequest) resourceResult {
+func (s *grpcServer) k8sResourceQuery(ctx context.Context, req *pb.StatSummaryRequest, qt queryTime) resourceResult {
 	k8sObjects, err := s.getKubernetesObjectStats(req)
 	if err != nil {
 		return resourceResult{res: nil, err: err}
@@ -205,8 +217,9 @@ func (s *grpcServer) k8sResourceQuery(ctx context.Context, req *pb.StatSummaryRe
 
 	var requestMetrics map[rKey]*pb.BasicStats
 	var tcpMetrics map[rKey]*pb.TcpStats
+	var seriesMetrics map[rKey][]*pb.StatsSample
 	if !req.SkipStats {
-		requestMetrics, tcpMetrics, err = s.getStatMetrics(ctx, req, req.TimeWindow)
+		requestMetrics, tcpMetrics, seriesMetrics, err = s.getStatMetrics(ctx, req, qt)
 		if err != nil {
 			return resourceResult{res: nil, err: err}
 		}
@@ -238,9 +251,10 @@ func (s *grpcServer) k8sResourceQuery(ctx context.Context, req *pb.StatSummaryRe
 				Namespace: k8sResource.GetNamespace(),
 				Type:      req.GetSelector().GetResource().GetType(),
 			},
-			TimeWindow: req.TimeWindow,
+			TimeWindow: qt.window,
 			Stats:      basicStats,
 			TcpStats:   tcpStats,
+			Series:     seriesMetrics[key],
 		}
 
 		podStat := objInfo.podStats
@@ -287,7 +301,7 @@ func (s *grpcServer) getTrafficSplits(req *pb.StatSummaryRequest) ([]*v1alpha1.T
 	return trafficSplits, err
 }
 
-func (s *grpcServer) trafficSplitResourceQuery(ctx context.Context, req *pb.StatSummaryRequest) resourceResult {
+func (s *grpcServer) trafficSplitResourceQuery(ctx context.Context, req *pb.StatSummaryRequest, qt queryTime) resourceResult {
 	tss, err := s.getTrafficSplits(req)
 
 	if err != nil {
@@ -295,6 +309,7 @@ func (s *grpcServer) trafficSplitResourceQuery(ctx context.Context, req *pb.Stat
 	}
 
 	tsBasicStats := make(map[tsKey]*pb.BasicStats)
+	tsSeries := make(map[tsKey][]*pb.StatsSample)
 	rows := make([]*pb.StatTable_PodGroup_Row, 0)
 
 	for _, ts := range tss {
@@ -307,7 +322,7 @@ func (s *grpcServer) trafficSplitResourceQuery(ctx context.Context, req *pb.Stat
 		}
 
 		if !req.SkipStats {
-			tsBasicStats, err = s.getTrafficSplitMetrics(ctx, req, tsStats, req.TimeWindow)
+			tsBasicStats, tsSeries, err = s.getTrafficSplitMetrics(ctx, req, tsStats, qt)
 			if err != nil {
 				return resourceResult{res: nil, err: err}
 			}
@@ -337,9 +352,10 @@ func (s *grpcServer) trafficSplitResourceQuery(ctx context.Context, req *pb.Stat
 					Namespace: tsStats.namespace,
 					Type:      req.GetSelector().GetResource().GetType(),
 				},
-				TimeWindow: req.TimeWindow,
+				TimeWindow: qt.window,
 				Stats:      tsBasicStats[currentLeaf],
 				TsStats:    trafficSplitStats,
+				Series:     tsSeries[currentLeaf],
 			}
 			rows = append(rows, &row)
 		}
@@ -368,11 +384,12 @@ func sortTrafficSplitRows(rows []*pb.StatTable_PodGroup_Row) []*pb.StatTable_Pod
 	return rows
 }
 
-func (s *grpcServer) nonK8sResourceQuery(ctx context.Context, req *pb.StatSummaryRequest) resourceResult {
+func (s *grpcServer) nonK8sResourceQuery(ctx context.Context, req *pb.StatSummaryRequest, qt queryTime) resourceResult {
 	var requestMetrics map[rKey]*pb.BasicStats
+	var seriesMetrics map[rKey][]*pb.StatsSample
 	if !req.SkipStats {
 		var err error
-		requestMetrics, _, err = s.getStatMetrics(ctx, req, req.TimeWindow)
+		requestMetrics, _, seriesMetrics, err = s.getStatMetrics(ctx, req, qt)
 		if err != nil {
 			return resourceResult{res: nil, err: err}
 		}
@@ -388,8 +405,9 @@ func (s *grpcServer) nonK8sResourceQuery(ctx context.Context, req *pb.StatSummar
 				Namespace: rkey.Namespace,
 				Name:      rkey.Name,
 			},
-			TimeWindow: req.TimeWindow,
+			TimeWindow: qt.window,
 			Stats:      metrics,
+			Series:     seriesMetrics[rkey],
 		}
 		rows = append(rows, &row)
 	}
@@ -503,33 +521,78 @@ func buildTCPStatsRequestLabels(req *pb.StatSummaryRequest, reqLabels model.Labe
 	return reqLabels.String()
 }
 
-func (s *grpcServer) getStatMetrics(ctx context.Context, req *pb.StatSummaryRequest, timeWindow string) (map[rKey]*pb.BasicStats, map[rKey]*pb.TcpStats, error) {
+func (s *grpcServer) getStatMetrics(ctx context.Context, req *pb.StatSummaryRequest, qt queryTime) (map[rKey]*pb.BasicStats, map[rKey]*pb.TcpStats, map[rKey][]*pb.StatsSample, error) {
 	reqLabels, groupBy := buildRequestLabels(req)
 	promQueries := map[promType]string{
-		promRequests: fmt.Sprintf(reqQuery, reqLabels.String(), timeWindow, groupBy.String()),
+		promRequests: fmt.Sprintf(reqQuery, reqLabels.String(), qt.window, groupBy.String()),
 	}
 
 	if req.TcpStats {
 		promQueries[promTCPConnections] = fmt.Sprintf(tcpConnectionsQuery, reqLabels.String(), groupBy.String())
 		// For TCP read/write bytes total we add an additional 'peer' label with a value of either 'src' or 'dst'
 		tcpLabels := buildTCPStatsRequestLabels(req, reqLabels)
-		promQueries[promTCPReadBytes] = fmt.Sprintf(tcpReadBytesQuery, tcpLabels, timeWindow, groupBy.String())
-		promQueries[promTCPWriteBytes] = fmt.Sprintf(tcpWriteBytesQuery, tcpLabels, timeWindow, groupBy.String())
+		promQueries[promTCPReadBytes] = fmt.Sprintf(tcpReadBytesQuery, tcpLabels, qt.window, groupBy.String())
+		promQueries[promTCPWriteBytes] = fmt.Sprintf(tcpWriteBytesQuery, tcpLabels, qt.window, groupBy.String())
 	}
 
-	quantileQueries := generateQuantileQueries(latencyQuantileQuery, reqLabels.String(), timeWindow, groupBy.String())
-	results, err := s.getPrometheusMetrics(ctx, promQueries, quantileQueries)
+	if req.LatencyHistogram {
+		promQueries[promLatencyBuckets] = fmt.Sprintf(latencyBucketsQuery, reqLabels.String(), qt.window, groupBy.String())
+	}
+
+	if req.StatusBreakdown {
+		promQueries[promStatusCounts] = fmt.Sprintf(statusCountsQuery, reqLabels.String(), qt.window, groupBy.String())
+	}
+
+	quantileQueries := generateQuantileQueries(latencyQuantileQuery, reqLabels.String(), qt.window, groupBy.String(), req.LatencyQuantiles...)
+	results, err := s.getPrometheusMetrics(ctx, promQueries, quantileQueries, qt.ts)
 
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
-	basicStats, tcpStats := processPrometheusMetrics(req, results, groupBy)
-	return basicStats, tcpStats, nil
+	basicStats, tcpStats := processPrometheusMetrics(req, results, groupBy, latencyQuantileTypes(req.LatencyQuantiles))
+
+	var series map[rKey][]*pb.StatsSample
+	if qt.series != nil {
+		series, err = s.getStatSeries(ctx, req, reqLabels.String(), groupBy, qt.series)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	return basicStats, tcpStats, series, nil
 }
 
-func (s *grpcServer) getTrafficSplitMetrics(ctx context.Context, req *pb.StatSummaryRequest, tsStats *trafficSplitStats, timeWindow string) (map[tsKey]*pb.BasicStats, error) {
+// getStatSeries returns the stats of each resource over each step of the
+// range, using the step as the window of the queries
+func (s *grpcServer) getStatSeries(ctx context.Context, req *pb.StatSummaryRequest, reqLabels string, groupBy model.LabelNames, r *promv1.Range) (map[rKey][]*pb.StatsSample, error) {
+	window := promDuration(r.Step)
+	promQueries := mergeQueries(
+		map[promType]string{
+			promRequests: fmt.Sprintf(reqQuery, reqLabels, window, groupBy.String()),
+		},
+		generateQuantileQueries(latencyQuantileQuery, reqLabels, window, groupBy.String()),
+	)
+	results, err := s.getPrometheusSeries(ctx, promQueries, *r)
+	if err != nil {
+		return nil, err
+	}
+
+	series := make(map[rKey][]*pb.StatsSample)
+	for _, ts := range sortedSeriesTimes(results) {
+		basicStats, _ := processPrometheusMetrics(req, results[ts], groupBy, nil)
+		for key, stats := range basicStats {
+			series[key] = append(series[key], &pb.StatsSample{
+				Timestamp: ts.Unix(),
+				Stats:     stats,
+			})
+		}
+	}
+	return series, nil
+}
+
+func (s *grpcServer) getTrafficSplitMetrics(ctx context.Context, req *pb.StatSummaryRequest, tsStats *trafficSplitStats, qt queryTime) (map[tsKey]*pb.BasicStats, map[tsKey][]*pb.StatsSample, error) {
 	tsBasicStats := make(map[tsKey]*pb.BasicStats)
+	tsSeries := make(map[tsKey][]*pb.StatsSample)
 	labels, groupBy := buildTrafficSplitRequestLabels(req)
 
 	apex := tsStats.apex
@@ -540,31 +603,55 @@ func (s *grpcServer) getTrafficSplitMetrics(ctx context.Context, req *pb.StatSum
 	reqLabels := generateLabelStringWithRegex(labels, "authority", stringToMatch)
 
 	promQueries := map[promType]string{
-		promRequests: fmt.Sprintf(reqQuery, reqLabels, timeWindow, groupBy.String()),
+		promRequests: fmt.Sprintf(reqQuery, reqLabels, qt.window, groupBy.String()),
+	}
+
+	if req.LatencyHistogram {
+		promQueries[promLatencyBuckets] = fmt.Sprintf(latencyBucketsQuery, reqLabels, qt.window, groupBy.String())
 	}
 
-	quantileQueries := generateQuantileQueries(latencyQuantileQuery, reqLabels, timeWindow, groupBy.String())
-	results, err := s.getPrometheusMetrics(ctx, promQueries, quantileQueries)
+	if req.StatusBreakdown {
+		promQueries[promStatusCounts] = fmt.Sprintf(statusCountsQuery, reqLabels, qt.window, groupBy.String())
+	}
+
+	quantileQueries := generateQuantileQueries(latencyQuantileQuery, reqLabels, qt.window, groupBy.String(), req.LatencyQuantiles...)
+	results, err := s.getPrometheusMetrics(ctx, promQueries, quantileQueries, qt.ts)
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	basicStats, _ := processPrometheusMetrics(req, results, groupBy) // we don't need tcpStat info for traffic split
+	basicStats, _ := processPrometheusMetrics(req, results, groupBy, latencyQuantileTypes(req.LatencyQuantiles)) // we don't need tcpStat info for traffic split
 
-	for rKey, basicStatsVal := range basicStats {
-		tsBasicStats[tsKey{
+	var series map[rKey][]*pb.StatsSample
+	if qt.series != nil {
+		series, err = s.getStatSeries(ctx, req, reqLabels, groupBy, qt.series)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	leafKey := func(rKey rKey) tsKey {
+		return tsKey{
 			Namespace: namespace,
 			Name:      tsStats.name,
 			Type:      req.Selector.Resource.Type,
 			Apex:      apex,
 			Leaf:      rKey.Name,
-		}] = basicStatsVal
+		}
+	}
+	for rKey, basicStatsVal := range basicStats {
+		tsBasicStats[leafKey(rKey)] = basicStatsVal
+	}
+	for rKey, samples := range series {
+		tsSeries[leafKey(rKey)] = samples
 	}
-	return tsBasicStats, nil
+	return tsBasicStats, tsSeries, nil
 }
 
-func processPrometheusMetrics(req *pb.StatSummaryRequest, results []promResult, groupBy model.LabelNames) (map[rKey]*pb.BasicStats, map[rKey]*pb.TcpStats) {
+// processPrometheusMetrics indexes the results of the queries by resource,
+// including the latencies of the requested quantiles
+func processPrometheusMetrics(req *pb.StatSummaryRequest, results []promResult, groupBy model.LabelNames, quantiles map[promType]float64) (map[rKey]*pb.BasicStats, map[rKey]*pb.TcpStats) {
 	basicStats := make(map[rKey]*pb.BasicStats)
 	tcpStats := make(map[rKey]*pb.TcpStats)
 
@@ -585,6 +672,11 @@ func processPrometheusMetrics(req *pb.StatSummaryRequest, results []promResult,
 
 			value := extractSampleValue(sample)
 
+			if quantile, ok := quantiles[result.prom]; ok {
+				addBasicStats()
+				addLatencyQuantile(basicStats[resource], quantile, value)
+			}
+
 			switch result.prom {
 			case promRequests:
 				addBasicStats()
@@ -603,6 +695,12 @@ func processPrometheusMetrics(req *pb.StatSummaryRequest, results []promResult,
 			case promLatencyP99:
 				addBasicStats()
 				basicStats[resource].LatencyMsP99 = value
+			case promLatencyBuckets:
+				addBasicStats()
+				addLatencyBucket(basicStats[resource], sample)
+			case promStatusCounts:
+				addBasicStats()
+				addStatusCount(basicStats[resource], sample)
 			case promTCPConnections:
 				addTCPStats()
 				tcpStats[resource].OpenConnections = value
@@ -3,15 +3,21 @@ package api
 import (
 	"context"
 	"errors"
+	"math"
 	"sort"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/linkerd/linkerd2/controller/k8s"
 	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
 	"github.com/linkerd/linkerd2/pkg/prometheus"
 	pb "github.com/linkerd/linkerd2/viz/metrics-api/gen/viz"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	"github.com/prometheus/common/model"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 type statSumExpected struct {
@@ -770,6 +776,131 @@ status:
 		testStatSummary(t, expectations)
 	})
 
+	t.Run("Queries prometheus for additional latency quantiles and the latency histogram when requested", func(t *testing.T) {
+		sample := genPromSample("emojivoto-1", "pod", "emojivoto", false)
+		sample.Metric["le"] = "+Inf"
+
+		expectedResponse := GenStatSummaryResponse("emojivoto-1", pkgK8s.Pod, []string{"emojivoto"}, &PodCounts{
+			Status:      "Running",
+			MeshedPods:  1,
+			RunningPods: 1,
+			FailedPods:  0,
+		}, true, false)
+		stats := expectedResponse.GetOk().StatTables[0].GetPodGroup().Rows[0].Stats
+		stats.LatencyQuantiles = []*pb.LatencyQuantile{
+			{Quantile: 0.99, LatencyMs: 123},
+			{Quantile: 0.999, LatencyMs: 123},
+		}
+		stats.LatencyHistogram = []*pb.LatencyBucket{
+			{Le: math.Inf(1), Count: 123},
+		}
+
+		expectations := []statSumExpected{
+			{
+				expectedStatRPC: expectedStatRPC{
+					err: nil,
+					k8sConfigs: []string{`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: emojivoto-1
+  namespace: emojivoto
+  labels:
+    app: emoji-svc
+    linkerd.io/control-plane-ns: linkerd
+status:
+  phase: Running
+`,
+					},
+					mockPromResponse: model.Vector{sample},
+					expectedPrometheusQueries: []string{
+						`histogram_quantile(0.5, sum(irate(response_latency_ms_bucket{direction="inbound", namespace="emojivoto", pod="emojivoto-1"}[1m])) by (le, namespace, pod))`,
+						`histogram_quantile(0.95, sum(irate(response_latency_ms_bucket{direction="inbound", namespace="emojivoto", pod="emojivoto-1"}[1m])) by (le, namespace, pod))`,
+						`histogram_quantile(0.99, sum(irate(response_latency_ms_bucket{direction="inbound", namespace="emojivoto", pod="emojivoto-1"}[1m])) by (le, namespace, pod))`,
+						`histogram_quantile(0.999, sum(irate(response_latency_ms_bucket{direction="inbound", namespace="emojivoto", pod="emojivoto-1"}[1m])) by (le, namespace, pod))`,
+						`sum(increase(response_latency_ms_bucket{direction="inbound", namespace="emojivoto", pod="emojivoto-1"}[1m])) by (le, namespace, pod)`,
+						`sum(increase(response_total{direction="inbound", namespace="emojivoto", pod="emojivoto-1"}[1m])) by (namespace, pod, classification, tls)`,
+					},
+				},
+				req: &pb.StatSummaryRequest{
+					Selector: &pb.ResourceSelection{
+						Resource: &pb.Resource{
+							Name:      "emojivoto-1",
+							Namespace: "emojivoto",
+							Type:      pkgK8s.Pod,
+						},
+					},
+					TimeWindow:       "1m",
+					LatencyQuantiles: []float64{0.999, 0.99},
+					LatencyHistogram: true,
+				},
+				expectedResponse: expectedResponse,
+			},
+		}
+
+		testStatSummary(t, expectations)
+	})
+
+	t.Run("Queries prometheus for the breakdown of the responses by status when requested", func(t *testing.T) {
+		sample := genPromSample("emojivoto-1", "pod", "emojivoto", false)
+		sample.Metric["status_code"] = "200"
+		sample.Metric["grpc_status"] = "14"
+
+		expectedResponse := GenStatSummaryResponse("emojivoto-1", pkgK8s.Pod, []string{"emojivoto"}, &PodCounts{
+			Status:      "Running",
+			MeshedPods:  1,
+			RunningPods: 1,
+			FailedPods:  0,
+		}, true, false)
+		stats := expectedResponse.GetOk().StatTables[0].GetPodGroup().Rows[0].Stats
+		stats.StatusCounts = []*pb.StatusCount{
+			{HttpStatus: "200", GrpcStatus: "14", SuccessCount: 123},
+		}
+
+		expectations := []statSumExpected{
+			{
+				expectedStatRPC: expectedStatRPC{
+					err: nil,
+					k8sConfigs: []string{`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: emojivoto-1
+  namespace: emojivoto
+  labels:
+    app: emoji-svc
+    linkerd.io/control-plane-ns: linkerd
+status:
+  phase: Running
+`,
+					},
+					mockPromResponse: model.Vector{sample},
+					expectedPrometheusQueries: []string{
+						`histogram_quantile(0.5, sum(irate(response_latency_ms_bucket{direction="inbound", namespace="emojivoto", pod="emojivoto-1"}[1m])) by (le, namespace, pod))`,
+						`histogram_quantile(0.95, sum(irate(response_latency_ms_bucket{direction="inbound", namespace="emojivoto", pod="emojivoto-1"}[1m])) by (le, namespace, pod))`,
+						`histogram_quantile(0.99, sum(irate(response_latency_ms_bucket{direction="inbound", namespace="emojivoto", pod="emojivoto-1"}[1m])) by (le, namespace, pod))`,
+						`sum(increase(response_total{direction="inbound", namespace="emojivoto", pod="emojivoto-1"}[1m])) by (namespace, pod, classification, status_code, grpc_status)`,
+						`sum(increase(response_total{direction="inbound", namespace="emojivoto", pod="emojivoto-1"}[1m])) by (namespace, pod, classification, tls)`,
+					},
+				},
+				req: &pb.StatSummaryRequest{
+					Selector: &pb.ResourceSelection{
+						Resource: &pb.Resource{
+							Name:      "emojivoto-1",
+							Namespace: "emojivoto",
+							Type:      pkgK8s.Pod,
+						},
+					},
+					TimeWindow:      "1m",
+					StatusBreakdown: true,
+				},
+				expectedResponse: expectedResponse,
+			},
+		}
+
+		testStatSummary(t, expectations)
+	})
+
 	t.Run("Queries prometheus for outbound TCP stats if --to resource is specified", func(t *testing.T) {
 
 		expectations := []statSumExpected{
@@ -1477,6 +1608,7 @@ status:
 			fakeGrpcServer := newGrpcServer(
 				&prometheus.MockProm{Res: exp.mockPromResponse},
 				k8sAPI,
+				nil,
 				"linkerd",
 				"mycluster.local",
 				[]string{},
@@ -1501,6 +1633,7 @@ status:
 		fakeGrpcServer := newGrpcServer(
 			&prometheus.MockProm{Res: model.Vector{}},
 			k8sAPI,
+			nil,
 			"linkerd",
 			"mycluster.local",
 			[]string{},
@@ -1547,6 +1680,17 @@ status:
 					},
 				},
 			},
+			{
+				req: &pb.StatSummaryRequest{
+					Selector: &pb.ResourceSelection{
+						Resource: &pb.Resource{
+							Type: pkgK8s.Pod,
+						},
+					},
+					TimeWindow:       "1m",
+					LatencyQuantiles: []float64{0.99, 1},
+				},
+			},
 		}
 
 		for _, invalid := range invalidRequests {
@@ -1956,3 +2100,132 @@ status:
 		testStatSummary(t, expectations)
 	})
 }
+
+// rangeProm returns a vector to instant queries and a matrix to range
+// queries, recording when they are evaluated
+type rangeProm struct {
+	prometheus.MockProm
+	vector model.Vector
+	matrix model.Matrix
+	times  []time.Time
+	ranges []promv1.Range
+	mu     sync.Mutex
+}
+
+func (m *rangeProm) Query(ctx context.Context, query string, ts time.Time) (model.Value, promv1.Warnings, error) {
+	m.MockProm.Query(ctx, query, ts)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.times = append(m.times, ts)
+	return m.vector, nil, nil
+}
+
+func (m *rangeProm) QueryRange(ctx context.Context, query string, r promv1.Range) (model.Value, promv1.Warnings, error) {
+	m.MockProm.QueryRange(ctx, query, r)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.ranges = append(m.ranges, r)
+	return m.matrix, nil, nil
+}
+
+func TestStatSummaryTimeRange(t *testing.T) {
+	k8sAPI, err := k8s.NewFakeAPI(`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: emoji
+  namespace: emojivoto
+  labels:
+    app: emoji-svc
+    linkerd.io/control-plane-ns: linkerd
+status:
+  phase: Running
+`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	k8sAPI.Sync(nil)
+
+	sample := genPromSample("emoji", pkgK8s.Pod, "emojivoto", false)
+	promAPI := &rangeProm{
+		vector: model.Vector{sample},
+		matrix: model.Matrix{
+			{
+				Metric: sample.Metric,
+				Values: []model.SamplePair{
+					{Timestamp: model.TimeFromUnix(2800), Value: 10},
+					{Timestamp: model.TimeFromUnix(4600), Value: 20},
+				},
+			},
+		},
+	}
+	server := newGrpcServer(promAPI, k8sAPI, nil, "linkerd", "cluster.local", []string{})
+
+	req := &pb.StatSummaryRequest{
+		Selector: &pb.ResourceSelection{
+			Resource: &pb.Resource{
+				Namespace: "emojivoto",
+				Type:      pkgK8s.Pod,
+			},
+		},
+		TimeWindow: "1m",
+		TimeRange: &pb.TimeRange{
+			Start: 1000,
+			End:   4600,
+			Step:  durationpb.New(30 * time.Minute),
+		},
+	}
+	rsp, err := server.StatSummary(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	rows := rsp.GetOk().GetStatTables()[0].GetPodGroup().GetRows()
+	if len(rows) != 1 {
+		t.Fatalf("Expected 1 row, got %v", rows)
+	}
+	if rows[0].TimeWindow != "3600s" {
+		t.Errorf("Expected the time window of the range, got %s", rows[0].TimeWindow)
+	}
+	expectedSeries := []*pb.StatsSample{
+		{Timestamp: 2800, Stats: &pb.BasicStats{SuccessCount: 10, LatencyMsP50: 10, LatencyMsP95: 10, LatencyMsP99: 10}},
+		{Timestamp: 4600, Stats: &pb.BasicStats{SuccessCount: 20, LatencyMsP50: 20, LatencyMsP95: 20, LatencyMsP99: 20}},
+	}
+	if len(rows[0].Series) != len(expectedSeries) {
+		t.Fatalf("Expected series %v, got %v", expectedSeries, rows[0].Series)
+	}
+	for i, sample := range rows[0].Series {
+		if !proto.Equal(sample, expectedSeries[i]) {
+			t.Errorf("Expected sample %v, got %v", expectedSeries[i], sample)
+		}
+	}
+
+	for _, ts := range promAPI.times {
+		if !ts.Equal(time.Unix(4600, 0)) {
+			t.Errorf("Expected the queries to be evaluated at the end of the range, got %s", ts)
+		}
+	}
+	expectedRange := promv1.Range{Start: time.Unix(2800, 0), End: time.Unix(4600, 0), Step: 30 * time.Minute}
+	if len(promAPI.ranges) != 4 {
+		t.Fatalf("Expected 4 range queries, got %d", len(promAPI.ranges))
+	}
+	for _, r := range promAPI.ranges {
+		if r != expectedRange {
+			t.Errorf("Expected the range %+v, got %+v", expectedRange, r)
+		}
+	}
+	for _, query := range promAPI.QueriesExecuted {
+		if !strings.Contains(query, "[3600s]") && !strings.Contains(query, "[1800s]") {
+			t.Errorf("Unexpected query window: %s", query)
+		}
+	}
+
+	req.TimeRange = &pb.TimeRange{Start: 4600, End: 1000}
+	rsp, err = server.StatSummary(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if rsp.GetError() == nil {
+		t.Fatalf("Expected an error for an invalid time range, got %v", rsp)
+	}
+}
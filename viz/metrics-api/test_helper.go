@@ -23,6 +23,7 @@ type MockAPIClient struct {
 	TopRoutesResponseToReturn    *pb.TopRoutesResponse
 	EdgesResponseToReturn        *pb.EdgesResponse
 	SelfCheckResponseToReturn    *pb.SelfCheckResponse
+	SLOsResponseToReturn         *pb.ServiceLevelObjectivesResponse
 }
 
 // StatSummary provides a mock of a metrics-api method.
@@ -60,6 +61,11 @@ func (c *MockAPIClient) SelfCheck(ctx context.Context, in *pb.SelfCheckRequest,
 	return c.SelfCheckResponseToReturn, c.ErrorToReturn
 }
 
+// ServiceLevelObjectives provides a mock of a metrics-api method.
+func (c *MockAPIClient) ServiceLevelObjectives(ctx context.Context, in *pb.ServiceLevelObjectivesRequest, _ ...grpc.CallOption) (*pb.ServiceLevelObjectivesResponse, error) {
+	return c.SLOsResponseToReturn, c.ErrorToReturn
+}
+
 // PodCounts is a test helper struct that is used for representing data in a
 // StatTable.PodGroup.Row.
 type PodCounts struct {
@@ -371,6 +377,7 @@ func newMockGrpcServer(exp expectedStatRPC) (*prometheus.MockProm, *grpcServer,
 	fakeGrpcServer := newGrpcServer(
 		mockProm,
 		k8sAPI,
+		nil,
 		"linkerd",
 		"cluster.local",
 		[]string{},
@@ -21,6 +21,8 @@ const (
 	routeReqQuery             = "sum(increase(route_response_total%s[%s])) by (%s, dst, classification)"
 	actualRouteReqQuery       = "sum(increase(route_actual_response_total%s[%s])) by (%s, dst, classification)"
 	routeLatencyQuantileQuery = "histogram_quantile(%s, sum(irate(route_response_latency_ms_bucket%s[%s])) by (le, dst, %s))"
+	routeLatencyBucketsQuery  = "sum(increase(route_response_latency_ms_bucket%s[%s])) by (le, dst, %s)"
+	routeStatusCountsQuery    = "sum(increase(route_response_total%s[%s])) by (%s, dst, classification, status_code, grpc_status)"
 	dstLabel                  = `dst=~"(%s)(:\\d+)?"`
 	// DefaultRouteName is the name to display for requests that don't match any routes.
 	DefaultRouteName = "[DEFAULT]"
@@ -195,6 +197,13 @@ func validateRequest(req *pb.TopRoutesRequest) *pb.TopRoutesResponse {
 			return topRoutesError(req, fmt.Sprintf("The %s resource type is not supported with 'to' queries", targetType))
 		}
 	}
+
+	if _, err := newQueryTime(req.TimeWindow, req.GetTimeRange()); err != nil {
+		return topRoutesError(req, err.Error())
+	}
+	if err := validateLatencyQuantiles(req.GetLatencyQuantiles()); err != nil {
+		return topRoutesError(req, err.Error())
+	}
 	return nil
 }
 
@@ -229,7 +238,10 @@ func (s *grpcServer) getProfilesForAuthority(authority string, clientNs string,
 }
 
 func (s *grpcServer) getRouteMetrics(ctx context.Context, req *pb.TopRoutesRequest, profiles map[string]*sp.ServiceProfile, resource *pb.Resource) (indexedTable, error) {
-	timeWindow := req.TimeWindow
+	qt, err := newQueryTime(req.TimeWindow, req.GetTimeRange())
+	if err != nil {
+		return nil, err
+	}
 
 	dsts := make([]string, 0)
 	for _, p := range profiles {
@@ -237,6 +249,51 @@ func (s *grpcServer) getRouteMetrics(ctx context.Context, req *pb.TopRoutesReque
 	}
 
 	reqLabels := s.buildRouteLabels(req, dsts, resource)
+	queries, quantileQueries := routeQueries(req, reqLabels, qt.window, req.LatencyQuantiles...)
+	if req.LatencyHistogram {
+		queries[promLatencyBuckets] = fmt.Sprintf(routeLatencyBucketsQuery, reqLabels, qt.window, "rt_route")
+	}
+	if req.StatusBreakdown {
+		queries[promStatusCounts] = fmt.Sprintf(routeStatusCountsQuery, reqLabels, qt.window, "rt_route")
+	}
+	results, err := s.getPrometheusMetrics(ctx, queries, quantileQueries, qt.ts)
+	if err != nil {
+		return nil, err
+	}
+
+	table := newRouteTable(profiles)
+	processRouteMetrics(results, qt.window, table, latencyQuantileTypes(req.LatencyQuantiles))
+
+	if qt.series != nil {
+		window := promDuration(qt.series.Step)
+		queries, quantileQueries := routeQueries(req, reqLabels, window)
+		series, err := s.getPrometheusSeries(ctx, mergeQueries(queries, quantileQueries), *qt.series)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ts := range sortedSeriesTimes(series) {
+			stepTable := newRouteTable(profiles)
+			processRouteMetrics(series[ts], window, stepTable, nil)
+			for key, row := range stepTable {
+				// the time window is only set for the routes with samples
+				if row.TimeWindow == "" {
+					continue
+				}
+				table[key].Series = append(table[key].Series, &pb.StatsSample{
+					Timestamp: ts.Unix(),
+					Stats:     row.Stats,
+				})
+			}
+		}
+	}
+
+	return table, nil
+}
+
+// routeQueries returns the requests and latency queries of the routes over
+// the given window, including the queries of the given latency quantiles
+func routeQueries(req *pb.TopRoutesRequest, reqLabels, timeWindow string, quantiles ...float64) (map[promType]string, map[promType]string) {
 	groupBy := "rt_route"
 
 	queries := map[promType]string{
@@ -248,12 +305,12 @@ func (s *grpcServer) getRouteMetrics(ctx context.Context, req *pb.TopRoutesReque
 		queries[promActualRequests] = fmt.Sprintf(actualRouteReqQuery, reqLabels, timeWindow, groupBy)
 	}
 
-	quantileQueries := generateQuantileQueries(routeLatencyQuantileQuery, reqLabels, timeWindow, groupBy)
-	results, err := s.getPrometheusMetrics(ctx, queries, quantileQueries)
-	if err != nil {
-		return nil, err
-	}
+	return queries, generateQuantileQueries(routeLatencyQuantileQuery, reqLabels, timeWindow, groupBy, quantiles...)
+}
 
+// newRouteTable returns a table with empty stats for all the routes of the
+// profiles, including their default route
+func newRouteTable(profiles map[string]*sp.ServiceProfile) indexedTable {
 	table := make(indexedTable)
 	for service, profile := range profiles {
 		for _, route := range profile.Spec.Routes {
@@ -277,10 +334,7 @@ func (s *grpcServer) getRouteMetrics(ctx context.Context, req *pb.TopRoutesReque
 			Stats:     &pb.BasicStats{},
 		}
 	}
-
-	processRouteMetrics(results, timeWindow, table)
-
-	return table, nil
+	return table
 }
 
 func (s *grpcServer) buildRouteLabels(req *pb.TopRoutesRequest, dsts []string, resource *pb.Resource) string {
@@ -313,7 +367,9 @@ func renderLabels(labels model.LabelSet, services []string) string {
 	return fmt.Sprintf("{%s}", strings.Join(pairs, ", "))
 }
 
-func processRouteMetrics(results []promResult, timeWindow string, table indexedTable) {
+// processRouteMetrics sets the stats of the routes of the table from the
+// results of the queries, including the latencies of the requested quantiles
+func processRouteMetrics(results []promResult, timeWindow string, table indexedTable, quantiles map[promType]float64) {
 	for _, result := range results {
 		for _, sample := range result.vec {
 			route := string(sample.Metric[model.LabelName("rt_route")])
@@ -330,6 +386,10 @@ func processRouteMetrics(results []promResult, timeWindow string, table indexedT
 			table[key].TimeWindow = timeWindow
 			value := extractSampleValue(sample)
 
+			if quantile, ok := quantiles[result.prom]; ok {
+				addLatencyQuantile(table[key].Stats, quantile, value)
+			}
+
 			switch result.prom {
 			case promRequests:
 				switch string(sample.Metric[model.LabelName("classification")]) {
@@ -351,6 +411,10 @@ func processRouteMetrics(results []promResult, timeWindow string, table indexedT
 				table[key].Stats.LatencyMsP95 = value
 			case promLatencyP99:
 				table[key].Stats.LatencyMsP99 = value
+			case promLatencyBuckets:
+				addLatencyBucket(table[key].Stats, sample)
+			case promStatusCounts:
+				addStatusCount(table[key].Stats, sample)
 			}
 		}
 	}
@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"fmt"
+	"math"
 	"sort"
 	"testing"
 
@@ -286,6 +287,103 @@ func TestTopRoutes(t *testing.T) {
 		testTopRoutes(t, expectations)
 	})
 
+	t.Run("Successfully performs a routes query with additional latency quantiles and the latency histogram", func(t *testing.T) {
+		routes := []string{"/a"}
+		counts := []uint64{123}
+		samples := routesMetric(routes)
+		for _, sample := range samples {
+			sample.Metric["le"] = "+Inf"
+		}
+		expectedResponse := GenTopRoutesResponse(routes, counts, false, "books")
+		for _, row := range expectedResponse.GetOk().GetRoutes()[0].Rows {
+			row.Stats.LatencyQuantiles = []*pb.LatencyQuantile{{Quantile: 0.999, LatencyMs: 123}}
+			row.Stats.LatencyHistogram = []*pb.LatencyBucket{{Le: math.Inf(1), Count: 123}}
+		}
+
+		expectations := []topRoutesExpected{
+			{
+				expectedStatRPC: expectedStatRPC{
+					err:              nil,
+					mockPromResponse: samples,
+					expectedPrometheusQueries: []string{
+						`histogram_quantile(0.5, sum(irate(route_response_latency_ms_bucket{deployment="books", direction="inbound", dst=~"(books.default.svc.cluster.local)(:\\d+)?", namespace="default"}[1m])) by (le, dst, rt_route))`,
+						`histogram_quantile(0.95, sum(irate(route_response_latency_ms_bucket{deployment="books", direction="inbound", dst=~"(books.default.svc.cluster.local)(:\\d+)?", namespace="default"}[1m])) by (le, dst, rt_route))`,
+						`histogram_quantile(0.99, sum(irate(route_response_latency_ms_bucket{deployment="books", direction="inbound", dst=~"(books.default.svc.cluster.local)(:\\d+)?", namespace="default"}[1m])) by (le, dst, rt_route))`,
+						`histogram_quantile(0.999, sum(irate(route_response_latency_ms_bucket{deployment="books", direction="inbound", dst=~"(books.default.svc.cluster.local)(:\\d+)?", namespace="default"}[1m])) by (le, dst, rt_route))`,
+						`sum(increase(route_response_latency_ms_bucket{deployment="books", direction="inbound", dst=~"(books.default.svc.cluster.local)(:\\d+)?", namespace="default"}[1m])) by (le, dst, rt_route)`,
+						`sum(increase(route_response_total{deployment="books", direction="inbound", dst=~"(books.default.svc.cluster.local)(:\\d+)?", namespace="default"}[1m])) by (rt_route, dst, classification)`,
+					},
+					k8sConfigs: booksConfig,
+				},
+				req: &pb.TopRoutesRequest{
+					Selector: &pb.ResourceSelection{
+						Resource: &pb.Resource{
+							Namespace: "default",
+							Type:      pkgK8s.Deployment,
+							Name:      "books",
+						},
+					},
+					TimeWindow: "1m",
+					Outbound: &pb.TopRoutesRequest_None{
+						None: &pb.Empty{},
+					},
+					LatencyQuantiles: []float64{0.999},
+					LatencyHistogram: true,
+				},
+				expectedResponse: expectedResponse,
+			},
+		}
+
+		testTopRoutes(t, expectations)
+	})
+
+	t.Run("Successfully performs a routes query with the breakdown of the responses by status", func(t *testing.T) {
+		routes := []string{"/a"}
+		counts := []uint64{123}
+		samples := routesMetric(routes)
+		for _, sample := range samples {
+			sample.Metric["status_code"] = "503"
+		}
+		expectedResponse := GenTopRoutesResponse(routes, counts, false, "books")
+		for _, row := range expectedResponse.GetOk().GetRoutes()[0].Rows {
+			row.Stats.StatusCounts = []*pb.StatusCount{{HttpStatus: "503", SuccessCount: 123}}
+		}
+
+		expectations := []topRoutesExpected{
+			{
+				expectedStatRPC: expectedStatRPC{
+					err:              nil,
+					mockPromResponse: samples,
+					expectedPrometheusQueries: []string{
+						`histogram_quantile(0.5, sum(irate(route_response_latency_ms_bucket{deployment="books", direction="inbound", dst=~"(books.default.svc.cluster.local)(:\\d+)?", namespace="default"}[1m])) by (le, dst, rt_route))`,
+						`histogram_quantile(0.95, sum(irate(route_response_latency_ms_bucket{deployment="books", direction="inbound", dst=~"(books.default.svc.cluster.local)(:\\d+)?", namespace="default"}[1m])) by (le, dst, rt_route))`,
+						`histogram_quantile(0.99, sum(irate(route_response_latency_ms_bucket{deployment="books", direction="inbound", dst=~"(books.default.svc.cluster.local)(:\\d+)?", namespace="default"}[1m])) by (le, dst, rt_route))`,
+						`sum(increase(route_response_total{deployment="books", direction="inbound", dst=~"(books.default.svc.cluster.local)(:\\d+)?", namespace="default"}[1m])) by (rt_route, dst, classification)`,
+						`sum(increase(route_response_total{deployment="books", direction="inbound", dst=~"(books.default.svc.cluster.local)(:\\d+)?", namespace="default"}[1m])) by (rt_route, dst, classification, status_code, grpc_status)`,
+					},
+					k8sConfigs: booksConfig,
+				},
+				req: &pb.TopRoutesRequest{
+					Selector: &pb.ResourceSelection{
+						Resource: &pb.Resource{
+							Namespace: "default",
+							Type:      pkgK8s.Deployment,
+							Name:      "books",
+						},
+					},
+					TimeWindow: "1m",
+					Outbound: &pb.TopRoutesRequest_None{
+						None: &pb.Empty{},
+					},
+					StatusBreakdown: true,
+				},
+				expectedResponse: expectedResponse,
+			},
+		}
+
+		testTopRoutes(t, expectations)
+	})
+
 	t.Run("Successfully performs a routes query for a service", func(t *testing.T) {
 		routes := []string{"/a"}
 		counts := []uint64{123}
@@ -2,6 +2,7 @@ package util
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/linkerd/linkerd2/pkg/k8s"
@@ -17,11 +18,21 @@ var (
 // StatsBaseRequestParams contains parameters that are used to build requests
 // for metrics data.  This includes requests to StatSummary and TopRoutes.
 type StatsBaseRequestParams struct {
-	TimeWindow    string
+	TimeWindow string
+	// TimeRange, if set, is the absolute range of the metrics, which is used
+	// instead of the TimeWindow ending now
+	TimeRange     *pb.TimeRange
 	Namespace     string
 	ResourceType  string
 	ResourceName  string
 	AllNamespaces bool
+	// LatencyQuantiles are the latency quantiles to compute in addition to
+	// the p50, p95 and p99
+	LatencyQuantiles []float64
+	LatencyHistogram bool
+	// StatusBreakdown requests the breakdown of the responses by HTTP and
+	// gRPC status
+	StatusBreakdown bool
 }
 
 // StatsSummaryRequestParams contains parameters that are used to build
@@ -65,6 +76,53 @@ type GatewayRequestParams struct {
 	TimeWindow        string
 }
 
+// BuildTimeRange builds the absolute time range between since and until,
+// which are either RFC3339 timestamps or durations before now. An empty until
+// means now. It returns nil if since is empty.
+func BuildTimeRange(since, until string, now time.Time) (*pb.TimeRange, error) {
+	if since == "" {
+		if until != "" {
+			return nil, errors.New("an end time requires a start time")
+		}
+		return nil, nil
+	}
+
+	start, err := parseTime(since, now)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start time: %s", err)
+	}
+	end := now
+	if until != "" {
+		end, err = parseTime(until, now)
+		if err != nil {
+			return nil, fmt.Errorf("invalid end time: %s", err)
+		}
+	}
+	if !end.After(start) {
+		return nil, fmt.Errorf("the end time (%s) must be after the start time (%s)", end.Format(time.RFC3339), start.Format(time.RFC3339))
+	}
+
+	return &pb.TimeRange{
+		Start: start.Unix(),
+		End:   end.Unix(),
+	}, nil
+}
+
+// parseTime parses an RFC3339 timestamp, or a duration before now
+func parseTime(value string, now time.Time) (time.Time, error) {
+	if d, err := time.ParseDuration(value); err == nil {
+		if d < 0 {
+			return time.Time{}, fmt.Errorf("negative duration %s", value)
+		}
+		return now.Add(-d), nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is neither a duration nor an RFC3339 timestamp", value)
+	}
+	return t, nil
+}
+
 // BuildStatSummaryRequest builds a Public API StatSummaryRequest from a
 // StatsSummaryRequestParams.
 func BuildStatSummaryRequest(p StatsSummaryRequestParams) (*pb.StatSummaryRequest, error) {
@@ -82,6 +140,10 @@ func BuildStatSummaryRequest(p StatsSummaryRequestParams) (*pb.StatSummaryReques
 		window = p.TimeWindow
 	}
 
+	if p.TimeRange != nil && p.TimeRange.GetEnd()-p.TimeRange.GetStart() < int64(metricTimeWindowLowerBound/time.Second) {
+		return nil, errors.New("metrics time range needs to be at least 15s")
+	}
+
 	if p.AllNamespaces && p.ResourceName != "" {
 		return nil, errors.New("stats for a resource cannot be retrieved by name across all namespaces")
 	}
@@ -107,9 +169,13 @@ func BuildStatSummaryRequest(p StatsSummaryRequestParams) (*pb.StatSummaryReques
 			},
 			LabelSelector: p.LabelSelector,
 		},
-		TimeWindow: window,
-		SkipStats:  p.SkipStats,
-		TcpStats:   p.TCPStats,
+		TimeWindow:       window,
+		SkipStats:        p.SkipStats,
+		TcpStats:         p.TCPStats,
+		TimeRange:        p.TimeRange,
+		LatencyQuantiles: p.LatencyQuantiles,
+		LatencyHistogram: p.LatencyHistogram,
+		StatusBreakdown:  p.StatusBreakdown,
 	}
 
 	if p.ToName != "" || p.ToType != "" || p.ToNamespace != "" {
@@ -225,7 +291,11 @@ func BuildTopRoutesRequest(p TopRoutesRequestParams) (*pb.TopRoutesRequest, erro
 			},
 			LabelSelector: p.LabelSelector,
 		},
-		TimeWindow: window,
+		TimeWindow:       window,
+		TimeRange:        p.TimeRange,
+		LatencyQuantiles: p.LatencyQuantiles,
+		LatencyHistogram: p.LatencyHistogram,
+		StatusBreakdown:  p.StatusBreakdown,
 	}
 
 	if p.ToName != "" || p.ToType != "" || p.ToNamespace != "" {
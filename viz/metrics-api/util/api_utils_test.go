@@ -3,6 +3,7 @@ package util
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/linkerd/linkerd2/pkg/k8s"
 	pb "github.com/linkerd/linkerd2/viz/metrics-api/gen/viz"
@@ -161,6 +162,61 @@ func TestBuildTopRoutesRequest(t *testing.T) {
 	})
 }
 
+func TestBuildTimeRange(t *testing.T) {
+	now := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("Parses durations and timestamps", func(t *testing.T) {
+		expectations := []struct {
+			since    string
+			until    string
+			expected *pb.TimeRange
+		}{
+			{since: "", until: "", expected: nil},
+			{since: "2h", until: "", expected: &pb.TimeRange{Start: now.Add(-2 * time.Hour).Unix(), End: now.Unix()}},
+			{since: "2h", until: "1h", expected: &pb.TimeRange{Start: now.Add(-2 * time.Hour).Unix(), End: now.Add(-time.Hour).Unix()}},
+			{since: "2021-10-01T10:00:00Z", until: "2021-10-01T11:30:00+01:00", expected: &pb.TimeRange{Start: now.Add(-2 * time.Hour).Unix(), End: now.Add(-90 * time.Minute).Unix()}},
+		}
+
+		for _, exp := range expectations {
+			timeRange, err := BuildTimeRange(exp.since, exp.until, now)
+			if err != nil {
+				t.Fatalf("Unexpected error from BuildTimeRange(%s, %s): %s", exp.since, exp.until, err)
+			}
+			if !reflect.DeepEqual(timeRange, exp.expected) {
+				t.Fatalf("Expected BuildTimeRange(%s, %s) to return %v, got %v", exp.since, exp.until, exp.expected, timeRange)
+			}
+		}
+	})
+
+	t.Run("Rejects invalid ranges", func(t *testing.T) {
+		expectations := map[[2]string]string{
+			{"", "1h"}:        "an end time requires a start time",
+			{"yesterday", ""}: "invalid start time: \"yesterday\" is neither a duration nor an RFC3339 timestamp",
+			{"-1h", ""}:       "invalid start time: negative duration -1h",
+			{"1h", "2h"}:      "the end time (2021-10-01T10:00:00Z) must be after the start time (2021-10-01T11:00:00Z)",
+		}
+
+		for args, msg := range expectations {
+			_, err := BuildTimeRange(args[0], args[1], now)
+			if err == nil || err.Error() != msg {
+				t.Fatalf("Expected BuildTimeRange(%s, %s) to return %s, got %v", args[0], args[1], msg, err)
+			}
+		}
+	})
+
+	t.Run("Rejects ranges shorter than 15s", func(t *testing.T) {
+		_, err := BuildStatSummaryRequest(StatsSummaryRequestParams{
+			StatsBaseRequestParams: StatsBaseRequestParams{
+				TimeRange:    &pb.TimeRange{Start: 100, End: 110},
+				ResourceType: k8s.Deployment,
+			},
+		})
+		if err == nil {
+			t.Fatal("Expected an error for a range shorter than 15s")
+		}
+	})
+}
+
 func TestK8sPodToPublicPod(t *testing.T) {
 	type podExp struct {
 		k8sPod    corev1.Pod
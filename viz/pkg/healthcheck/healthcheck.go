@@ -72,6 +72,7 @@ func (hc *HealthChecker) VizCategory() *healthcheck.Category {
 
 	return healthcheck.NewCategory(LinkerdVizExtensionCheck, []healthcheck.Checker{
 		*healthcheck.NewChecker("linkerd-viz Namespace exists").
+			WithID("linkerd-viz-namespace-exists").
 			WithHintAnchor("l5d-viz-ns-exists").
 			Fatal().
 			WithCheck(func(ctx context.Context) error {
@@ -85,6 +86,7 @@ func (hc *HealthChecker) VizCategory() *healthcheck.Category {
 				return nil
 			}),
 		*healthcheck.NewChecker("linkerd-viz ClusterRoles exist").
+			WithID("linkerd-viz-clusterroles-exist").
 			WithHintAnchor("l5d-viz-cr-exists").
 			Fatal().
 			Warning().
@@ -92,6 +94,7 @@ func (hc *HealthChecker) VizCategory() *healthcheck.Category {
 				return healthcheck.CheckClusterRoles(ctx, hc.KubeAPIClient(), true, []string{fmt.Sprintf("linkerd-%s-tap", hc.vizNamespace), fmt.Sprintf("linkerd-%s-metrics-api", hc.vizNamespace), fmt.Sprintf("linkerd-%s-tap-admin", hc.vizNamespace), "linkerd-tap-injector"}, "")
 			}),
 		*healthcheck.NewChecker("linkerd-viz ClusterRoleBindings exist").
+			WithID("linkerd-viz-clusterrolebindings-exist").
 			WithHintAnchor("l5d-viz-crb-exists").
 			Fatal().
 			Warning().
@@ -99,6 +102,7 @@ func (hc *HealthChecker) VizCategory() *healthcheck.Category {
 				return healthcheck.CheckClusterRoleBindings(ctx, hc.KubeAPIClient(), true, []string{fmt.Sprintf("linkerd-%s-tap", hc.vizNamespace), fmt.Sprintf("linkerd-%s-metrics-api", hc.vizNamespace), fmt.Sprintf("linkerd-%s-tap-auth-delegator", hc.vizNamespace), "linkerd-tap-injector"}, "")
 			}),
 		*healthcheck.NewChecker("tap API server has valid cert").
+			WithID("tap-api-server-has-valid-cert").
 			WithHintAnchor("l5d-tap-cert-valid").
 			Fatal().
 			WithCheck(func(ctx context.Context) error {
@@ -118,6 +122,7 @@ func (hc *HealthChecker) VizCategory() *healthcheck.Category {
 				return hc.CheckCertAndAnchors(cert, anchors, identityName)
 			}),
 		*healthcheck.NewChecker("tap API server cert is valid for at least 60 days").
+			WithID("tap-api-server-cert-is-valid-for-at-least-60-days").
 			WithHintAnchor("l5d-tap-cert-not-expiring-soon").
 			Warning().
 			WithCheck(func(ctx context.Context) error {
@@ -131,6 +136,7 @@ func (hc *HealthChecker) VizCategory() *healthcheck.Category {
 				return hc.CheckCertAndAnchorsExpiringSoon(cert)
 			}),
 		*healthcheck.NewChecker("tap API service is running").
+			WithID("tap-api-service-is-running").
 			WithHintAnchor("l5d-tap-api").
 			Warning().
 			WithRetryDeadline(hc.RetryDeadline).
@@ -138,6 +144,7 @@ func (hc *HealthChecker) VizCategory() *healthcheck.Category {
 				return hc.CheckAPIService(ctx, linkerdTapAPIServiceName)
 			}),
 		*healthcheck.NewChecker("linkerd-viz pods are injected").
+			WithID("linkerd-viz-pods-are-injected").
 			WithHintAnchor("l5d-viz-pods-injection").
 			Warning().
 			WithCheck(func(ctx context.Context) error {
@@ -148,6 +155,7 @@ func (hc *HealthChecker) VizCategory() *healthcheck.Category {
 				return healthcheck.CheckIfDataPlanePodsExist(pods)
 			}),
 		*healthcheck.NewChecker("viz extension pods are running").
+			WithID("viz-extension-pods-are-running").
 			WithHintAnchor("l5d-viz-pods-running").
 			Warning().
 			WithRetryDeadline(hc.RetryDeadline).
@@ -167,12 +175,14 @@ func (hc *HealthChecker) VizCategory() *healthcheck.Category {
 				return healthcheck.CheckPodsRunning(pods, "")
 			}),
 		*healthcheck.NewChecker("viz extension proxies are healthy").
+			WithID("viz-extension-proxies-are-healthy").
 			WithHintAnchor("l5d-viz-proxy-healthy").
 			Fatal().
 			WithCheck(func(ctx context.Context) (err error) {
 				return hc.CheckProxyHealth(ctx, hc.ControlPlaneNamespace, hc.vizNamespace)
 			}),
 		*healthcheck.NewChecker("viz extension proxies are up-to-date").
+			WithID("viz-extension-proxies-are-up-to-date").
 			WithHintAnchor("l5d-viz-proxy-cp-version").
 			Warning().
 			WithCheck(func(ctx context.Context) error {
@@ -198,6 +208,7 @@ func (hc *HealthChecker) VizCategory() *healthcheck.Category {
 				return hc.CheckProxyVersionsUpToDate(pods)
 			}),
 		*healthcheck.NewChecker("viz extension proxies and cli versions match").
+			WithID("viz-extension-proxies-and-cli-versions-match").
 			WithHintAnchor("l5d-viz-proxy-cli-version").
 			Warning().
 			WithCheck(func(ctx context.Context) error {
@@ -209,6 +220,7 @@ func (hc *HealthChecker) VizCategory() *healthcheck.Category {
 				return healthcheck.CheckIfProxyVersionsMatchWithCLI(pods)
 			}),
 		*healthcheck.NewChecker("prometheus is installed and configured correctly").
+			WithID("prometheus-is-installed-and-configured-correctly").
 			WithHintAnchor("l5d-viz-prometheus").
 			Warning().
 			WithCheck(func(ctx context.Context) error {
@@ -248,6 +260,7 @@ func (hc *HealthChecker) VizCategory() *healthcheck.Category {
 				return nil
 			}),
 		*healthcheck.NewChecker("can initialize the client").
+			WithID("can-initialize-the-client").
 			WithHintAnchor("l5d-viz-existence-client").
 			Fatal().
 			WithCheck(func(ctx context.Context) (err error) {
@@ -255,6 +268,7 @@ func (hc *HealthChecker) VizCategory() *healthcheck.Category {
 				return
 			}),
 		*healthcheck.NewChecker("viz extension self-check").
+			WithID("viz-extension-self-check").
 			WithHintAnchor("l5d-viz-metrics-api").
 			Fatal().
 			// to avoid confusing users with a prometheus readiness error, we only show
@@ -293,6 +307,7 @@ func (hc *HealthChecker) VizDataPlaneCategory() *healthcheck.Category {
 
 	return healthcheck.NewCategory(LinkerdVizExtensionDataPlaneCheck, []healthcheck.Checker{
 		*healthcheck.NewChecker("data plane namespace exists").
+			WithID("data-plane-namespace-exists").
 			WithHintAnchor("l5d-data-plane-exists").
 			Fatal().
 			WithCheck(func(ctx context.Context) error {
@@ -303,6 +318,7 @@ func (hc *HealthChecker) VizDataPlaneCategory() *healthcheck.Category {
 				return hc.CheckNamespace(ctx, hc.DataPlaneNamespace, true)
 			}),
 		*healthcheck.NewChecker("data plane proxy metrics are present in Prometheus").
+			WithID("data-plane-proxy-metrics-are-present-in-prometheus").
 			WithHintAnchor("l5d-data-plane-prom").
 			Warning().
 			WithRetryDeadline(hc.RetryDeadline).
@@ -0,0 +1,157 @@
+package slo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/prometheus/common/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+// DefaultBurnRateWindow is the window of the burn rate of the objectives which
+// don't specify one
+const DefaultBurnRateWindow = "1h"
+
+// GroupVersionResource is the resource of the ServiceLevelObjective CRD
+var GroupVersionResource = schema.GroupVersionResource{
+	Group:    "viz.linkerd.io",
+	Version:  "v1alpha1",
+	Resource: "servicelevelobjectives",
+}
+
+// ServiceLevelObjective declares the objectives of the success rate and
+// latency of the inbound traffic of a resource over a window
+type ServiceLevelObjective struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec Spec `json:"spec"`
+
+	// ConversionError is set when the object couldn't be converted, in which
+	// case only its namespace and name are set
+	ConversionError error `json:"-"`
+}
+
+// Spec is the spec of a ServiceLevelObjective
+type Spec struct {
+	Target Target `json:"target"`
+	// Window is the Prometheus duration over which the objectives are
+	// measured
+	Window string `json:"window"`
+	// SuccessRate is the objective of the percentage of successful requests
+	SuccessRate *float64 `json:"successRate,omitempty"`
+	// Latency is the objective of the latency of a percentile of the requests
+	Latency *LatencyObjective `json:"latency,omitempty"`
+	// BurnRateWindow is the Prometheus duration over which the burn rate of
+	// the error budget is measured
+	BurnRateWindow string `json:"burnRateWindow,omitempty"`
+}
+
+// Target is the resource an objective applies to
+type Target struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+}
+
+// LatencyObjective is the maximum latency of a percentile of the requests
+type LatencyObjective struct {
+	Percentile  float64 `json:"percentile"`
+	ThresholdMs uint64  `json:"thresholdMs"`
+}
+
+// List returns the objectives of a namespace, or of all the namespaces if it
+// is empty, sorted by namespace and name. The objectives which can't be
+// converted are returned with their ConversionError set, so that they don't
+// prevent the others from being listed.
+func List(ctx context.Context, client dynamic.Interface, namespace string) ([]*ServiceLevelObjective, error) {
+	list, err := client.Resource(GroupVersionResource).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	objectives := make([]*ServiceLevelObjective, 0, len(list.Items))
+	for i := range list.Items {
+		slo, err := FromUnstructured(&list.Items[i])
+		if err != nil {
+			slo = &ServiceLevelObjective{ConversionError: err}
+			slo.Namespace = list.Items[i].GetNamespace()
+			slo.Name = list.Items[i].GetName()
+		}
+		objectives = append(objectives, slo)
+	}
+
+	sort.Slice(objectives, func(i, j int) bool {
+		if objectives[i].Namespace != objectives[j].Namespace {
+			return objectives[i].Namespace < objectives[j].Namespace
+		}
+		return objectives[i].Name < objectives[j].Name
+	})
+	return objectives, nil
+}
+
+// FromUnstructured converts an unstructured ServiceLevelObjective
+func FromUnstructured(obj *unstructured.Unstructured) (*ServiceLevelObjective, error) {
+	var slo ServiceLevelObjective
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), &slo); err != nil {
+		return nil, fmt.Errorf("invalid ServiceLevelObjective %s/%s: %s", obj.GetNamespace(), obj.GetName(), err)
+	}
+	return &slo, nil
+}
+
+// Validate returns an error if the objective can't be evaluated
+func (slo *ServiceLevelObjective) Validate() error {
+	if slo.ConversionError != nil {
+		return slo.ConversionError
+	}
+	if _, err := k8s.CanonicalResourceNameFromFriendlyName(slo.Spec.Target.Kind); err != nil {
+		return fmt.Errorf("invalid target kind: %s", err)
+	}
+	if slo.Spec.Target.Name == "" {
+		return errors.New("the target needs a name")
+	}
+	if _, err := model.ParseDuration(slo.Spec.Window); err != nil {
+		return fmt.Errorf("invalid window: %s", err)
+	}
+	if _, err := model.ParseDuration(slo.BurnRateWindow()); err != nil {
+		return fmt.Errorf("invalid burn rate window: %s", err)
+	}
+	if slo.Spec.SuccessRate == nil && slo.Spec.Latency == nil {
+		return errors.New("either a success rate or a latency objective is required")
+	}
+	if sr := slo.Spec.SuccessRate; sr != nil && (*sr <= 0 || *sr >= 100) {
+		return fmt.Errorf("the success rate objective needs to be between 0 and 100 exclusive, got %v", *sr)
+	}
+	if l := slo.Spec.Latency; l != nil && (l.Percentile <= 0 || l.Percentile >= 100) {
+		return fmt.Errorf("the latency percentile needs to be between 0 and 100 exclusive, got %v", l.Percentile)
+	}
+	return nil
+}
+
+// BurnRateWindow returns the window of the burn rate of the objective
+func (slo *ServiceLevelObjective) BurnRateWindow() string {
+	if slo.Spec.BurnRateWindow == "" {
+		return DefaultBurnRateWindow
+	}
+	return slo.Spec.BurnRateWindow
+}
+
+// ErrorBudgetRemaining returns the fraction of the error budget of a success
+// rate objective which is left, given the success rate over its window. It's
+// negative when the budget is overspent.
+func ErrorBudgetRemaining(objective, successRate float64) float64 {
+	return 1 - BurnRate(objective, successRate)
+}
+
+// BurnRate returns the rate at which the error budget of a success rate
+// objective is consumed, given the success rate over a period. A rate of 1
+// exhausts the budget exactly at the end of the window.
+func BurnRate(objective, successRate float64) float64 {
+	return (1 - successRate) / (1 - objective)
+}
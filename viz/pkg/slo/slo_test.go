@@ -0,0 +1,82 @@
+package slo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	successRate := func(sr float64) *float64 { return &sr }
+
+	testCases := []struct {
+		spec Spec
+		err  string
+	}{
+		{
+			spec: Spec{Target: Target{Kind: "deploy", Name: "web"}, Window: "30d", SuccessRate: successRate(99.9)},
+		},
+		{
+			spec: Spec{Target: Target{Kind: "deploy", Name: "web"}, Window: "1w", Latency: &LatencyObjective{Percentile: 99, ThresholdMs: 300}, BurnRateWindow: "5m"},
+		},
+		{
+			spec: Spec{Target: Target{Kind: "foo", Name: "web"}, Window: "30d", SuccessRate: successRate(99.9)},
+			err:  "invalid target kind: cannot find Kubernetes canonical name from friendly name [foo]",
+		},
+		{
+			spec: Spec{Target: Target{Kind: "deploy"}, Window: "30d", SuccessRate: successRate(99.9)},
+			err:  "the target needs a name",
+		},
+		{
+			spec: Spec{Target: Target{Kind: "deploy", Name: "web"}, Window: "a month", SuccessRate: successRate(99.9)},
+			err:  "invalid window: not a valid duration string: \"a month\"",
+		},
+		{
+			spec: Spec{Target: Target{Kind: "deploy", Name: "web"}, Window: "30d"},
+			err:  "either a success rate or a latency objective is required",
+		},
+		{
+			spec: Spec{Target: Target{Kind: "deploy", Name: "web"}, Window: "30d", SuccessRate: successRate(100)},
+			err:  "the success rate objective needs to be between 0 and 100 exclusive, got 100",
+		},
+		{
+			spec: Spec{Target: Target{Kind: "deploy", Name: "web"}, Window: "30d", Latency: &LatencyObjective{Percentile: 0.99, ThresholdMs: 300}},
+		},
+		{
+			spec: Spec{Target: Target{Kind: "deploy", Name: "web"}, Window: "30d", Latency: &LatencyObjective{Percentile: 100, ThresholdMs: 300}},
+			err:  "the latency percentile needs to be between 0 and 100 exclusive, got 100",
+		},
+	}
+
+	for i, tc := range testCases {
+		tc := tc // pin
+		slo := &ServiceLevelObjective{Spec: tc.spec}
+		err := slo.Validate()
+		if tc.err == "" && err != nil {
+			t.Errorf("test case %d: unexpected error: %s", i, err)
+		}
+		if tc.err != "" && (err == nil || err.Error() != tc.err) {
+			t.Errorf("test case %d: expected error %q, got %v", i, tc.err, err)
+		}
+	}
+}
+
+func TestErrorBudget(t *testing.T) {
+	testCases := []struct {
+		objective, successRate float64
+		burnRate, remaining    float64
+	}{
+		{objective: 0.99, successRate: 1, burnRate: 0, remaining: 1},
+		{objective: 0.99, successRate: 0.995, burnRate: 0.5, remaining: 0.5},
+		{objective: 0.999, successRate: 0.999, burnRate: 1, remaining: 0},
+		{objective: 0.9, successRate: 0.7, burnRate: 3, remaining: -2},
+	}
+
+	for _, tc := range testCases {
+		if burnRate := BurnRate(tc.objective, tc.successRate); math.Abs(burnRate-tc.burnRate) > 1e-9 {
+			t.Errorf("expected a burn rate of %v for %v/%v, got %v", tc.burnRate, tc.successRate, tc.objective, burnRate)
+		}
+		if remaining := ErrorBudgetRemaining(tc.objective, tc.successRate); math.Abs(remaining-tc.remaining) > 1e-9 {
+			t.Errorf("expected %v of the budget remaining for %v/%v, got %v", tc.remaining, tc.successRate, tc.objective, remaining)
+		}
+	}
+}
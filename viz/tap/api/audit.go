@@ -0,0 +1,97 @@
+package api
+
+import (
+	"io"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	pb "github.com/linkerd/linkerd2/viz/tap/gen/tap"
+	"github.com/sirupsen/logrus"
+)
+
+// auditLog records the tap sessions served by the tap APIServer, one JSON
+// object per line: a "started" record once a session is authorized, and a
+// "finished" record once it ended. A nil auditLog records nothing.
+type auditLog struct {
+	log *logrus.Logger
+}
+
+// tapSession is the audit record of a tap request
+type tapSession struct {
+	user   string
+	groups []string
+
+	// the target from the request path, as it's known before the request
+	// body is decoded
+	namespace string
+	resource  string
+	name      string
+
+	req    *pb.TapByResourceRequest
+	start  time.Time
+	events uint64
+}
+
+func newAuditLog(w io.Writer) *auditLog {
+	if w == nil {
+		return nil
+	}
+
+	log := logrus.New()
+	log.SetOutput(w)
+	log.SetFormatter(&logrus.JSONFormatter{})
+	return &auditLog{log: log}
+}
+
+// started writes the audit record of a tap session once it's authorized
+func (a *auditLog) started(session *tapSession) {
+	if a == nil {
+		return
+	}
+
+	a.entry(session, "started").Infof("tap session of %s started", session.user)
+}
+
+// finished writes the audit record of a tap session once it ended, along with
+// the error it ended with, if any
+func (a *auditLog) finished(session *tapSession, err error) {
+	if a == nil {
+		return
+	}
+
+	entry := a.entry(session, "finished").WithFields(logrus.Fields{
+		"duration":        time.Since(session.start).String(),
+		"eventsDelivered": session.events,
+	})
+	if err != nil {
+		entry.WithError(err).Warnf("tap session of %s failed", session.user)
+		return
+	}
+	entry.Infof("tap session of %s ended", session.user)
+}
+
+func (a *auditLog) entry(session *tapSession, phase string) *logrus.Entry {
+	fields := logrus.Fields{
+		"component": "tap-audit",
+		"phase":     phase,
+		"user":      session.user,
+		"groups":    session.groups,
+		"namespace": session.namespace,
+		"resource":  session.resource,
+		"name":      session.name,
+		"start":     session.start.UTC().Format(time.RFC3339Nano),
+	}
+	if session.req != nil {
+		if selector := session.req.GetTarget().GetLabelSelector(); selector != "" {
+			fields["labelSelector"] = selector
+		}
+		if match := session.req.GetMatch(); match != nil {
+			if m, err := (&jsonpb.Marshaler{}).MarshalToString(match); err == nil {
+				fields["match"] = m
+			}
+		}
+		fields["extractHeaders"] = session.req.GetExtract().GetHttp().GetHeaders() != nil
+		fields["maxRps"] = session.req.GetMaxRps()
+	}
+	return a.log.WithFields(fields)
+}
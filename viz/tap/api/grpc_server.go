@@ -22,6 +22,7 @@ import (
 	metricsPb "github.com/linkerd/linkerd2/viz/metrics-api/gen/viz"
 	vizLabels "github.com/linkerd/linkerd2/viz/pkg/labels"
 	tapPb "github.com/linkerd/linkerd2/viz/tap/gen/tap"
+	"github.com/linkerd/linkerd2/viz/tap/pkg"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -42,6 +43,8 @@ type GRPCTapServer struct {
 	k8sAPI              *k8s.API
 	controllerNamespace string
 	trustDomain         string
+	// the namespaces whose headers can't be extracted
+	headersDisabledNamespaces map[string]struct{}
 }
 
 var (
@@ -135,21 +138,49 @@ func (s *GRPCTapServer) TapByResource(req *tapPb.TapByResourceRequest, stream ta
 		return apiUtil.GRPCError(err)
 	}
 
+	// the matches the proxies can't evaluate are evaluated on their events
+	filter, err := pkg.NewEventFilter(req.GetMatch())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// the tapped pods are all in the namespace of the target
+	ns := res.GetNamespace()
+	if res.GetType() == pkgK8s.Namespace {
+		ns = res.GetName()
+	}
+
 	extract := &proxy.ObserveRequest_Extract{}
 
 	// HTTP is the only protocol supported for extracting metadata, so this is
 	// the only field checked.
 	extractHTTP := req.GetExtract().GetHttp()
+	if s.headersDisabled(ns) {
+		if filter.NeedsHeaders() {
+			return status.Errorf(codes.PermissionDenied, "headers can't be matched: header extraction is disabled in namespace %s", ns)
+		}
+		if extractHTTP.GetHeaders() != nil {
+			return status.Errorf(codes.PermissionDenied, "headers can't be extracted: header extraction is disabled in namespace %s", ns)
+		}
+	}
 	if extractHTTP != nil {
 		extract = buildExtractHTTP(extractHTTP)
 	}
 
+	// headers are extracted to be matched even if they weren't requested, in
+	// which case they're removed from the events sent back
+	stripHeaders := false
+	if filter.NeedsHeaders() && extractHTTP.GetHeaders() == nil {
+		extract = buildExtractHTTP(&tapPb.TapByResourceRequest_Extract_Http{
+			Extract: &tapPb.TapByResourceRequest_Extract_Http_Headers_{
+				Headers: &tapPb.TapByResourceRequest_Extract_Http_Headers{},
+			},
+		})
+		stripHeaders = true
+	}
+
 	for _, pod := range pods {
 		// create the expected pod identity from the pod spec
-		ns := res.GetNamespace()
-		if res.GetType() == pkgK8s.Namespace {
-			ns = res.GetName()
-		}
 		name := fmt.Sprintf("%s.%s.serviceaccount.identity.%s.%s", pod.Spec.ServiceAccountName, ns, s.controllerNamespace, s.trustDomain)
 		log.Debugf("initiating tap request to %s with required name %s", pod.Spec.ServiceAccountName, name)
 
@@ -167,14 +198,45 @@ func (s *GRPCTapServer) TapByResource(req *tapPb.TapByResourceRequest, stream ta
 		case <-stream.Context().Done():
 			return nil
 		case event := <-events:
-			err := stream.Send(event)
-			if err != nil {
-				return apiUtil.GRPCError(err)
+			// the headers of the requests from or to the pods of a namespace
+			// whose headers can't be extracted are removed before they can
+			// be matched
+			if s.headersDisabled(event.GetSourceMeta().GetLabels()[pkgK8s.Namespace]) ||
+				s.headersDisabled(event.GetDestinationMeta().GetLabels()[pkgK8s.Namespace]) {
+				removeHeaders(event)
+			}
+			for _, event := range filter.Filter(event) {
+				if stripHeaders {
+					removeHeaders(event)
+				}
+				err := stream.Send(event)
+				if err != nil {
+					return apiUtil.GRPCError(err)
+				}
 			}
 		}
 	}
 }
 
+// headersDisabled returns true if the headers of the pods of the namespace
+// can't be extracted
+func (s *GRPCTapServer) headersDisabled(namespace string) bool {
+	_, ok := s.headersDisabledNamespaces[namespace]
+	return ok
+}
+
+// removeHeaders removes the headers and trailers of an HTTP event
+func removeHeaders(event *tapPb.TapEvent) {
+	switch ev := event.GetHttp().GetEvent().(type) {
+	case *tapPb.TapEvent_Http_RequestInit_:
+		ev.RequestInit.Headers = nil
+	case *tapPb.TapEvent_Http_ResponseInit_:
+		ev.ResponseInit.Headers = nil
+	case *tapPb.TapEvent_Http_ResponseEnd_:
+		ev.ResponseEnd.Trailers = nil
+	}
+}
+
 func makeByResourceMatch(match *tapPb.TapByResourceRequest_Match) (*proxy.ObserveRequest_Match, error) {
 	// TODO: for now assume it's always a single, flat `All` match list
 	seq := match.GetAll()
@@ -188,7 +250,7 @@ func makeByResourceMatch(match *tapPb.TapByResourceRequest_Match) (*proxy.Observ
 		switch typed := reqMatch.Match.(type) {
 		case *tapPb.TapByResourceRequest_Match_Destinations:
 
-			for k, v := range destinationLabels(typed.Destinations.Resource) {
+			for k, v := range pkg.DestinationLabels(typed.Destinations.Resource) {
 				matches = append(matches, &proxy.ObserveRequest_Match{
 					Match: &proxy.ObserveRequest_Match_DestinationLabel{
 						DestinationLabel: &proxy.ObserveRequest_Match_Label{
@@ -236,6 +298,12 @@ func makeByResourceMatch(match *tapPb.TapByResourceRequest_Match) (*proxy.Observ
 						},
 					},
 				}
+			case *tapPb.TapByResourceRequest_Match_Http_Status,
+				*tapPb.TapByResourceRequest_Match_Http_MinLatency,
+				*tapPb.TapByResourceRequest_Match_Http_Header:
+				// the proxies can't match these, they're evaluated on the
+				// events by an EventFilter instead
+				continue
 			default:
 				return nil, status.Errorf(codes.Unimplemented, "unknown HTTP match type: %v", httpTyped)
 			}
@@ -260,19 +328,6 @@ func makeByResourceMatch(match *tapPb.TapByResourceRequest_Match) (*proxy.Observ
 	}, nil
 }
 
-// TODO: factor out with `promLabels` in public-api
-func destinationLabels(resource *metricsPb.Resource) map[string]string {
-	dstLabels := map[string]string{}
-	if resource.Name != "" {
-		l5dLabel := pkgK8s.KindToL5DLabel(resource.Type)
-		dstLabels[l5dLabel] = resource.Name
-	}
-	if resource.Type != pkgK8s.Namespace && resource.Namespace != "" {
-		dstLabels["namespace"] = resource.Namespace
-	}
-	return dstLabels
-}
-
 func buildExtractHTTP(extract *tapPb.TapByResourceRequest_Extract_Http) *proxy.ObserveRequest_Extract {
 	if extract.GetHeaders() != nil {
 		return &proxy.ObserveRequest_Extract{
@@ -531,25 +586,33 @@ func NewGrpcTapServer(
 	tapPort uint,
 	controllerNamespace string,
 	trustDomain string,
+	headersDisabledNamespaces []string,
 	k8sAPI *k8s.API,
 ) *GRPCTapServer {
 	k8sAPI.Pod().Informer().AddIndexers(cache.Indexers{ipIndex: indexByIP})
 	k8sAPI.Node().Informer().AddIndexers(cache.Indexers{ipIndex: indexByIP})
 
-	return newGRPCTapServer(tapPort, controllerNamespace, trustDomain, k8sAPI)
+	return newGRPCTapServer(tapPort, controllerNamespace, trustDomain, headersDisabledNamespaces, k8sAPI)
 }
 
 func newGRPCTapServer(
 	tapPort uint,
 	controllerNamespace string,
 	trustDomain string,
+	headersDisabledNamespaces []string,
 	k8sAPI *k8s.API,
 ) *GRPCTapServer {
 	srv := &GRPCTapServer{
-		tapPort:             tapPort,
-		k8sAPI:              k8sAPI,
-		controllerNamespace: controllerNamespace,
-		trustDomain:         trustDomain,
+		tapPort:                   tapPort,
+		k8sAPI:                    k8sAPI,
+		controllerNamespace:       controllerNamespace,
+		trustDomain:               trustDomain,
+		headersDisabledNamespaces: make(map[string]struct{}),
+	}
+	for _, ns := range headersDisabledNamespaces {
+		if ns = strings.TrimSpace(ns); ns != "" {
+			srv.headersDisabledNamespaces[ns] = struct{}{}
+		}
 	}
 
 	s := prometheus.NewGrpcServer()
@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"strconv"
 	"testing"
+	"time"
 
 	proxy "github.com/linkerd/linkerd2-proxy-api/go/tap"
 	"github.com/linkerd/linkerd2/controller/api/util"
@@ -15,6 +16,7 @@ import (
 	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
 	metricsPb "github.com/linkerd/linkerd2/viz/metrics-api/gen/viz"
 	tapPb "github.com/linkerd/linkerd2/viz/tap/gen/tap"
+	"github.com/linkerd/linkerd2/viz/tap/pkg"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -22,10 +24,12 @@ import (
 )
 
 type tapExpected struct {
-	err       error
-	k8sRes    []string
-	req       *tapPb.TapByResourceRequest
-	requireID string
+	err                       error
+	k8sRes                    []string
+	req                       *tapPb.TapByResourceRequest
+	requireID                 string
+	headersDisabledNamespaces []string
+	extractHeaders            bool
 }
 
 // mockTapByResourceServer satisfies controller.tap.Tap_TapByResourceServer
@@ -41,10 +45,12 @@ func (m *mockTapByResourceServer) Send(event *tapPb.TapEvent) error {
 type mockProxyTapServer struct {
 	mockControllerServer mockTapByResourceServer // for cancellation
 	ctx                  context.Context
+	req                  *proxy.ObserveRequest
 }
 
 func (m *mockProxyTapServer) Observe(req *proxy.ObserveRequest, obsSrv proxy.Tap_ObserveServer) error {
 	m.ctx = obsSrv.Context()
+	m.req = req
 	m.mockControllerServer.Cancel()
 	return nil
 }
@@ -374,6 +380,148 @@ status:
 			},
 			requireID: "emojivoto-meshed-sa.emojivoto.serviceaccount.identity.controller-ns.cluster.local",
 		},
+		{
+			err: status.Errorf(codes.PermissionDenied, "headers can't be matched: header extraction is disabled in namespace emojivoto"),
+			k8sRes: []string{`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: emojivoto-meshed
+  namespace: emojivoto
+  labels:
+    app: emoji-svc
+    linkerd.io/control-plane-ns: controller-ns
+  annotations:
+    viz.linkerd.io/tap-enabled: "true"
+    linkerd.io/proxy-version: testinjectversion
+spec:
+  serviceAccountName: emojivoto-meshed-sa
+status:
+  phase: Running
+  podIP: 127.0.0.1
+`,
+			},
+			req: &tapPb.TapByResourceRequest{
+				Target: &metricsPb.ResourceSelection{
+					Resource: &metricsPb.Resource{
+						Namespace: "emojivoto",
+						Type:      pkgK8s.Pod,
+						Name:      "emojivoto-meshed",
+					},
+				},
+				Match: &tapPb.TapByResourceRequest_Match{
+					Match: &tapPb.TapByResourceRequest_Match_All{
+						All: &tapPb.TapByResourceRequest_Match_Seq{
+							Matches: []*tapPb.TapByResourceRequest_Match{
+								{
+									Match: &tapPb.TapByResourceRequest_Match_Http_{
+										Http: &tapPb.TapByResourceRequest_Match_Http{
+											Match: &tapPb.TapByResourceRequest_Match_Http_Header{
+												Header: &tapPb.TapByResourceRequest_Match_Http_HeaderMatch{Name: "authorization"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			headersDisabledNamespaces: []string{"emojivoto"},
+		},
+		{
+			err: status.Errorf(codes.PermissionDenied, "headers can't be extracted: header extraction is disabled in namespace emojivoto"),
+			k8sRes: []string{`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: emojivoto-meshed
+  namespace: emojivoto
+  labels:
+    app: emoji-svc
+    linkerd.io/control-plane-ns: controller-ns
+  annotations:
+    viz.linkerd.io/tap-enabled: "true"
+    linkerd.io/proxy-version: testinjectversion
+spec:
+  serviceAccountName: emojivoto-meshed-sa
+status:
+  phase: Running
+  podIP: 127.0.0.1
+`,
+			},
+			req: &tapPb.TapByResourceRequest{
+				Target: &metricsPb.ResourceSelection{
+					Resource: &metricsPb.Resource{
+						Namespace: "emojivoto",
+						Type:      pkgK8s.Pod,
+						Name:      "emojivoto-meshed",
+					},
+				},
+				Match: &tapPb.TapByResourceRequest_Match{
+					Match: &tapPb.TapByResourceRequest_Match_All{
+						All: &tapPb.TapByResourceRequest_Match_Seq{},
+					},
+				},
+				Extract: &tapPb.TapByResourceRequest_Extract{
+					Extract: &tapPb.TapByResourceRequest_Extract_Http_{
+						Http: &tapPb.TapByResourceRequest_Extract_Http{
+							Extract: &tapPb.TapByResourceRequest_Extract_Http_Headers_{
+								Headers: &tapPb.TapByResourceRequest_Extract_Http_Headers{},
+							},
+						},
+					},
+				},
+			},
+			headersDisabledNamespaces: []string{"emojivoto"},
+		},
+		{
+			k8sRes: []string{`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: emojivoto-meshed
+  namespace: emojivoto
+  labels:
+    app: emoji-svc
+    linkerd.io/control-plane-ns: controller-ns
+  annotations:
+    viz.linkerd.io/tap-enabled: "true"
+    linkerd.io/proxy-version: testinjectversion
+spec:
+  serviceAccountName: emojivoto-meshed-sa
+status:
+  phase: Running
+  podIP: 127.0.0.1
+`,
+			},
+			req: &tapPb.TapByResourceRequest{
+				Target: &metricsPb.ResourceSelection{
+					Resource: &metricsPb.Resource{
+						Namespace: "emojivoto",
+						Type:      pkgK8s.Pod,
+						Name:      "emojivoto-meshed",
+					},
+				},
+				Match: &tapPb.TapByResourceRequest_Match{
+					Match: &tapPb.TapByResourceRequest_Match_All{
+						All: &tapPb.TapByResourceRequest_Match_Seq{},
+					},
+				},
+				Extract: &tapPb.TapByResourceRequest_Extract{
+					Extract: &tapPb.TapByResourceRequest_Extract_Http_{
+						Http: &tapPb.TapByResourceRequest_Extract_Http{
+							Extract: &tapPb.TapByResourceRequest_Extract_Http_Headers_{
+								Headers: &tapPb.TapByResourceRequest_Extract_Http_Headers{},
+							},
+						},
+					},
+				},
+			},
+			requireID:                 "emojivoto-meshed-sa.emojivoto.serviceaccount.identity.controller-ns.cluster.local",
+			headersDisabledNamespaces: []string{"other"},
+			extractHeaders:            true,
+		},
 	}
 
 	for i, exp := range expectations {
@@ -424,7 +572,7 @@ status:
 				t.Fatalf("Invalid port: %s", port)
 			}
 
-			fakeGrpcServer := newGRPCTapServer(uint(tapPort), "controller-ns", "cluster.local", k8sAPI)
+			fakeGrpcServer := newGRPCTapServer(uint(tapPort), "controller-ns", "cluster.local", exp.headersDisabledNamespaces, k8sAPI)
 
 			k8sAPI.Sync(nil)
 
@@ -451,10 +599,41 @@ status:
 				}
 			}
 
+			if exp.req.GetExtract() != nil && exp.err == nil {
+				extractHeaders := mockProxyTapServer.req.GetExtract().GetHttp().GetHeaders() != nil
+				if extractHeaders != exp.extractHeaders {
+					t.Fatalf("Unexpected header extraction: %t, expected: %t", extractHeaders, exp.extractHeaders)
+				}
+			}
+
 		})
 	}
 }
 
+func TestMakeByResourceMatch(t *testing.T) {
+	req, err := pkg.BuildTapByResourceRequest(pkg.TapRequestParams{
+		Resource:   "deploy/web",
+		Path:       "/api",
+		Status:     "5xx",
+		MinLatency: time.Second,
+		Headers:    []string{"x-canary=true"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	match, err := makeByResourceMatch(req.GetMatch())
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	// only the path can be matched by the proxies
+	matches := match.GetAll().GetMatches()
+	if len(matches) != 1 || matches[0].GetHttp().GetPath().GetPrefix() != "/api" {
+		t.Fatalf("Unexpected proxy matches: %+v", matches)
+	}
+}
+
 func TestHydrateIPLabels(t *testing.T) {
 	expectations := []struct {
 		k8sRes      []string
@@ -663,7 +842,7 @@ status:
 			if err != nil {
 				t.Fatalf("NewFakeAPI returned an error: %s", err)
 			}
-			s := NewGrpcTapServer(4190, "controller-ns", "cluster.local", k8sAPI)
+			s := NewGrpcTapServer(4190, "controller-ns", "cluster.local", nil, k8sAPI)
 			k8sAPI.Sync(nil)
 
 			labels := make(map[string]string)
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/go-openapi/spec"
 	"github.com/julienschmidt/httprouter"
@@ -27,6 +28,7 @@ type handler struct {
 	usernameHeader string
 	groupHeader    string
 	grpcTapServer  pb.TapServer
+	audit          *auditLog
 	log            *logrus.Entry
 }
 
@@ -120,13 +122,24 @@ func (h *handler) handleTap(w http.ResponseWriter, req *http.Request, p httprout
 		return
 	}
 
+	session := &tapSession{
+		user:      req.Header.Get(h.usernameHeader),
+		groups:    req.Header.Values(h.groupHeader),
+		namespace: namespace,
+		resource:  resource,
+		name:      name,
+		start:     time.Now(),
+	}
+	var err error
+	defer func() { h.audit.finished(session, err) }()
+
 	h.log.Debugf("SubjectAccessReview: namespace: %s, resource: %s, name: %s, user: <%s>, group: <%s>",
 		namespace, resource, name, h.usernameHeader, h.groupHeader,
 	)
 
 	// TODO: it's possible this SubjectAccessReview is redundant, consider
 	// removing, more info at https://github.com/linkerd/linkerd2/issues/3182
-	err := pkgK8s.ResourceAuthzForUser(
+	err = pkgK8s.ResourceAuthzForUser(
 		req.Context(),
 		h.k8sAPI.Client,
 		namespace,
@@ -136,8 +149,8 @@ func (h *handler) handleTap(w http.ResponseWriter, req *http.Request, p httprout
 		resource,
 		"tap",
 		name,
-		req.Header.Get(h.usernameHeader),
-		req.Header.Values(h.groupHeader),
+		session.user,
+		session.groups,
 	)
 	if err != nil {
 		err = fmt.Errorf("tap authorization failed (%s), visit %s for more information", err, pkg.TapRbacURL)
@@ -145,6 +158,7 @@ func (h *handler) handleTap(w http.ResponseWriter, req *http.Request, p httprout
 		renderJSONError(w, err, http.StatusForbidden)
 		return
 	}
+	h.audit.started(session)
 
 	tapReq := pb.TapByResourceRequest{}
 	err = protohttp.HTTPRequestToProto(req, &tapReq)
@@ -154,6 +168,7 @@ func (h *handler) handleTap(w http.ResponseWriter, req *http.Request, p httprout
 		protohttp.WriteErrorToHTTPResponse(w, err)
 		return
 	}
+	session.req = &tapReq
 
 	url := pkg.TapReqToURL(&tapReq)
 	if url != req.URL.Path {
@@ -172,6 +187,7 @@ func (h *handler) handleTap(w http.ResponseWriter, req *http.Request, p httprout
 
 	serverStream := serverStream{w: flushableWriter, req: req, log: h.log}
 	err = h.grpcTapServer.TapByResource(&tapReq, &serverStream)
+	session.events = serverStream.events
 	if err != nil {
 		h.log.Error(err)
 		protohttp.WriteErrorToHTTPResponse(flushableWriter, err)
@@ -373,6 +389,8 @@ type serverStream struct {
 	w   protohttp.FlushableResponseWriter
 	req *http.Request
 	log *logrus.Entry
+	// the number of events sent
+	events uint64
 }
 
 // Satisfy the grpc.ServerStream interface
@@ -393,5 +411,6 @@ func (s *serverStream) Send(m *pb.TapEvent) error {
 	}
 
 	s.w.Flush()
+	s.events++
 	return nil
 }
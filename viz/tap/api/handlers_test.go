@@ -1,7 +1,10 @@
 package api
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -11,6 +14,10 @@ import (
 	"github.com/julienschmidt/httprouter"
 	"github.com/linkerd/linkerd2/controller/k8s"
 	"github.com/sirupsen/logrus"
+	authV1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 )
 
 func TestHandleTap(t *testing.T) {
@@ -71,3 +78,121 @@ func TestHandleTap(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleTapAudit(t *testing.T) {
+	newRequest := func() *http.Request {
+		return &http.Request{
+			URL: &url.URL{
+				Path: "/apis/tap.linkerd.io/v1alpha1/watch/namespaces/emojivoto/deployments/web/tap",
+			},
+			Header: http.Header{
+				"X-Remote-User":  []string{"jane"},
+				"X-Remote-Group": []string{"developers", "system:authenticated"},
+			},
+			Body: ioutil.NopCloser(bytes.NewReader(nil)),
+		}
+	}
+	params := httprouter.Params{
+		{Key: "namespace", Value: "emojivoto"},
+		{Key: "name", Value: "web"},
+	}
+	target := map[string]interface{}{
+		"user":      "jane",
+		"groups":    []interface{}{"developers", "system:authenticated"},
+		"namespace": "emojivoto",
+		"resource":  "deployments",
+		"name":      "web",
+	}
+
+	t.Run("Records the denied sessions once", func(t *testing.T) {
+		k8sAPI, err := k8s.NewFakeAPI()
+		if err != nil {
+			t.Fatalf("NewFakeAPI returned an error: %s", err)
+		}
+		var audit bytes.Buffer
+		h := &handler{
+			k8sAPI:         k8sAPI,
+			usernameHeader: "X-Remote-User",
+			groupHeader:    "X-Remote-Group",
+			audit:          newAuditLog(&audit),
+			log:            logrus.WithField("test", t.Name()),
+		}
+		h.handleTap(httptest.NewRecorder(), newRequest(), params)
+
+		records := parseAuditRecords(t, &audit)
+		if len(records) != 1 {
+			t.Fatalf("Expected 1 audit record, got %d: %q", len(records), audit.String())
+		}
+		expectAuditRecord(t, records[0], target, map[string]interface{}{
+			"phase":           "finished",
+			"eventsDelivered": float64(0),
+			"level":           "warning",
+			"error":           "tap authorization failed (not authorized to access deployments.tap.linkerd.io), visit https://linkerd.io/tap-rbac for more information",
+		})
+	})
+
+	t.Run("Records the authorized sessions when they start and finish", func(t *testing.T) {
+		k8sAPI, err := k8s.NewFakeAPI()
+		if err != nil {
+			t.Fatalf("NewFakeAPI returned an error: %s", err)
+		}
+		k8sAPI.Client.(*fake.Clientset).PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &authV1.SubjectAccessReview{Status: authV1.SubjectAccessReviewStatus{Allowed: true}}, nil
+		})
+		var audit bytes.Buffer
+		h := &handler{
+			k8sAPI:         k8sAPI,
+			usernameHeader: "X-Remote-User",
+			groupHeader:    "X-Remote-Group",
+			audit:          newAuditLog(&audit),
+			log:            logrus.WithField("test", t.Name()),
+		}
+		// the empty request body doesn't match the path, which ends the
+		// session right after it started
+		h.handleTap(httptest.NewRecorder(), newRequest(), params)
+
+		records := parseAuditRecords(t, &audit)
+		if len(records) != 2 {
+			t.Fatalf("Expected 2 audit records, got %d: %q", len(records), audit.String())
+		}
+		expectAuditRecord(t, records[0], target, map[string]interface{}{
+			"phase": "started",
+			"level": "info",
+			"msg":   "tap session of jane started",
+		})
+		if _, ok := records[0]["eventsDelivered"]; ok {
+			t.Errorf("Expected the started record not to have the events delivered, got %v", records[0])
+		}
+		expectAuditRecord(t, records[1], target, map[string]interface{}{
+			"phase":           "finished",
+			"eventsDelivered": float64(0),
+			"level":           "warning",
+			"msg":             "tap session of jane failed",
+		})
+	})
+}
+
+func parseAuditRecords(t *testing.T, audit *bytes.Buffer) []map[string]interface{} {
+	t.Helper()
+	records := []map[string]interface{}{}
+	decoder := json.NewDecoder(audit)
+	for decoder.More() {
+		record := map[string]interface{}{}
+		if err := decoder.Decode(&record); err != nil {
+			t.Fatalf("Expected audit records, got %q: %s", audit.String(), err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func expectAuditRecord(t *testing.T, record map[string]interface{}, expected ...map[string]interface{}) {
+	t.Helper()
+	for _, fields := range expected {
+		for key, value := range fields {
+			if !reflect.DeepEqual(record[key], value) {
+				t.Errorf("Unexpected audit %s: %v, expected: %v", key, record[key], value)
+			}
+		}
+	}
+}
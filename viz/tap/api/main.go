@@ -3,8 +3,10 @@ package api
 import (
 	"context"
 	"flag"
+	"io"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 
 	"github.com/linkerd/linkerd2/controller/k8s"
@@ -26,6 +28,8 @@ func Main(args []string) {
 	tapPort := cmd.Uint("tap-port", 4190, "proxy tap port to connect to")
 	disableCommonNames := cmd.Bool("disable-common-names", false, "disable checks for Common Names (for development)")
 	trustDomain := cmd.String("identity-trust-domain", defaultDomain, "configures the name suffix used for identities")
+	headersDisabledNamespaces := cmd.String("headers-disabled-namespaces", "", "comma separated list of namespaces whose request and response headers can't be extracted")
+	auditLogPath := cmd.String("audit-log", "-", "file to append the audit log of the tap sessions to, \"-\" for stdout or empty to disable it")
 	traceCollector := flags.AddTraceFlags(cmd)
 	flags.ConfigureAndParse(cmd, args)
 	ctx := context.Background()
@@ -56,8 +60,25 @@ func Main(args []string) {
 			log.Warnf("failed to initialize tracing: %s", err)
 		}
 	}
-	grpcTapServer := NewGrpcTapServer(*tapPort, *apiNamespace, *trustDomain, k8sAPI)
-	apiServer, err := NewServer(ctx, *apiServerAddr, k8sAPI, grpcTapServer, *disableCommonNames)
+	var auditLog io.Writer
+	switch *auditLogPath {
+	case "":
+	case "-":
+		auditLog = os.Stdout
+	default:
+		f, err := os.OpenFile(*auditLogPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			log.Fatalf("Failed to open the audit log: %s", err)
+		}
+		defer f.Close()
+		auditLog = f
+	}
+	var disabledNamespaces []string
+	if *headersDisabledNamespaces != "" {
+		disabledNamespaces = strings.Split(*headersDisabledNamespaces, ",")
+	}
+	grpcTapServer := NewGrpcTapServer(*tapPort, *apiNamespace, *trustDomain, disabledNamespaces, k8sAPI)
+	apiServer, err := NewServer(ctx, *apiServerAddr, k8sAPI, grpcTapServer, *disableCommonNames, auditLog)
 	if err != nil {
 		log.Fatal(err.Error())
 	}
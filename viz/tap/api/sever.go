@@ -6,6 +6,7 @@ import (
 	"crypto/x509"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"sync/atomic"
@@ -38,6 +39,7 @@ func NewServer(
 	k8sAPI *k8s.API,
 	grpcTapServer pb.TapServer,
 	disableCommonNames bool,
+	auditLogWriter io.Writer,
 ) (*Server, error) {
 	updateEvent := make(chan struct{})
 	errEvent := make(chan error)
@@ -81,6 +83,7 @@ func NewServer(
 		usernameHeader: usernameHeader,
 		groupHeader:    groupHeader,
 		grpcTapServer:  grpcTapServer,
+		audit:          newAuditLog(auditLogWriter),
 		log:            log,
 	}
 
@@ -420,6 +420,140 @@ type TapEvent_Http_ struct {
 
 func (*TapEvent_Http_) isTapEvent_Event() {}
 
+// The metadata at the start of a recording of tap events, which is followed
+// by the TapRecords of the recorded events.
+type TapRecordingMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The version of the format of the recording.
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// The request whose events were recorded.
+	Request *TapByResourceRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	// When the recording started, in milliseconds since the Unix epoch.
+	StartTimeMs int64 `protobuf:"varint,3,opt,name=start_time_ms,json=startTimeMs,proto3" json:"start_time_ms,omitempty"`
+	// The version of the CLI that made the recording.
+	CliVersion string `protobuf:"bytes,4,opt,name=cli_version,json=cliVersion,proto3" json:"cli_version,omitempty"`
+}
+
+func (x *TapRecordingMetadata) Reset() {
+	*x = TapRecordingMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_viz_tap_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TapRecordingMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TapRecordingMetadata) ProtoMessage() {}
+
+func (x *TapRecordingMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_viz_tap_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TapRecordingMetadata.ProtoReflect.Descriptor instead.
+func (*TapRecordingMetadata) Descriptor() ([]byte, []int) {
+	return file_viz_tap_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TapRecordingMetadata) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *TapRecordingMetadata) GetRequest() *TapByResourceRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *TapRecordingMetadata) GetStartTimeMs() int64 {
+	if x != nil {
+		return x.StartTimeMs
+	}
+	return 0
+}
+
+func (x *TapRecordingMetadata) GetCliVersion() string {
+	if x != nil {
+		return x.CliVersion
+	}
+	return ""
+}
+
+// A recorded tap event.
+type TapRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Event *TapEvent `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// When the event was received, in milliseconds since the Unix epoch.
+	TimeMs int64 `protobuf:"varint,2,opt,name=time_ms,json=timeMs,proto3" json:"time_ms,omitempty"`
+}
+
+func (x *TapRecord) Reset() {
+	*x = TapRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_viz_tap_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TapRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TapRecord) ProtoMessage() {}
+
+func (x *TapRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_viz_tap_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TapRecord.ProtoReflect.Descriptor instead.
+func (*TapRecord) Descriptor() ([]byte, []int) {
+	return file_viz_tap_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TapRecord) GetEvent() *TapEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *TapRecord) GetTimeMs() int64 {
+	if x != nil {
+		return x.TimeMs
+	}
+	return 0
+}
+
 type TapByResourceRequest_Match struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -437,7 +571,7 @@ type TapByResourceRequest_Match struct {
 func (x *TapByResourceRequest_Match) Reset() {
 	*x = TapByResourceRequest_Match{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_tap_proto_msgTypes[3]
+		mi := &file_viz_tap_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -450,7 +584,7 @@ func (x *TapByResourceRequest_Match) String() string {
 func (*TapByResourceRequest_Match) ProtoMessage() {}
 
 func (x *TapByResourceRequest_Match) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_tap_proto_msgTypes[3]
+	mi := &file_viz_tap_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -560,7 +694,7 @@ type TapByResourceRequest_Extract struct {
 func (x *TapByResourceRequest_Extract) Reset() {
 	*x = TapByResourceRequest_Extract{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_tap_proto_msgTypes[4]
+		mi := &file_viz_tap_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -573,7 +707,7 @@ func (x *TapByResourceRequest_Extract) String() string {
 func (*TapByResourceRequest_Extract) ProtoMessage() {}
 
 func (x *TapByResourceRequest_Extract) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_tap_proto_msgTypes[4]
+	mi := &file_viz_tap_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -624,7 +758,7 @@ type TapByResourceRequest_Match_Seq struct {
 func (x *TapByResourceRequest_Match_Seq) Reset() {
 	*x = TapByResourceRequest_Match_Seq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_tap_proto_msgTypes[5]
+		mi := &file_viz_tap_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -637,7 +771,7 @@ func (x *TapByResourceRequest_Match_Seq) String() string {
 func (*TapByResourceRequest_Match_Seq) ProtoMessage() {}
 
 func (x *TapByResourceRequest_Match_Seq) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_tap_proto_msgTypes[5]
+	mi := &file_viz_tap_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -670,13 +804,16 @@ type TapByResourceRequest_Match_Http struct {
 	//	*TapByResourceRequest_Match_Http_Method
 	//	*TapByResourceRequest_Match_Http_Authority
 	//	*TapByResourceRequest_Match_Http_Path
+	//	*TapByResourceRequest_Match_Http_Status
+	//	*TapByResourceRequest_Match_Http_MinLatency
+	//	*TapByResourceRequest_Match_Http_Header
 	Match isTapByResourceRequest_Match_Http_Match `protobuf_oneof:"match"`
 }
 
 func (x *TapByResourceRequest_Match_Http) Reset() {
 	*x = TapByResourceRequest_Match_Http{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_tap_proto_msgTypes[6]
+		mi := &file_viz_tap_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -689,7 +826,7 @@ func (x *TapByResourceRequest_Match_Http) String() string {
 func (*TapByResourceRequest_Match_Http) ProtoMessage() {}
 
 func (x *TapByResourceRequest_Match_Http) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_tap_proto_msgTypes[6]
+	mi := &file_viz_tap_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -740,6 +877,27 @@ func (x *TapByResourceRequest_Match_Http) GetPath() string {
 	return ""
 }
 
+func (x *TapByResourceRequest_Match_Http) GetStatus() *TapByResourceRequest_Match_Http_StatusRange {
+	if x, ok := x.GetMatch().(*TapByResourceRequest_Match_Http_Status); ok {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *TapByResourceRequest_Match_Http) GetMinLatency() *duration.Duration {
+	if x, ok := x.GetMatch().(*TapByResourceRequest_Match_Http_MinLatency); ok {
+		return x.MinLatency
+	}
+	return nil
+}
+
+func (x *TapByResourceRequest_Match_Http) GetHeader() *TapByResourceRequest_Match_Http_HeaderMatch {
+	if x, ok := x.GetMatch().(*TapByResourceRequest_Match_Http_Header); ok {
+		return x.Header
+	}
+	return nil
+}
+
 type isTapByResourceRequest_Match_Http_Match interface {
 	isTapByResourceRequest_Match_Http_Match()
 }
@@ -760,6 +918,22 @@ type TapByResourceRequest_Match_Http_Path struct {
 	Path string `protobuf:"bytes,4,opt,name=path,proto3,oneof"`
 }
 
+type TapByResourceRequest_Match_Http_Status struct {
+	// Matches requests whose response status is within the range.
+	Status *TapByResourceRequest_Match_Http_StatusRange `protobuf:"bytes,5,opt,name=status,proto3,oneof"`
+}
+
+type TapByResourceRequest_Match_Http_MinLatency struct {
+	// Matches requests whose response was initiated at least this long
+	// after the request.
+	MinLatency *duration.Duration `protobuf:"bytes,6,opt,name=min_latency,json=minLatency,proto3,oneof"`
+}
+
+type TapByResourceRequest_Match_Http_Header struct {
+	// Matches requests with a header.
+	Header *TapByResourceRequest_Match_Http_HeaderMatch `protobuf:"bytes,7,opt,name=header,proto3,oneof"`
+}
+
 func (*TapByResourceRequest_Match_Http_Scheme) isTapByResourceRequest_Match_Http_Match() {}
 
 func (*TapByResourceRequest_Match_Http_Method) isTapByResourceRequest_Match_Http_Match() {}
@@ -768,6 +942,125 @@ func (*TapByResourceRequest_Match_Http_Authority) isTapByResourceRequest_Match_H
 
 func (*TapByResourceRequest_Match_Http_Path) isTapByResourceRequest_Match_Http_Match() {}
 
+func (*TapByResourceRequest_Match_Http_Status) isTapByResourceRequest_Match_Http_Match() {}
+
+func (*TapByResourceRequest_Match_Http_MinLatency) isTapByResourceRequest_Match_Http_Match() {}
+
+func (*TapByResourceRequest_Match_Http_Header) isTapByResourceRequest_Match_Http_Match() {}
+
+// An inclusive range of HTTP statuses.
+type TapByResourceRequest_Match_Http_StatusRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Min uint32 `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max uint32 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (x *TapByResourceRequest_Match_Http_StatusRange) Reset() {
+	*x = TapByResourceRequest_Match_Http_StatusRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_viz_tap_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TapByResourceRequest_Match_Http_StatusRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TapByResourceRequest_Match_Http_StatusRange) ProtoMessage() {}
+
+func (x *TapByResourceRequest_Match_Http_StatusRange) ProtoReflect() protoreflect.Message {
+	mi := &file_viz_tap_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TapByResourceRequest_Match_Http_StatusRange.ProtoReflect.Descriptor instead.
+func (*TapByResourceRequest_Match_Http_StatusRange) Descriptor() ([]byte, []int) {
+	return file_viz_tap_proto_rawDescGZIP(), []int{1, 0, 1, 0}
+}
+
+func (x *TapByResourceRequest_Match_Http_StatusRange) GetMin() uint32 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *TapByResourceRequest_Match_Http_StatusRange) GetMax() uint32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+// Matches a header by its name, case-insensitively, and its value. If
+// empty, the value isn't matched.
+type TapByResourceRequest_Match_Http_HeaderMatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *TapByResourceRequest_Match_Http_HeaderMatch) Reset() {
+	*x = TapByResourceRequest_Match_Http_HeaderMatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_viz_tap_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TapByResourceRequest_Match_Http_HeaderMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TapByResourceRequest_Match_Http_HeaderMatch) ProtoMessage() {}
+
+func (x *TapByResourceRequest_Match_Http_HeaderMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_viz_tap_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TapByResourceRequest_Match_Http_HeaderMatch.ProtoReflect.Descriptor instead.
+func (*TapByResourceRequest_Match_Http_HeaderMatch) Descriptor() ([]byte, []int) {
+	return file_viz_tap_proto_rawDescGZIP(), []int{1, 0, 1, 1}
+}
+
+func (x *TapByResourceRequest_Match_Http_HeaderMatch) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TapByResourceRequest_Match_Http_HeaderMatch) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
 type TapByResourceRequest_Extract_Http struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -781,7 +1074,7 @@ type TapByResourceRequest_Extract_Http struct {
 func (x *TapByResourceRequest_Extract_Http) Reset() {
 	*x = TapByResourceRequest_Extract_Http{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_tap_proto_msgTypes[7]
+		mi := &file_viz_tap_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -794,7 +1087,7 @@ func (x *TapByResourceRequest_Extract_Http) String() string {
 func (*TapByResourceRequest_Extract_Http) ProtoMessage() {}
 
 func (x *TapByResourceRequest_Extract_Http) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_tap_proto_msgTypes[7]
+	mi := &file_viz_tap_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -843,7 +1136,7 @@ type TapByResourceRequest_Extract_Http_Headers struct {
 func (x *TapByResourceRequest_Extract_Http_Headers) Reset() {
 	*x = TapByResourceRequest_Extract_Http_Headers{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_tap_proto_msgTypes[8]
+		mi := &file_viz_tap_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -856,7 +1149,7 @@ func (x *TapByResourceRequest_Extract_Http_Headers) String() string {
 func (*TapByResourceRequest_Extract_Http_Headers) ProtoMessage() {}
 
 func (x *TapByResourceRequest_Extract_Http_Headers) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_tap_proto_msgTypes[8]
+	mi := &file_viz_tap_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -883,7 +1176,7 @@ type TapEvent_EndpointMeta struct {
 func (x *TapEvent_EndpointMeta) Reset() {
 	*x = TapEvent_EndpointMeta{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_tap_proto_msgTypes[9]
+		mi := &file_viz_tap_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -896,7 +1189,7 @@ func (x *TapEvent_EndpointMeta) String() string {
 func (*TapEvent_EndpointMeta) ProtoMessage() {}
 
 func (x *TapEvent_EndpointMeta) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_tap_proto_msgTypes[9]
+	mi := &file_viz_tap_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -930,7 +1223,7 @@ type TapEvent_RouteMeta struct {
 func (x *TapEvent_RouteMeta) Reset() {
 	*x = TapEvent_RouteMeta{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_tap_proto_msgTypes[10]
+		mi := &file_viz_tap_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -943,7 +1236,7 @@ func (x *TapEvent_RouteMeta) String() string {
 func (*TapEvent_RouteMeta) ProtoMessage() {}
 
 func (x *TapEvent_RouteMeta) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_tap_proto_msgTypes[10]
+	mi := &file_viz_tap_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -981,7 +1274,7 @@ type TapEvent_Http struct {
 func (x *TapEvent_Http) Reset() {
 	*x = TapEvent_Http{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_tap_proto_msgTypes[11]
+		mi := &file_viz_tap_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -994,7 +1287,7 @@ func (x *TapEvent_Http) String() string {
 func (*TapEvent_Http) ProtoMessage() {}
 
 func (x *TapEvent_Http) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_tap_proto_msgTypes[11]
+	mi := &file_viz_tap_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1074,7 +1367,7 @@ type TapEvent_Http_StreamId struct {
 func (x *TapEvent_Http_StreamId) Reset() {
 	*x = TapEvent_Http_StreamId{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_tap_proto_msgTypes[14]
+		mi := &file_viz_tap_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1087,7 +1380,7 @@ func (x *TapEvent_Http_StreamId) String() string {
 func (*TapEvent_Http_StreamId) ProtoMessage() {}
 
 func (x *TapEvent_Http_StreamId) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_tap_proto_msgTypes[14]
+	mi := &file_viz_tap_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1133,7 +1426,7 @@ type TapEvent_Http_RequestInit struct {
 func (x *TapEvent_Http_RequestInit) Reset() {
 	*x = TapEvent_Http_RequestInit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_tap_proto_msgTypes[15]
+		mi := &file_viz_tap_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1146,7 +1439,7 @@ func (x *TapEvent_Http_RequestInit) String() string {
 func (*TapEvent_Http_RequestInit) ProtoMessage() {}
 
 func (x *TapEvent_Http_RequestInit) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_tap_proto_msgTypes[15]
+	mi := &file_viz_tap_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1218,7 +1511,7 @@ type TapEvent_Http_ResponseInit struct {
 func (x *TapEvent_Http_ResponseInit) Reset() {
 	*x = TapEvent_Http_ResponseInit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_tap_proto_msgTypes[16]
+		mi := &file_viz_tap_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1231,7 +1524,7 @@ func (x *TapEvent_Http_ResponseInit) String() string {
 func (*TapEvent_Http_ResponseInit) ProtoMessage() {}
 
 func (x *TapEvent_Http_ResponseInit) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_tap_proto_msgTypes[16]
+	mi := &file_viz_tap_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1291,7 +1584,7 @@ type TapEvent_Http_ResponseEnd struct {
 func (x *TapEvent_Http_ResponseEnd) Reset() {
 	*x = TapEvent_Http_ResponseEnd{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_viz_tap_proto_msgTypes[17]
+		mi := &file_viz_tap_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1304,7 +1597,7 @@ func (x *TapEvent_Http_ResponseEnd) String() string {
 func (*TapEvent_Http_ResponseEnd) ProtoMessage() {}
 
 func (x *TapEvent_Http_ResponseEnd) ProtoReflect() protoreflect.Message {
-	mi := &file_viz_tap_proto_msgTypes[17]
+	mi := &file_viz_tap_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1389,7 +1682,7 @@ var file_viz_tap_proto_rawDesc = []byte{
 	0x72, 0x69, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68,
 	0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x0b, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x3a, 0x02, 0x18, 0x01, 0x42, 0x08, 0x0a,
-	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0xe5, 0x07, 0x0a, 0x14, 0x54, 0x61, 0x70, 0x42,
+	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0xba, 0x0a, 0x0a, 0x14, 0x54, 0x61, 0x70, 0x42,
 	0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x37, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x1f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e,
@@ -1404,7 +1697,7 @@ var file_viz_tap_proto_rawDesc = []byte{
 	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x74, 0x61,
 	0x70, 0x2e, 0x54, 0x61, 0x70, 0x42, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x07,
-	0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x1a, 0xa4, 0x04, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63,
+	0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x1a, 0xf9, 0x06, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63,
 	0x68, 0x12, 0x40, 0x0a, 0x03, 0x61, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c,
 	0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x74, 0x61, 0x70, 0x2e, 0x54, 0x61,
 	0x70, 0x42, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
@@ -1430,166 +1723,204 @@ var file_viz_tap_proto_rawDesc = []byte{
 	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72,
 	0x64, 0x32, 0x2e, 0x74, 0x61, 0x70, 0x2e, 0x54, 0x61, 0x70, 0x42, 0x79, 0x52, 0x65, 0x73, 0x6f,
 	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x61, 0x74, 0x63,
-	0x68, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x1a, 0x79, 0x0a, 0x04, 0x48, 0x74,
-	0x74, 0x70, 0x12, 0x18, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x06,
-	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06,
-	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1e, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x61, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x42, 0x07, 0x0a, 0x05,
-	0x6d, 0x61, 0x74, 0x63, 0x68, 0x42, 0x07, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x1a, 0xce,
-	0x01, 0x0a, 0x07, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x45, 0x0a, 0x04, 0x68, 0x74,
-	0x74, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65,
-	0x72, 0x64, 0x32, 0x2e, 0x74, 0x61, 0x70, 0x2e, 0x54, 0x61, 0x70, 0x42, 0x79, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x48, 0x00, 0x52, 0x04, 0x68, 0x74, 0x74,
-	0x70, 0x1a, 0x71, 0x0a, 0x04, 0x48, 0x74, 0x74, 0x70, 0x12, 0x53, 0x0a, 0x07, 0x68, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x6c, 0x69, 0x6e,
-	0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x74, 0x61, 0x70, 0x2e, 0x54, 0x61, 0x70, 0x42, 0x79, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45,
-	0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x48, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x73, 0x48, 0x00, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x09,
-	0x0a, 0x07, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x65, 0x78, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x22,
-	0xc2, 0x0f, 0x0a, 0x08, 0x54, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x06,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c,
-	0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x6e,
-	0x65, 0x74, 0x2e, 0x54, 0x63, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x06, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
-	0x6d, 0x65, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6c, 0x69, 0x6e,
-	0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x74, 0x61, 0x70, 0x2e, 0x54, 0x61, 0x70, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52,
-	0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x41, 0x0a, 0x0b, 0x64,
-	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x54, 0x63, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4e,
-	0x0a, 0x10, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65,
-	0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65,
-	0x72, 0x64, 0x32, 0x2e, 0x74, 0x61, 0x70, 0x2e, 0x54, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x0f, 0x64,
-	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x3f,
-	0x0a, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x74, 0x61,
-	0x70, 0x2e, 0x54, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x4d, 0x65, 0x74, 0x61, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x12,
-	0x4e, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65,
-	0x72, 0x64, 0x32, 0x2e, 0x74, 0x61, 0x70, 0x2e, 0x54, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x0e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x31, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x68, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x1a, 0xcd, 0x03, 0x0a, 0x04, 0x48,
+	0x74, 0x74, 0x70, 0x12, 0x18, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1e, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x53, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x74, 0x61, 0x70, 0x2e, 0x54, 0x61, 0x70,
+	0x42, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x3c, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x12, 0x53, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x39, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x74, 0x61, 0x70, 0x2e,
+	0x54, 0x61, 0x70, 0x42, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x48, 0x00, 0x52, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x1a, 0x31, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x1a, 0x37, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x42, 0x07, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x42, 0x07, 0x0a, 0x05, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x1a, 0xce, 0x01, 0x0a, 0x07, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12,
+	0x45, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x74, 0x61, 0x70, 0x2e, 0x54, 0x61, 0x70,
+	0x42, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x48, 0x00,
+	0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x1a, 0x71, 0x0a, 0x04, 0x48, 0x74, 0x74, 0x70, 0x12, 0x53,
+	0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x37, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x74, 0x61, 0x70, 0x2e, 0x54,
+	0x61, 0x70, 0x42, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x2e, 0x48, 0x74, 0x74, 0x70,
+	0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x48, 0x00, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x1a, 0x09, 0x0a, 0x07, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x42, 0x09,
+	0x0a, 0x07, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x65, 0x78, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x22, 0xc2, 0x0f, 0x0a, 0x08, 0x54, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x54, 0x63, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x74, 0x61, 0x70, 0x2e, 0x54,
+	0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x4d, 0x65, 0x74, 0x61, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61,
+	0x12, 0x41, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x54, 0x63, 0x70, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x4e, 0x0a, 0x10, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x74, 0x61, 0x70, 0x2e, 0x54, 0x61, 0x70,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4d, 0x65,
+	0x74, 0x61, 0x52, 0x0f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x65, 0x74, 0x61, 0x12, 0x3f, 0x0a, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6d, 0x65, 0x74,
+	0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72,
+	0x64, 0x32, 0x2e, 0x74, 0x61, 0x70, 0x2e, 0x54, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x4d, 0x65, 0x74, 0x61, 0x12, 0x4e, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e,
 	0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x74, 0x61, 0x70, 0x2e, 0x54, 0x61, 0x70,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x48, 0x00, 0x52, 0x04, 0x68, 0x74,
-	0x74, 0x70, 0x1a, 0x92, 0x01, 0x0a, 0x0c, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4d,
-	0x65, 0x74, 0x61, 0x12, 0x47, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x74,
-	0x61, 0x70, 0x2e, 0x54, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x64, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39, 0x0a, 0x0b,
-	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x8c, 0x01, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x44, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32,
-	0x2e, 0x74, 0x61, 0x70, 0x2e, 0x54, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c,
-	0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xf8, 0x08, 0x0a, 0x04, 0x48, 0x74, 0x74, 0x70, 0x12,
-	0x4c, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x74, 0x61,
+	0x70, 0x2e, 0x54, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x48,
+	0x00, 0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x1a, 0x92, 0x01, 0x0a, 0x0c, 0x45, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x47, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65,
+	0x72, 0x64, 0x32, 0x2e, 0x74, 0x61, 0x70, 0x2e, 0x54, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x2e, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x8c, 0x01, 0x0a,
+	0x09, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x44, 0x0a, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6c, 0x69, 0x6e,
+	0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x74, 0x61, 0x70, 0x2e, 0x54, 0x61, 0x70, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x2e, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xf8, 0x08, 0x0a, 0x04,
+	0x48, 0x74, 0x74, 0x70, 0x12, 0x4c, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x69, 0x6e, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6c, 0x69, 0x6e,
+	0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x74, 0x61, 0x70, 0x2e, 0x54, 0x61, 0x70, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x6e, 0x69, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e,
+	0x69, 0x74, 0x12, 0x4f, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x69,
+	0x6e, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x65, 0x72, 0x64, 0x32, 0x2e, 0x74, 0x61, 0x70, 0x2e, 0x54, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49,
+	0x6e, 0x69, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49,
+	0x6e, 0x69, 0x74, 0x12, 0x4c, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f,
+	0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x65, 0x72, 0x64, 0x32, 0x2e, 0x74, 0x61, 0x70, 0x2e, 0x54, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45,
+	0x6e, 0x64, 0x48, 0x00, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e,
+	0x64, 0x1a, 0x36, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x62, 0x61, 0x73,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x1a, 0x86, 0x02, 0x0a, 0x0b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x34, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32,
 	0x2e, 0x74, 0x61, 0x70, 0x2e, 0x54, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x48, 0x74,
-	0x74, 0x70, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x69, 0x74, 0x48, 0x00,
-	0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x4f, 0x0a,
-	0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e,
-	0x74, 0x61, 0x70, 0x2e, 0x54, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x48, 0x74, 0x74,
-	0x70, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x48, 0x00,
-	0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x4c,
-	0x0a, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e,
-	0x74, 0x61, 0x70, 0x2e, 0x54, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x48, 0x74, 0x74,
-	0x70, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x64, 0x48, 0x00, 0x52,
-	0x0b, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x64, 0x1a, 0x36, 0x0a, 0x08,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x1a, 0x86, 0x02, 0x0a, 0x0b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x49, 0x6e, 0x69, 0x74, 0x12, 0x34, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x74, 0x70, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x30, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x48,
+	0x74, 0x74, 0x70, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a,
+	0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x2f, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69,
+	0x7a, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x1a, 0xdf, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49,
+	0x6e, 0x69, 0x74, 0x12, 0x34, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x74, 0x61, 0x70, 0x2e, 0x54,
+	0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x49, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x47, 0x0a, 0x12, 0x73, 0x69, 0x6e,
+	0x63, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x10, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e,
+	0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x68, 0x74, 0x74, 0x70, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e,
+	0x76, 0x69, 0x7a, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x07, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x1a, 0xd6, 0x02, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x45, 0x6e, 0x64, 0x12, 0x34, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x24, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x74, 0x61, 0x70, 0x2e,
 	0x54, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x6d, 0x65,
-	0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x69, 0x6e,
-	0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x4d, 0x65,
-	0x74, 0x68, 0x6f, 0x64, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x2c, 0x0a, 0x06,
-	0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c,
-	0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x53, 0x63, 0x68, 0x65,
-	0x6d, 0x65, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x2f, 0x0a, 0x07,
-	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x73, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x1a, 0xdf, 0x01,
-	0x0a, 0x0c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x34,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x6e,
-	0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x74, 0x61, 0x70, 0x2e, 0x54, 0x61, 0x70, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x47, 0x0a, 0x12, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x47, 0x0a, 0x12, 0x73, 0x69,
+	0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x69, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x10, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x6e, 0x69, 0x74, 0x12, 0x49, 0x0a, 0x13, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x73, 0x69, 0x6e,
-	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0a, 0x68, 0x74, 0x74, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f,
-	0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x1a,
-	0xd6, 0x02, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x64, 0x12,
-	0x34, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x69,
-	0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x74, 0x61, 0x70, 0x2e, 0x54, 0x61, 0x70, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49,
-	0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x47, 0x0a, 0x12, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x73, 0x69,
-	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x49,
-	0x0a, 0x13, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x5f, 0x69, 0x6e, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73,
-	0x12, 0x23, 0x0a, 0x03, 0x65, 0x6f, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x45, 0x6f, 0x73,
-	0x52, 0x03, 0x65, 0x6f, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72,
-	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72,
-	0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x08,
-	0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x22, 0x38, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00,
-	0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x42, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a,
-	0x08, 0x4f, 0x55, 0x54, 0x42, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x42, 0x07, 0x0a, 0x05, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x32, 0x99, 0x01, 0x0a, 0x03, 0x54, 0x61, 0x70, 0x12, 0x3e, 0x0a, 0x03,
-	0x54, 0x61, 0x70, 0x12, 0x18, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x74,
-	0x61, 0x70, 0x2e, 0x54, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x74, 0x61, 0x70, 0x2e, 0x54, 0x61, 0x70,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x03, 0x88, 0x02, 0x01, 0x30, 0x01, 0x12, 0x52, 0x0a, 0x0d,
-	0x54, 0x61, 0x70, 0x42, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x22, 0x2e,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x73, 0x69, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x03, 0x65, 0x6f, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69,
+	0x7a, 0x2e, 0x45, 0x6f, 0x73, 0x52, 0x03, 0x65, 0x6f, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x74, 0x72,
+	0x61, 0x69, 0x6c, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x76, 0x69, 0x7a, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x52, 0x08, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x73, 0x42, 0x07, 0x0a,
+	0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x38, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x42, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4f, 0x55, 0x54, 0x42, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02,
+	0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0xb3, 0x01, 0x0a, 0x14, 0x54, 0x61,
+	0x70, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x07,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
 	0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x74, 0x61, 0x70, 0x2e, 0x54, 0x61, 0x70,
 	0x42, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x74, 0x61, 0x70,
-	0x2e, 0x54, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x03, 0x88, 0x02, 0x01, 0x30, 0x01,
-	0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c,
-	0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2f,
-	0x76, 0x69, 0x7a, 0x2f, 0x74, 0x61, 0x70, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x74, 0x61, 0x70, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x52, 0x0a, 0x09, 0x54, 0x61, 0x70, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x2c, 0x0a, 0x05,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x74, 0x61, 0x70, 0x2e, 0x54, 0x61, 0x70, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x69, 0x6d,
+	0x65, 0x4d, 0x73, 0x32, 0x99, 0x01, 0x0a, 0x03, 0x54, 0x61, 0x70, 0x12, 0x3e, 0x0a, 0x03, 0x54,
+	0x61, 0x70, 0x12, 0x18, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x74, 0x61,
+	0x70, 0x2e, 0x54, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x74, 0x61, 0x70, 0x2e, 0x54, 0x61, 0x70, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x22, 0x03, 0x88, 0x02, 0x01, 0x30, 0x01, 0x12, 0x52, 0x0a, 0x0d, 0x54,
+	0x61, 0x70, 0x42, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x22, 0x2e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x74, 0x61, 0x70, 0x2e, 0x54, 0x61, 0x70, 0x42,
+	0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2e, 0x74, 0x61, 0x70, 0x2e,
+	0x54, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x03, 0x88, 0x02, 0x01, 0x30, 0x01, 0x42,
+	0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69,
+	0x6e, 0x6b, 0x65, 0x72, 0x64, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x64, 0x32, 0x2f, 0x76,
+	0x69, 0x7a, 0x2f, 0x74, 0x61, 0x70, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x74, 0x61, 0x70, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1605,80 +1936,89 @@ func file_viz_tap_proto_rawDescGZIP() []byte {
 }
 
 var file_viz_tap_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_viz_tap_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_viz_tap_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_viz_tap_proto_goTypes = []interface{}{
-	(TapEvent_ProxyDirection)(0),                      // 0: linkerd2.tap.TapEvent.ProxyDirection
-	(*TapRequest)(nil),                                // 1: linkerd2.tap.TapRequest
-	(*TapByResourceRequest)(nil),                      // 2: linkerd2.tap.TapByResourceRequest
-	(*TapEvent)(nil),                                  // 3: linkerd2.tap.TapEvent
-	(*TapByResourceRequest_Match)(nil),                // 4: linkerd2.tap.TapByResourceRequest.Match
-	(*TapByResourceRequest_Extract)(nil),              // 5: linkerd2.tap.TapByResourceRequest.Extract
-	(*TapByResourceRequest_Match_Seq)(nil),            // 6: linkerd2.tap.TapByResourceRequest.Match.Seq
-	(*TapByResourceRequest_Match_Http)(nil),           // 7: linkerd2.tap.TapByResourceRequest.Match.Http
-	(*TapByResourceRequest_Extract_Http)(nil),         // 8: linkerd2.tap.TapByResourceRequest.Extract.Http
-	(*TapByResourceRequest_Extract_Http_Headers)(nil), // 9: linkerd2.tap.TapByResourceRequest.Extract.Http.Headers
-	(*TapEvent_EndpointMeta)(nil),                     // 10: linkerd2.tap.TapEvent.EndpointMeta
-	(*TapEvent_RouteMeta)(nil),                        // 11: linkerd2.tap.TapEvent.RouteMeta
-	(*TapEvent_Http)(nil),                             // 12: linkerd2.tap.TapEvent.Http
-	nil,                                               // 13: linkerd2.tap.TapEvent.EndpointMeta.LabelsEntry
-	nil,                                               // 14: linkerd2.tap.TapEvent.RouteMeta.LabelsEntry
-	(*TapEvent_Http_StreamId)(nil),                    // 15: linkerd2.tap.TapEvent.Http.StreamId
-	(*TapEvent_Http_RequestInit)(nil),                 // 16: linkerd2.tap.TapEvent.Http.RequestInit
-	(*TapEvent_Http_ResponseInit)(nil),                // 17: linkerd2.tap.TapEvent.Http.ResponseInit
-	(*TapEvent_Http_ResponseEnd)(nil),                 // 18: linkerd2.tap.TapEvent.Http.ResponseEnd
-	(*viz.ResourceSelection)(nil),                     // 19: linkerd2.viz.ResourceSelection
-	(*net.TcpAddress)(nil),                            // 20: linkerd2.common.net.TcpAddress
-	(*viz.HttpMethod)(nil),                            // 21: linkerd2.viz.HttpMethod
-	(*viz.Scheme)(nil),                                // 22: linkerd2.viz.Scheme
-	(*viz.Headers)(nil),                               // 23: linkerd2.viz.Headers
-	(*duration.Duration)(nil),                         // 24: google.protobuf.Duration
-	(*viz.Eos)(nil),                                   // 25: linkerd2.viz.Eos
+	(TapEvent_ProxyDirection)(0),                        // 0: linkerd2.tap.TapEvent.ProxyDirection
+	(*TapRequest)(nil),                                  // 1: linkerd2.tap.TapRequest
+	(*TapByResourceRequest)(nil),                        // 2: linkerd2.tap.TapByResourceRequest
+	(*TapEvent)(nil),                                    // 3: linkerd2.tap.TapEvent
+	(*TapRecordingMetadata)(nil),                        // 4: linkerd2.tap.TapRecordingMetadata
+	(*TapRecord)(nil),                                   // 5: linkerd2.tap.TapRecord
+	(*TapByResourceRequest_Match)(nil),                  // 6: linkerd2.tap.TapByResourceRequest.Match
+	(*TapByResourceRequest_Extract)(nil),                // 7: linkerd2.tap.TapByResourceRequest.Extract
+	(*TapByResourceRequest_Match_Seq)(nil),              // 8: linkerd2.tap.TapByResourceRequest.Match.Seq
+	(*TapByResourceRequest_Match_Http)(nil),             // 9: linkerd2.tap.TapByResourceRequest.Match.Http
+	(*TapByResourceRequest_Match_Http_StatusRange)(nil), // 10: linkerd2.tap.TapByResourceRequest.Match.Http.StatusRange
+	(*TapByResourceRequest_Match_Http_HeaderMatch)(nil), // 11: linkerd2.tap.TapByResourceRequest.Match.Http.HeaderMatch
+	(*TapByResourceRequest_Extract_Http)(nil),           // 12: linkerd2.tap.TapByResourceRequest.Extract.Http
+	(*TapByResourceRequest_Extract_Http_Headers)(nil),   // 13: linkerd2.tap.TapByResourceRequest.Extract.Http.Headers
+	(*TapEvent_EndpointMeta)(nil),                       // 14: linkerd2.tap.TapEvent.EndpointMeta
+	(*TapEvent_RouteMeta)(nil),                          // 15: linkerd2.tap.TapEvent.RouteMeta
+	(*TapEvent_Http)(nil),                               // 16: linkerd2.tap.TapEvent.Http
+	nil,                                                 // 17: linkerd2.tap.TapEvent.EndpointMeta.LabelsEntry
+	nil,                                                 // 18: linkerd2.tap.TapEvent.RouteMeta.LabelsEntry
+	(*TapEvent_Http_StreamId)(nil),                      // 19: linkerd2.tap.TapEvent.Http.StreamId
+	(*TapEvent_Http_RequestInit)(nil),                   // 20: linkerd2.tap.TapEvent.Http.RequestInit
+	(*TapEvent_Http_ResponseInit)(nil),                  // 21: linkerd2.tap.TapEvent.Http.ResponseInit
+	(*TapEvent_Http_ResponseEnd)(nil),                   // 22: linkerd2.tap.TapEvent.Http.ResponseEnd
+	(*viz.ResourceSelection)(nil),                       // 23: linkerd2.viz.ResourceSelection
+	(*net.TcpAddress)(nil),                              // 24: linkerd2.common.net.TcpAddress
+	(*duration.Duration)(nil),                           // 25: google.protobuf.Duration
+	(*viz.HttpMethod)(nil),                              // 26: linkerd2.viz.HttpMethod
+	(*viz.Scheme)(nil),                                  // 27: linkerd2.viz.Scheme
+	(*viz.Headers)(nil),                                 // 28: linkerd2.viz.Headers
+	(*viz.Eos)(nil),                                     // 29: linkerd2.viz.Eos
 }
 var file_viz_tap_proto_depIdxs = []int32{
-	19, // 0: linkerd2.tap.TapByResourceRequest.target:type_name -> linkerd2.viz.ResourceSelection
-	4,  // 1: linkerd2.tap.TapByResourceRequest.match:type_name -> linkerd2.tap.TapByResourceRequest.Match
-	5,  // 2: linkerd2.tap.TapByResourceRequest.extract:type_name -> linkerd2.tap.TapByResourceRequest.Extract
-	20, // 3: linkerd2.tap.TapEvent.source:type_name -> linkerd2.common.net.TcpAddress
-	10, // 4: linkerd2.tap.TapEvent.source_meta:type_name -> linkerd2.tap.TapEvent.EndpointMeta
-	20, // 5: linkerd2.tap.TapEvent.destination:type_name -> linkerd2.common.net.TcpAddress
-	10, // 6: linkerd2.tap.TapEvent.destination_meta:type_name -> linkerd2.tap.TapEvent.EndpointMeta
-	11, // 7: linkerd2.tap.TapEvent.route_meta:type_name -> linkerd2.tap.TapEvent.RouteMeta
+	23, // 0: linkerd2.tap.TapByResourceRequest.target:type_name -> linkerd2.viz.ResourceSelection
+	6,  // 1: linkerd2.tap.TapByResourceRequest.match:type_name -> linkerd2.tap.TapByResourceRequest.Match
+	7,  // 2: linkerd2.tap.TapByResourceRequest.extract:type_name -> linkerd2.tap.TapByResourceRequest.Extract
+	24, // 3: linkerd2.tap.TapEvent.source:type_name -> linkerd2.common.net.TcpAddress
+	14, // 4: linkerd2.tap.TapEvent.source_meta:type_name -> linkerd2.tap.TapEvent.EndpointMeta
+	24, // 5: linkerd2.tap.TapEvent.destination:type_name -> linkerd2.common.net.TcpAddress
+	14, // 6: linkerd2.tap.TapEvent.destination_meta:type_name -> linkerd2.tap.TapEvent.EndpointMeta
+	15, // 7: linkerd2.tap.TapEvent.route_meta:type_name -> linkerd2.tap.TapEvent.RouteMeta
 	0,  // 8: linkerd2.tap.TapEvent.proxy_direction:type_name -> linkerd2.tap.TapEvent.ProxyDirection
-	12, // 9: linkerd2.tap.TapEvent.http:type_name -> linkerd2.tap.TapEvent.Http
-	6,  // 10: linkerd2.tap.TapByResourceRequest.Match.all:type_name -> linkerd2.tap.TapByResourceRequest.Match.Seq
-	6,  // 11: linkerd2.tap.TapByResourceRequest.Match.any:type_name -> linkerd2.tap.TapByResourceRequest.Match.Seq
-	4,  // 12: linkerd2.tap.TapByResourceRequest.Match.not:type_name -> linkerd2.tap.TapByResourceRequest.Match
-	19, // 13: linkerd2.tap.TapByResourceRequest.Match.destinations:type_name -> linkerd2.viz.ResourceSelection
-	7,  // 14: linkerd2.tap.TapByResourceRequest.Match.http:type_name -> linkerd2.tap.TapByResourceRequest.Match.Http
-	8,  // 15: linkerd2.tap.TapByResourceRequest.Extract.http:type_name -> linkerd2.tap.TapByResourceRequest.Extract.Http
-	4,  // 16: linkerd2.tap.TapByResourceRequest.Match.Seq.matches:type_name -> linkerd2.tap.TapByResourceRequest.Match
-	9,  // 17: linkerd2.tap.TapByResourceRequest.Extract.Http.headers:type_name -> linkerd2.tap.TapByResourceRequest.Extract.Http.Headers
-	13, // 18: linkerd2.tap.TapEvent.EndpointMeta.labels:type_name -> linkerd2.tap.TapEvent.EndpointMeta.LabelsEntry
-	14, // 19: linkerd2.tap.TapEvent.RouteMeta.labels:type_name -> linkerd2.tap.TapEvent.RouteMeta.LabelsEntry
-	16, // 20: linkerd2.tap.TapEvent.Http.request_init:type_name -> linkerd2.tap.TapEvent.Http.RequestInit
-	17, // 21: linkerd2.tap.TapEvent.Http.response_init:type_name -> linkerd2.tap.TapEvent.Http.ResponseInit
-	18, // 22: linkerd2.tap.TapEvent.Http.response_end:type_name -> linkerd2.tap.TapEvent.Http.ResponseEnd
-	15, // 23: linkerd2.tap.TapEvent.Http.RequestInit.id:type_name -> linkerd2.tap.TapEvent.Http.StreamId
-	21, // 24: linkerd2.tap.TapEvent.Http.RequestInit.method:type_name -> linkerd2.viz.HttpMethod
-	22, // 25: linkerd2.tap.TapEvent.Http.RequestInit.scheme:type_name -> linkerd2.viz.Scheme
-	23, // 26: linkerd2.tap.TapEvent.Http.RequestInit.headers:type_name -> linkerd2.viz.Headers
-	15, // 27: linkerd2.tap.TapEvent.Http.ResponseInit.id:type_name -> linkerd2.tap.TapEvent.Http.StreamId
-	24, // 28: linkerd2.tap.TapEvent.Http.ResponseInit.since_request_init:type_name -> google.protobuf.Duration
-	23, // 29: linkerd2.tap.TapEvent.Http.ResponseInit.headers:type_name -> linkerd2.viz.Headers
-	15, // 30: linkerd2.tap.TapEvent.Http.ResponseEnd.id:type_name -> linkerd2.tap.TapEvent.Http.StreamId
-	24, // 31: linkerd2.tap.TapEvent.Http.ResponseEnd.since_request_init:type_name -> google.protobuf.Duration
-	24, // 32: linkerd2.tap.TapEvent.Http.ResponseEnd.since_response_init:type_name -> google.protobuf.Duration
-	25, // 33: linkerd2.tap.TapEvent.Http.ResponseEnd.eos:type_name -> linkerd2.viz.Eos
-	23, // 34: linkerd2.tap.TapEvent.Http.ResponseEnd.trailers:type_name -> linkerd2.viz.Headers
-	1,  // 35: linkerd2.tap.Tap.Tap:input_type -> linkerd2.tap.TapRequest
-	2,  // 36: linkerd2.tap.Tap.TapByResource:input_type -> linkerd2.tap.TapByResourceRequest
-	3,  // 37: linkerd2.tap.Tap.Tap:output_type -> linkerd2.tap.TapEvent
-	3,  // 38: linkerd2.tap.Tap.TapByResource:output_type -> linkerd2.tap.TapEvent
-	37, // [37:39] is the sub-list for method output_type
-	35, // [35:37] is the sub-list for method input_type
-	35, // [35:35] is the sub-list for extension type_name
-	35, // [35:35] is the sub-list for extension extendee
-	0,  // [0:35] is the sub-list for field type_name
+	16, // 9: linkerd2.tap.TapEvent.http:type_name -> linkerd2.tap.TapEvent.Http
+	2,  // 10: linkerd2.tap.TapRecordingMetadata.request:type_name -> linkerd2.tap.TapByResourceRequest
+	3,  // 11: linkerd2.tap.TapRecord.event:type_name -> linkerd2.tap.TapEvent
+	8,  // 12: linkerd2.tap.TapByResourceRequest.Match.all:type_name -> linkerd2.tap.TapByResourceRequest.Match.Seq
+	8,  // 13: linkerd2.tap.TapByResourceRequest.Match.any:type_name -> linkerd2.tap.TapByResourceRequest.Match.Seq
+	6,  // 14: linkerd2.tap.TapByResourceRequest.Match.not:type_name -> linkerd2.tap.TapByResourceRequest.Match
+	23, // 15: linkerd2.tap.TapByResourceRequest.Match.destinations:type_name -> linkerd2.viz.ResourceSelection
+	9,  // 16: linkerd2.tap.TapByResourceRequest.Match.http:type_name -> linkerd2.tap.TapByResourceRequest.Match.Http
+	12, // 17: linkerd2.tap.TapByResourceRequest.Extract.http:type_name -> linkerd2.tap.TapByResourceRequest.Extract.Http
+	6,  // 18: linkerd2.tap.TapByResourceRequest.Match.Seq.matches:type_name -> linkerd2.tap.TapByResourceRequest.Match
+	10, // 19: linkerd2.tap.TapByResourceRequest.Match.Http.status:type_name -> linkerd2.tap.TapByResourceRequest.Match.Http.StatusRange
+	25, // 20: linkerd2.tap.TapByResourceRequest.Match.Http.min_latency:type_name -> google.protobuf.Duration
+	11, // 21: linkerd2.tap.TapByResourceRequest.Match.Http.header:type_name -> linkerd2.tap.TapByResourceRequest.Match.Http.HeaderMatch
+	13, // 22: linkerd2.tap.TapByResourceRequest.Extract.Http.headers:type_name -> linkerd2.tap.TapByResourceRequest.Extract.Http.Headers
+	17, // 23: linkerd2.tap.TapEvent.EndpointMeta.labels:type_name -> linkerd2.tap.TapEvent.EndpointMeta.LabelsEntry
+	18, // 24: linkerd2.tap.TapEvent.RouteMeta.labels:type_name -> linkerd2.tap.TapEvent.RouteMeta.LabelsEntry
+	20, // 25: linkerd2.tap.TapEvent.Http.request_init:type_name -> linkerd2.tap.TapEvent.Http.RequestInit
+	21, // 26: linkerd2.tap.TapEvent.Http.response_init:type_name -> linkerd2.tap.TapEvent.Http.ResponseInit
+	22, // 27: linkerd2.tap.TapEvent.Http.response_end:type_name -> linkerd2.tap.TapEvent.Http.ResponseEnd
+	19, // 28: linkerd2.tap.TapEvent.Http.RequestInit.id:type_name -> linkerd2.tap.TapEvent.Http.StreamId
+	26, // 29: linkerd2.tap.TapEvent.Http.RequestInit.method:type_name -> linkerd2.viz.HttpMethod
+	27, // 30: linkerd2.tap.TapEvent.Http.RequestInit.scheme:type_name -> linkerd2.viz.Scheme
+	28, // 31: linkerd2.tap.TapEvent.Http.RequestInit.headers:type_name -> linkerd2.viz.Headers
+	19, // 32: linkerd2.tap.TapEvent.Http.ResponseInit.id:type_name -> linkerd2.tap.TapEvent.Http.StreamId
+	25, // 33: linkerd2.tap.TapEvent.Http.ResponseInit.since_request_init:type_name -> google.protobuf.Duration
+	28, // 34: linkerd2.tap.TapEvent.Http.ResponseInit.headers:type_name -> linkerd2.viz.Headers
+	19, // 35: linkerd2.tap.TapEvent.Http.ResponseEnd.id:type_name -> linkerd2.tap.TapEvent.Http.StreamId
+	25, // 36: linkerd2.tap.TapEvent.Http.ResponseEnd.since_request_init:type_name -> google.protobuf.Duration
+	25, // 37: linkerd2.tap.TapEvent.Http.ResponseEnd.since_response_init:type_name -> google.protobuf.Duration
+	29, // 38: linkerd2.tap.TapEvent.Http.ResponseEnd.eos:type_name -> linkerd2.viz.Eos
+	28, // 39: linkerd2.tap.TapEvent.Http.ResponseEnd.trailers:type_name -> linkerd2.viz.Headers
+	1,  // 40: linkerd2.tap.Tap.Tap:input_type -> linkerd2.tap.TapRequest
+	2,  // 41: linkerd2.tap.Tap.TapByResource:input_type -> linkerd2.tap.TapByResourceRequest
+	3,  // 42: linkerd2.tap.Tap.Tap:output_type -> linkerd2.tap.TapEvent
+	3,  // 43: linkerd2.tap.Tap.TapByResource:output_type -> linkerd2.tap.TapEvent
+	42, // [42:44] is the sub-list for method output_type
+	40, // [40:42] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_viz_tap_proto_init() }
@@ -1724,7 +2064,7 @@ func file_viz_tap_proto_init() {
 			}
 		}
 		file_viz_tap_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TapByResourceRequest_Match); i {
+			switch v := v.(*TapRecordingMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1736,7 +2076,7 @@ func file_viz_tap_proto_init() {
 			}
 		}
 		file_viz_tap_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TapByResourceRequest_Extract); i {
+			switch v := v.(*TapRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1748,7 +2088,7 @@ func file_viz_tap_proto_init() {
 			}
 		}
 		file_viz_tap_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TapByResourceRequest_Match_Seq); i {
+			switch v := v.(*TapByResourceRequest_Match); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1760,7 +2100,7 @@ func file_viz_tap_proto_init() {
 			}
 		}
 		file_viz_tap_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TapByResourceRequest_Match_Http); i {
+			switch v := v.(*TapByResourceRequest_Extract); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1772,7 +2112,7 @@ func file_viz_tap_proto_init() {
 			}
 		}
 		file_viz_tap_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TapByResourceRequest_Extract_Http); i {
+			switch v := v.(*TapByResourceRequest_Match_Seq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1784,7 +2124,7 @@ func file_viz_tap_proto_init() {
 			}
 		}
 		file_viz_tap_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TapByResourceRequest_Extract_Http_Headers); i {
+			switch v := v.(*TapByResourceRequest_Match_Http); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1796,7 +2136,7 @@ func file_viz_tap_proto_init() {
 			}
 		}
 		file_viz_tap_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TapEvent_EndpointMeta); i {
+			switch v := v.(*TapByResourceRequest_Match_Http_StatusRange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1808,7 +2148,7 @@ func file_viz_tap_proto_init() {
 			}
 		}
 		file_viz_tap_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TapEvent_RouteMeta); i {
+			switch v := v.(*TapByResourceRequest_Match_Http_HeaderMatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1820,7 +2160,31 @@ func file_viz_tap_proto_init() {
 			}
 		}
 		file_viz_tap_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TapEvent_Http); i {
+			switch v := v.(*TapByResourceRequest_Extract_Http); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_viz_tap_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TapByResourceRequest_Extract_Http_Headers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_viz_tap_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TapEvent_EndpointMeta); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1832,7 +2196,7 @@ func file_viz_tap_proto_init() {
 			}
 		}
 		file_viz_tap_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TapEvent_Http_StreamId); i {
+			switch v := v.(*TapEvent_RouteMeta); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1844,6 +2208,30 @@ func file_viz_tap_proto_init() {
 			}
 		}
 		file_viz_tap_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TapEvent_Http); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_viz_tap_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TapEvent_Http_StreamId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_viz_tap_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TapEvent_Http_RequestInit); i {
 			case 0:
 				return &v.state
@@ -1855,7 +2243,7 @@ func file_viz_tap_proto_init() {
 				return nil
 			}
 		}
-		file_viz_tap_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_viz_tap_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TapEvent_Http_ResponseInit); i {
 			case 0:
 				return &v.state
@@ -1867,7 +2255,7 @@ func file_viz_tap_proto_init() {
 				return nil
 			}
 		}
-		file_viz_tap_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_viz_tap_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TapEvent_Http_ResponseEnd); i {
 			case 0:
 				return &v.state
@@ -1887,26 +2275,29 @@ func file_viz_tap_proto_init() {
 	file_viz_tap_proto_msgTypes[2].OneofWrappers = []interface{}{
 		(*TapEvent_Http_)(nil),
 	}
-	file_viz_tap_proto_msgTypes[3].OneofWrappers = []interface{}{
+	file_viz_tap_proto_msgTypes[5].OneofWrappers = []interface{}{
 		(*TapByResourceRequest_Match_All)(nil),
 		(*TapByResourceRequest_Match_Any)(nil),
 		(*TapByResourceRequest_Match_Not)(nil),
 		(*TapByResourceRequest_Match_Destinations)(nil),
 		(*TapByResourceRequest_Match_Http_)(nil),
 	}
-	file_viz_tap_proto_msgTypes[4].OneofWrappers = []interface{}{
+	file_viz_tap_proto_msgTypes[6].OneofWrappers = []interface{}{
 		(*TapByResourceRequest_Extract_Http_)(nil),
 	}
-	file_viz_tap_proto_msgTypes[6].OneofWrappers = []interface{}{
+	file_viz_tap_proto_msgTypes[8].OneofWrappers = []interface{}{
 		(*TapByResourceRequest_Match_Http_Scheme)(nil),
 		(*TapByResourceRequest_Match_Http_Method)(nil),
 		(*TapByResourceRequest_Match_Http_Authority)(nil),
 		(*TapByResourceRequest_Match_Http_Path)(nil),
+		(*TapByResourceRequest_Match_Http_Status)(nil),
+		(*TapByResourceRequest_Match_Http_MinLatency)(nil),
+		(*TapByResourceRequest_Match_Http_Header)(nil),
 	}
-	file_viz_tap_proto_msgTypes[7].OneofWrappers = []interface{}{
+	file_viz_tap_proto_msgTypes[11].OneofWrappers = []interface{}{
 		(*TapByResourceRequest_Extract_Http_Headers_)(nil),
 	}
-	file_viz_tap_proto_msgTypes[11].OneofWrappers = []interface{}{
+	file_viz_tap_proto_msgTypes[15].OneofWrappers = []interface{}{
 		(*TapEvent_Http_RequestInit_)(nil),
 		(*TapEvent_Http_ResponseInit_)(nil),
 		(*TapEvent_Http_ResponseEnd_)(nil),
@@ -1917,7 +2308,7 @@ func file_viz_tap_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_viz_tap_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   18,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -2,8 +2,11 @@ package pkg
 
 import (
 	"encoding/binary"
+	"fmt"
 
 	netPb "github.com/linkerd/linkerd2/controller/gen/common/net"
+	"github.com/linkerd/linkerd2/pkg/addr"
+	metricsPb "github.com/linkerd/linkerd2/viz/metrics-api/gen/viz"
 	tapPb "github.com/linkerd/linkerd2/viz/tap/gen/tap"
 )
 
@@ -38,3 +41,61 @@ func CreateTapEvent(eventHTTP *tapPb.TapEvent_Http, dstMeta map[string]string, p
 	}
 	return event
 }
+
+// StreamID identifies the HTTP stream of a tap event, so that the request and
+// response events of a request can be paired. The base distinguishes the
+// connections sharing the same addresses.
+type StreamID struct {
+	Src    string
+	Dst    string
+	Base   uint32
+	Stream uint64
+}
+
+func (id StreamID) String() string {
+	return fmt.Sprintf("%s->%s(%d:%d)", id.Src, id.Dst, id.Base, id.Stream)
+}
+
+// EventStreamID returns the StreamID of an HTTP tap event, and false if the
+// event isn't one
+func EventStreamID(event *tapPb.TapEvent) (StreamID, bool) {
+	var id *tapPb.TapEvent_Http_StreamId
+	switch ev := event.GetHttp().GetEvent().(type) {
+	case *tapPb.TapEvent_Http_RequestInit_:
+		id = ev.RequestInit.GetId()
+	case *tapPb.TapEvent_Http_ResponseInit_:
+		id = ev.ResponseInit.GetId()
+	case *tapPb.TapEvent_Http_ResponseEnd_:
+		id = ev.ResponseEnd.GetId()
+	default:
+		return StreamID{}, false
+	}
+	return StreamID{
+		Src:    addr.PublicAddressToString(event.GetSource()),
+		Dst:    addr.PublicAddressToString(event.GetDestination()),
+		Base:   id.GetBase(),
+		Stream: id.GetStream(),
+	}, true
+}
+
+// FormatMethod returns the name of an HTTP method
+func FormatMethod(m *metricsPb.HttpMethod) string {
+	if x, ok := m.GetType().(*metricsPb.HttpMethod_Registered_); ok {
+		return x.Registered.String()
+	}
+	if s, ok := m.GetType().(*metricsPb.HttpMethod_Unregistered); ok {
+		return s.Unregistered
+	}
+	return ""
+}
+
+// FormatScheme returns the name of a URL scheme
+func FormatScheme(s *metricsPb.Scheme) string {
+	if x, ok := s.GetType().(*metricsPb.Scheme_Registered_); ok {
+		return x.Registered.String()
+	}
+	if str, ok := s.GetType().(*metricsPb.Scheme_Unregistered); ok {
+		return str.Unregistered
+	}
+	return ""
+}
@@ -0,0 +1,280 @@
+package pkg
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	tapPb "github.com/linkerd/linkerd2/viz/tap/gen/tap"
+)
+
+// maxPendingStreams bounds the number of requests an EventFilter keeps track
+// of, as the end of some requests may never be observed
+const maxPendingStreams = 10000
+
+// EventFilter evaluates the matches of a TapByResourceRequest that the proxies
+// can't evaluate: the response status, latency and request header matches.
+// These depend on several events of a request, so the events of a request are
+// held until it's known whether the request matches.
+//
+// An EventFilter isn't safe for concurrent use. A nil EventFilter matches all
+// the events.
+type EventFilter struct {
+	statuses    []*tapPb.TapByResourceRequest_Match_Http_StatusRange
+	minLatency  time.Duration
+	headers     []*tapPb.TapByResourceRequest_Match_Http_HeaderMatch
+	hasResponse bool
+
+	// the matches otherwise evaluated by the proxies, for recorded events
+	requests     []*tapPb.TapByResourceRequest_Match_Http
+	destinations []map[string]string
+
+	streams map[StreamID]*filterStream
+}
+
+type filterStream struct {
+	matched bool
+	// events held until the response is known to match
+	held []*tapPb.TapEvent
+}
+
+// NewEventFilter builds an EventFilter from the matches of a
+// TapByResourceRequest, or returns nil if there are no matches to evaluate
+// on the events.
+func NewEventFilter(match *tapPb.TapByResourceRequest_Match) (*EventFilter, error) {
+	return newEventFilter(match, false)
+}
+
+// NewReplayFilter builds an EventFilter evaluating all the matches of a
+// TapByResourceRequest, including the ones evaluated by the proxies on live
+// events, to filter recorded events. It returns nil if there are no matches.
+func NewReplayFilter(match *tapPb.TapByResourceRequest_Match) (*EventFilter, error) {
+	return newEventFilter(match, true)
+}
+
+func newEventFilter(match *tapPb.TapByResourceRequest_Match, replay bool) (*EventFilter, error) {
+	filter := &EventFilter{}
+	for _, m := range match.GetAll().GetMatches() {
+		if replay && m.GetDestinations() != nil {
+			filter.destinations = append(filter.destinations, DestinationLabels(m.GetDestinations().GetResource()))
+			continue
+		}
+		switch typed := m.GetHttp().GetMatch().(type) {
+		case *tapPb.TapByResourceRequest_Match_Http_Scheme,
+			*tapPb.TapByResourceRequest_Match_Http_Method,
+			*tapPb.TapByResourceRequest_Match_Http_Authority,
+			*tapPb.TapByResourceRequest_Match_Http_Path:
+			if replay {
+				filter.requests = append(filter.requests, m.GetHttp())
+			}
+		case *tapPb.TapByResourceRequest_Match_Http_Status:
+			if typed.Status.GetMin() > typed.Status.GetMax() {
+				return nil, fmt.Errorf("invalid status range: %d-%d", typed.Status.GetMin(), typed.Status.GetMax())
+			}
+			filter.statuses = append(filter.statuses, typed.Status)
+			filter.hasResponse = true
+		case *tapPb.TapByResourceRequest_Match_Http_MinLatency:
+			if err := typed.MinLatency.CheckValid(); err != nil {
+				return nil, fmt.Errorf("invalid minimum latency: %s", err)
+			}
+			if latency := typed.MinLatency.AsDuration(); latency > filter.minLatency {
+				filter.minLatency = latency
+			}
+			filter.hasResponse = true
+		case *tapPb.TapByResourceRequest_Match_Http_Header:
+			if typed.Header.GetName() == "" {
+				return nil, fmt.Errorf("header match without a name")
+			}
+			filter.headers = append(filter.headers, typed.Header)
+		}
+	}
+
+	if !filter.hasResponse && len(filter.headers) == 0 && len(filter.requests) == 0 && len(filter.destinations) == 0 {
+		return nil, nil
+	}
+	filter.streams = make(map[StreamID]*filterStream)
+	return filter, nil
+}
+
+// NeedsHeaders returns true if the filter matches the headers of the
+// requests, which then need to be extracted from the proxies.
+func (f *EventFilter) NeedsHeaders() bool {
+	return f != nil && len(f.headers) > 0
+}
+
+// Filter takes the next event of the tap stream and returns the events to
+// pass along: none if the event is held or doesn't match, or the event along
+// with the events held for its request once it matches.
+func (f *EventFilter) Filter(event *tapPb.TapEvent) []*tapPb.TapEvent {
+	if f == nil {
+		return []*tapPb.TapEvent{event}
+	}
+
+	id, _ := EventStreamID(event)
+	switch ev := event.GetHttp().GetEvent().(type) {
+	case *tapPb.TapEvent_Http_RequestInit_:
+		if !f.matchDestination(event) || !f.matchRequest(ev.RequestInit) || !f.matchHeaders(ev.RequestInit) {
+			return nil
+		}
+		if len(f.streams) >= maxPendingStreams {
+			// forget the requests whose end was never observed
+			f.streams = make(map[StreamID]*filterStream)
+		}
+		if !f.hasResponse {
+			f.streams[id] = &filterStream{matched: true}
+			return []*tapPb.TapEvent{event}
+		}
+		f.streams[id] = &filterStream{held: []*tapPb.TapEvent{event}}
+		return nil
+
+	case *tapPb.TapEvent_Http_ResponseInit_:
+		stream, ok := f.streams[id]
+		if !ok {
+			return nil
+		}
+		if stream.matched {
+			return []*tapPb.TapEvent{event}
+		}
+		if !f.matchResponse(ev.ResponseInit) {
+			delete(f.streams, id)
+			return nil
+		}
+		events := append(stream.held, event)
+		stream.matched = true
+		stream.held = nil
+		return events
+
+	case *tapPb.TapEvent_Http_ResponseEnd_:
+		stream, ok := f.streams[id]
+		delete(f.streams, id)
+		// requests ending without a response never match a response match
+		if !ok || !stream.matched {
+			return nil
+		}
+		return []*tapPb.TapEvent{event}
+	}
+
+	return nil
+}
+
+func (f *EventFilter) matchDestination(event *tapPb.TapEvent) bool {
+	labels := event.GetDestinationMeta().GetLabels()
+	for _, destination := range f.destinations {
+		for k, v := range destination {
+			if labels[k] != v {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (f *EventFilter) matchRequest(req *tapPb.TapEvent_Http_RequestInit) bool {
+	for _, match := range f.requests {
+		switch typed := match.GetMatch().(type) {
+		case *tapPb.TapByResourceRequest_Match_Http_Scheme:
+			if !strings.EqualFold(FormatScheme(req.GetScheme()), typed.Scheme) {
+				return false
+			}
+		case *tapPb.TapByResourceRequest_Match_Http_Method:
+			if !strings.EqualFold(FormatMethod(req.GetMethod()), typed.Method) {
+				return false
+			}
+		case *tapPb.TapByResourceRequest_Match_Http_Authority:
+			if req.GetAuthority() != typed.Authority {
+				return false
+			}
+		case *tapPb.TapByResourceRequest_Match_Http_Path:
+			if !strings.HasPrefix(req.GetPath(), typed.Path) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (f *EventFilter) matchHeaders(req *tapPb.TapEvent_Http_RequestInit) bool {
+	for _, match := range f.headers {
+		found := false
+		for _, header := range req.GetHeaders().GetHeaders() {
+			if !strings.EqualFold(header.GetName(), match.GetName()) {
+				continue
+			}
+			value := header.GetValueStr()
+			if header.GetValueBin() != nil {
+				value = string(header.GetValueBin())
+			}
+			if match.GetValue() == "" || value == match.GetValue() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *EventFilter) matchResponse(rsp *tapPb.TapEvent_Http_ResponseInit) bool {
+	for _, status := range f.statuses {
+		if rsp.GetHttpStatus() < status.GetMin() || rsp.GetHttpStatus() > status.GetMax() {
+			return false
+		}
+	}
+	return rsp.GetSinceRequestInit().AsDuration() >= f.minLatency
+}
+
+// ParseStatusRange parses an HTTP status (e.g. 503), class (e.g. 5xx) or
+// inclusive range (e.g. 500-504) into a StatusRange.
+func ParseStatusRange(s string) (*tapPb.TapByResourceRequest_Match_Http_StatusRange, error) {
+	invalid := fmt.Errorf("invalid status %q: expected a status (503), a class (5xx) or a range (500-504)", s)
+
+	lower := strings.ToLower(s)
+	if len(lower) == 3 && strings.HasSuffix(lower, "xx") {
+		class, err := strconv.ParseUint(lower[:1], 10, 32)
+		if err != nil || class < 1 || class > 5 {
+			return nil, invalid
+		}
+		return &tapPb.TapByResourceRequest_Match_Http_StatusRange{
+			Min: uint32(class * 100),
+			Max: uint32(class*100 + 99),
+		}, nil
+	}
+
+	bounds := strings.SplitN(s, "-", 2)
+	statuses := make([]uint32, len(bounds))
+	for i, bound := range bounds {
+		status, err := strconv.ParseUint(strings.TrimSpace(bound), 10, 32)
+		if err != nil || status < 100 || status > 599 {
+			return nil, invalid
+		}
+		statuses[i] = uint32(status)
+	}
+	if len(statuses) == 1 {
+		statuses = append(statuses, statuses[0])
+	}
+	if statuses[0] > statuses[1] {
+		return nil, invalid
+	}
+	return &tapPb.TapByResourceRequest_Match_Http_StatusRange{
+		Min: statuses[0],
+		Max: statuses[1],
+	}, nil
+}
+
+// ParseHeaderMatch parses a header match of the form NAME=VALUE, or NAME to
+// match any value.
+func ParseHeaderMatch(s string) (*tapPb.TapByResourceRequest_Match_Http_HeaderMatch, error) {
+	parts := strings.SplitN(s, "=", 2)
+	name := strings.TrimSpace(parts[0])
+	if name == "" {
+		return nil, fmt.Errorf("invalid header %q: expected NAME=VALUE or NAME", s)
+	}
+	match := &tapPb.TapByResourceRequest_Match_Http_HeaderMatch{Name: name}
+	if len(parts) == 2 {
+		match.Value = parts[1]
+	}
+	return match, nil
+}
@@ -0,0 +1,206 @@
+package pkg
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	metricsPb "github.com/linkerd/linkerd2/viz/metrics-api/gen/viz"
+	tapPb "github.com/linkerd/linkerd2/viz/tap/gen/tap"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func requestInit(stream uint64, headers map[string]string) *tapPb.TapEvent {
+	hs := &metricsPb.Headers{}
+	for name, value := range headers {
+		hs.Headers = append(hs.Headers, &metricsPb.Headers_Header{
+			Name:  name,
+			Value: &metricsPb.Headers_Header_ValueStr{ValueStr: value},
+		})
+	}
+	return CreateTapEvent(&tapPb.TapEvent_Http{
+		Event: &tapPb.TapEvent_Http_RequestInit_{
+			RequestInit: &tapPb.TapEvent_Http_RequestInit{
+				Id:      &tapPb.TapEvent_Http_StreamId{Base: 1, Stream: stream},
+				Headers: hs,
+			},
+		},
+	}, nil, tapPb.TapEvent_OUTBOUND)
+}
+
+func responseInit(stream uint64, status uint32, latency time.Duration) *tapPb.TapEvent {
+	return CreateTapEvent(&tapPb.TapEvent_Http{
+		Event: &tapPb.TapEvent_Http_ResponseInit_{
+			ResponseInit: &tapPb.TapEvent_Http_ResponseInit{
+				Id:               &tapPb.TapEvent_Http_StreamId{Base: 1, Stream: stream},
+				HttpStatus:       status,
+				SinceRequestInit: durationpb.New(latency),
+			},
+		},
+	}, nil, tapPb.TapEvent_OUTBOUND)
+}
+
+func responseEnd(stream uint64) *tapPb.TapEvent {
+	return CreateTapEvent(&tapPb.TapEvent_Http{
+		Event: &tapPb.TapEvent_Http_ResponseEnd_{
+			ResponseEnd: &tapPb.TapEvent_Http_ResponseEnd{
+				Id: &tapPb.TapEvent_Http_StreamId{Base: 1, Stream: stream},
+			},
+		},
+	}, nil, tapPb.TapEvent_OUTBOUND)
+}
+
+func buildMatch(t *testing.T, params TapRequestParams) *tapPb.TapByResourceRequest_Match {
+	params.Resource = "deploy/web"
+	req, err := BuildTapByResourceRequest(params)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	return req.GetMatch()
+}
+
+func TestEventFilter(t *testing.T) {
+	t.Run("Returns no filter without matches on the events", func(t *testing.T) {
+		filter, err := NewEventFilter(buildMatch(t, TapRequestParams{Path: "/api"}))
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if filter != nil {
+			t.Fatalf("Expected no filter, got %+v", filter)
+		}
+		event := requestInit(1, nil)
+		if events := filter.Filter(event); len(events) != 1 || events[0] != event {
+			t.Fatalf("Expected the event to pass, got %+v", events)
+		}
+	})
+
+	t.Run("Holds the requests until their response matches", func(t *testing.T) {
+		filter, err := NewEventFilter(buildMatch(t, TapRequestParams{
+			Status:     "5xx",
+			MinLatency: 100 * time.Millisecond,
+		}))
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		slowFailure := []*tapPb.TapEvent{requestInit(1, nil), responseInit(1, 503, time.Second), responseEnd(1)}
+		fastFailure := []*tapPb.TapEvent{requestInit(2, nil), responseInit(2, 503, time.Millisecond), responseEnd(2)}
+		slowSuccess := []*tapPb.TapEvent{requestInit(3, nil), responseInit(3, 200, time.Second), responseEnd(3)}
+
+		expected := [][]*tapPb.TapEvent{
+			nil, nil, nil,
+			{slowFailure[0], slowFailure[1]}, nil, nil,
+			{slowFailure[2]}, nil, nil,
+		}
+		for i, event := range []*tapPb.TapEvent{
+			slowFailure[0], fastFailure[0], slowSuccess[0],
+			slowFailure[1], fastFailure[1], slowSuccess[1],
+			slowFailure[2], fastFailure[2], slowSuccess[2],
+		} {
+			if events := filter.Filter(event); !reflect.DeepEqual(events, expected[i]) {
+				t.Fatalf("Unexpected events for event %d: expected %+v, got %+v", i, expected[i], events)
+			}
+		}
+		if len(filter.streams) != 0 {
+			t.Fatalf("Expected no pending streams, got %d", len(filter.streams))
+		}
+	})
+
+	t.Run("Matches the request headers", func(t *testing.T) {
+		filter, err := NewEventFilter(buildMatch(t, TapRequestParams{
+			Headers: []string{"X-Canary=true", "x-user"},
+		}))
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if !filter.NeedsHeaders() {
+			t.Fatal("Expected the filter to need the headers")
+		}
+
+		matching := requestInit(1, map[string]string{"x-canary": "true", "x-user": "alice"})
+		if events := filter.Filter(matching); len(events) != 1 {
+			t.Fatalf("Expected the matching request to pass, got %+v", events)
+		}
+		if events := filter.Filter(responseEnd(1)); len(events) != 1 {
+			t.Fatalf("Expected the end of the matching request to pass, got %+v", events)
+		}
+
+		for _, headers := range []map[string]string{
+			{"x-canary": "false", "x-user": "alice"},
+			{"x-canary": "true"},
+		} {
+			if events := filter.Filter(requestInit(2, headers)); len(events) != 0 {
+				t.Fatalf("Expected the request with headers %v to be filtered out, got %+v", headers, events)
+			}
+			if events := filter.Filter(responseEnd(2)); len(events) != 0 {
+				t.Fatalf("Expected the end of the request with headers %v to be filtered out, got %+v", headers, events)
+			}
+		}
+	})
+
+	t.Run("Returns an error for an invalid status range", func(t *testing.T) {
+		_, err := NewEventFilter(&tapPb.TapByResourceRequest_Match{
+			Match: &tapPb.TapByResourceRequest_Match_All{
+				All: &tapPb.TapByResourceRequest_Match_Seq{
+					Matches: []*tapPb.TapByResourceRequest_Match{
+						{
+							Match: &tapPb.TapByResourceRequest_Match_Http_{
+								Http: &tapPb.TapByResourceRequest_Match_Http{
+									Match: &tapPb.TapByResourceRequest_Match_Http_Status{
+										Status: &tapPb.TapByResourceRequest_Match_Http_StatusRange{Min: 599, Max: 500},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+		expectedError := "invalid status range: 599-500"
+		if err == nil || err.Error() != expectedError {
+			t.Fatalf("Expected error [%s], got [%s]", expectedError, err)
+		}
+	})
+}
+
+func TestParseStatusRange(t *testing.T) {
+	for status, expected := range map[string][]uint32{
+		"503":     {503, 503},
+		"5xx":     {500, 599},
+		"4XX":     {400, 499},
+		"500-504": {500, 504},
+		"6xx":     nil,
+		"504-500": nil,
+		"42":      nil,
+		"foo":     nil,
+	} {
+		statusRange, err := ParseStatusRange(status)
+		if expected == nil {
+			if err == nil {
+				t.Errorf("Expected an error for status %q, got %+v", status, statusRange)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Unexpected error for status %q: %s", status, err)
+			continue
+		}
+		if statusRange.GetMin() != expected[0] || statusRange.GetMax() != expected[1] {
+			t.Errorf("Expected %v for status %q, got %d-%d", expected, status, statusRange.GetMin(), statusRange.GetMax())
+		}
+	}
+}
+
+func TestParseHeaderMatch(t *testing.T) {
+	match, err := ParseHeaderMatch("x-token=a=b")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if match.GetName() != "x-token" || match.GetValue() != "a=b" {
+		t.Fatalf("Unexpected header match: %+v", match)
+	}
+
+	if _, err := ParseHeaderMatch("=value"); err == nil {
+		t.Fatal("Expected an error for a header without a name")
+	}
+}
@@ -0,0 +1,104 @@
+package pkg
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/linkerd/linkerd2/pkg/protohttp"
+	"github.com/linkerd/linkerd2/pkg/version"
+	tapPb "github.com/linkerd/linkerd2/viz/tap/gen/tap"
+)
+
+// RecordingVersion is the version of the format of the tap recordings
+// written by RecordingWriter.
+//
+// A recording starts with recordingMagic, followed by a TapRecordingMetadata
+// and a TapRecord per recorded event, with when the event was received. The
+// messages are delimited the same way as in the streams of the tap APIServer.
+const RecordingVersion = 1
+
+var recordingMagic = []byte("linkerd-tap\n")
+
+// RecordingWriter writes tap events to a recording.
+type RecordingWriter struct {
+	w io.Writer
+}
+
+// NewRecordingWriter writes the header of a recording of the events of a
+// TapByResourceRequest to w, and returns a RecordingWriter to write the
+// events with.
+func NewRecordingWriter(w io.Writer, req *tapPb.TapByResourceRequest) (*RecordingWriter, error) {
+	metadata := &tapPb.TapRecordingMetadata{
+		Version:     RecordingVersion,
+		Request:     req,
+		StartTimeMs: time.Now().UnixNano() / int64(time.Millisecond),
+		CliVersion:  version.Version,
+	}
+	if _, err := w.Write(recordingMagic); err != nil {
+		return nil, err
+	}
+	recorder := &RecordingWriter{w}
+	if err := recorder.write(metadata); err != nil {
+		return nil, err
+	}
+	return recorder, nil
+}
+
+// Write appends an event received at t to the recording.
+func (r *RecordingWriter) Write(event *tapPb.TapEvent, t time.Time) error {
+	return r.write(&tapPb.TapRecord{
+		Event:  event,
+		TimeMs: t.UnixNano() / int64(time.Millisecond),
+	})
+}
+
+func (r *RecordingWriter) write(msg proto.Message) error {
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	// write each message at once, so that an interrupted recording only
+	// misses its last events
+	_, err = r.w.Write(protohttp.SerializeAsPayload(b))
+	return err
+}
+
+// RecordingReader reads the events of a recording.
+type RecordingReader struct {
+	r *bufio.Reader
+}
+
+// Read returns the next event of the recording along with when it was
+// received, or io.EOF at the end of the recording.
+func (r *RecordingReader) Read() (*tapPb.TapEvent, time.Time, error) {
+	record := &tapPb.TapRecord{}
+	if err := protohttp.FromByteStreamToProtocolBuffers(r.r, record); err != nil {
+		return nil, time.Time{}, err
+	}
+	return record.GetEvent(), time.Unix(0, record.GetTimeMs()*int64(time.Millisecond)), nil
+}
+
+// OpenRecording reads the header of a recording from r, and returns its
+// metadata along with a reader of its events.
+func OpenRecording(r io.Reader) (*tapPb.TapRecordingMetadata, *RecordingReader, error) {
+	reader := bufio.NewReader(r)
+
+	magic := make([]byte, len(recordingMagic))
+	if _, err := io.ReadFull(reader, magic); err != nil || !bytes.Equal(magic, recordingMagic) {
+		return nil, nil, fmt.Errorf("not a tap recording")
+	}
+
+	metadata := &tapPb.TapRecordingMetadata{}
+	if err := protohttp.FromByteStreamToProtocolBuffers(reader, metadata); err != nil {
+		return nil, nil, fmt.Errorf("invalid tap recording metadata: %s", err)
+	}
+	if metadata.GetVersion() > RecordingVersion {
+		return nil, nil, fmt.Errorf("unsupported tap recording version %d, this CLI supports up to version %d", metadata.GetVersion(), RecordingVersion)
+	}
+
+	return metadata, &RecordingReader{reader}, nil
+}
@@ -0,0 +1,85 @@
+package pkg
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/linkerd/linkerd2/pkg/protohttp"
+	tapPb "github.com/linkerd/linkerd2/viz/tap/gen/tap"
+)
+
+func TestRecording(t *testing.T) {
+	t.Run("Reads the recorded events", func(t *testing.T) {
+		req, err := BuildTapByResourceRequest(TapRequestParams{Resource: "deploy/web", Namespace: "emojivoto"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		var recording bytes.Buffer
+		recorder, err := NewRecordingWriter(&recording, req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		events := []*tapPb.TapEvent{requestInit(1, nil), responseInit(1, 200, 0), responseEnd(1)}
+		start := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+		for i, event := range events {
+			if err := recorder.Write(event, start.Add(time.Duration(i)*time.Millisecond)); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		}
+
+		metadata, reader, err := OpenRecording(&recording)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if metadata.GetVersion() != RecordingVersion {
+			t.Fatalf("Expected version %d, got %d", RecordingVersion, metadata.GetVersion())
+		}
+		if !proto.Equal(metadata.GetRequest(), req) {
+			t.Fatalf("Expected request %+v, got %+v", req, metadata.GetRequest())
+		}
+
+		for i, expected := range events {
+			event, received, err := reader.Read()
+			if err != nil {
+				t.Fatalf("Unexpected error reading event %d: %s", i, err)
+			}
+			if !proto.Equal(event, expected) {
+				t.Fatalf("Expected event %d to be %+v, got %+v", i, expected, event)
+			}
+			if expectedReceived := start.Add(time.Duration(i) * time.Millisecond); !received.Equal(expectedReceived) {
+				t.Fatalf("Expected event %d to be received at %s, got %s", i, expectedReceived, received)
+			}
+		}
+		_, _, err = reader.Read()
+		if !errors.Is(err, io.EOF) {
+			t.Fatalf("Expected the end of the recording, got %s", err)
+		}
+	})
+
+	t.Run("Returns an error for a file that isn't a recording", func(t *testing.T) {
+		_, _, err := OpenRecording(bytes.NewBufferString("{\"source\": {}}\n"))
+		expectedError := "not a tap recording"
+		if err == nil || err.Error() != expectedError {
+			t.Fatalf("Expected error [%s], got [%s]", expectedError, err)
+		}
+	})
+
+	t.Run("Returns an error for a recording of a newer version", func(t *testing.T) {
+		b, err := proto.Marshal(&tapPb.TapRecordingMetadata{Version: RecordingVersion + 1})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		recording := append(append([]byte{}, recordingMagic...), protohttp.SerializeAsPayload(b)...)
+
+		_, _, err = OpenRecording(bytes.NewReader(recording))
+		expectedError := "unsupported tap recording version 2, this CLI supports up to version 1"
+		if err == nil || err.Error() != expectedError {
+			t.Fatalf("Expected error [%s], got [%s]", expectedError, err)
+		}
+	})
+}
@@ -2,12 +2,14 @@ package pkg
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/linkerd/linkerd2/controller/api/util"
 	"github.com/linkerd/linkerd2/pkg/k8s"
 	metricsPb "github.com/linkerd/linkerd2/viz/metrics-api/gen/viz"
 	"github.com/linkerd/linkerd2/viz/pkg"
 	tapPb "github.com/linkerd/linkerd2/viz/tap/gen/tap"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 // ValidTapDestinations specifies resource types allowed as a tap destination:
@@ -37,6 +39,9 @@ type TapRequestParams struct {
 	Method        string
 	Authority     string
 	Path          string
+	Status        string
+	MinLatency    time.Duration
+	Headers       []string
 	Extract       bool
 	LabelSelector string
 }
@@ -52,6 +57,34 @@ func BuildTapByResourceRequest(params TapRequestParams) (*tapPb.TapByResourceReq
 		return nil, fmt.Errorf("unsupported resource type [%s]", target.Type)
 	}
 
+	match, err := BuildTapMatch(params)
+	if err != nil {
+		return nil, err
+	}
+
+	extract := &tapPb.TapByResourceRequest_Extract{}
+	if params.Extract {
+		extract = buildExtractHTTP(&tapPb.TapByResourceRequest_Extract_Http{
+			Extract: &tapPb.TapByResourceRequest_Extract_Http_Headers_{
+				Headers: &tapPb.TapByResourceRequest_Extract_Http_Headers{},
+			},
+		})
+	}
+
+	return &tapPb.TapByResourceRequest{
+		Target: &metricsPb.ResourceSelection{
+			Resource:      target,
+			LabelSelector: params.LabelSelector,
+		},
+		MaxRps:  params.MaxRps,
+		Match:   match,
+		Extract: extract,
+	}, nil
+}
+
+// BuildTapMatch builds the match of a TapByResourceRequest from the matching
+// parameters of a TapRequestParams.
+func BuildTapMatch(params TapRequestParams) (*tapPb.TapByResourceRequest_Match, error) {
 	matches := []*tapPb.TapByResourceRequest_Match{}
 
 	if params.ToResource != "" {
@@ -98,29 +131,42 @@ func BuildTapByResourceRequest(params TapRequestParams) (*tapPb.TapByResourceReq
 		matches = append(matches, &match)
 	}
 
-	extract := &tapPb.TapByResourceRequest_Extract{}
-	if params.Extract {
-		extract = buildExtractHTTP(&tapPb.TapByResourceRequest_Extract_Http{
-			Extract: &tapPb.TapByResourceRequest_Extract_Http_Headers_{
-				Headers: &tapPb.TapByResourceRequest_Extract_Http_Headers{},
-			},
+	if params.Status != "" {
+		status, err := ParseStatusRange(params.Status)
+		if err != nil {
+			return nil, err
+		}
+		match := buildMatchHTTP(&tapPb.TapByResourceRequest_Match_Http{
+			Match: &tapPb.TapByResourceRequest_Match_Http_Status{Status: status},
+		})
+		matches = append(matches, &match)
+	}
+	if params.MinLatency < 0 {
+		return nil, fmt.Errorf("minimum latency must be positive, got %s", params.MinLatency)
+	}
+	if params.MinLatency > 0 {
+		match := buildMatchHTTP(&tapPb.TapByResourceRequest_Match_Http{
+			Match: &tapPb.TapByResourceRequest_Match_Http_MinLatency{MinLatency: durationpb.New(params.MinLatency)},
+		})
+		matches = append(matches, &match)
+	}
+	for _, h := range params.Headers {
+		header, err := ParseHeaderMatch(h)
+		if err != nil {
+			return nil, err
+		}
+		match := buildMatchHTTP(&tapPb.TapByResourceRequest_Match_Http{
+			Match: &tapPb.TapByResourceRequest_Match_Http_Header{Header: header},
 		})
+		matches = append(matches, &match)
 	}
 
-	return &tapPb.TapByResourceRequest{
-		Target: &metricsPb.ResourceSelection{
-			Resource:      target,
-			LabelSelector: params.LabelSelector,
-		},
-		MaxRps: params.MaxRps,
-		Match: &tapPb.TapByResourceRequest_Match{
-			Match: &tapPb.TapByResourceRequest_Match_All{
-				All: &tapPb.TapByResourceRequest_Match_Seq{
-					Matches: matches,
-				},
+	return &tapPb.TapByResourceRequest_Match{
+		Match: &tapPb.TapByResourceRequest_Match_All{
+			All: &tapPb.TapByResourceRequest_Match_Seq{
+				Matches: matches,
 			},
 		},
-		Extract: extract,
 	}, nil
 }
 
@@ -140,6 +186,21 @@ func buildExtractHTTP(extract *tapPb.TapByResourceRequest_Extract_Http) *tapPb.T
 	}
 }
 
+// DestinationLabels returns the labels of the destinations of the events
+// matching a destination resource
+// TODO: factor out with `promLabels` in public-api
+func DestinationLabels(resource *metricsPb.Resource) map[string]string {
+	dstLabels := map[string]string{}
+	if resource.Name != "" {
+		l5dLabel := k8s.KindToL5DLabel(resource.Type)
+		dstLabels[l5dLabel] = resource.Name
+	}
+	if resource.Type != k8s.Namespace && resource.Namespace != "" {
+		dstLabels["namespace"] = resource.Namespace
+	}
+	return dstLabels
+}
+
 func contains(list []string, s string) bool {
 	for _, elem := range list {
 		if s == elem {
@@ -15,6 +15,7 @@ const routeToCrumbTitle = {
   routes: <Trans>menuItemRoutes</Trans>,
   community: <Trans>menuItemCommunity</Trans>,
   gateways: <Trans>menuItemGateway</Trans>,
+  slos: <Trans>menuItemSLOs</Trans>,
 };
 
 class BreadcrumbHeader extends React.Component {
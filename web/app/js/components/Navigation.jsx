@@ -28,6 +28,7 @@ import Version from './Version.jsx';
 import _isEmpty from 'lodash/isEmpty';
 import _maxBy from 'lodash/maxBy';
 import { faBars } from '@fortawesome/free-solid-svg-icons/faBars';
+import { faBullseye } from '@fortawesome/free-solid-svg-icons/faBullseye';
 import { faCloud } from '@fortawesome/free-solid-svg-icons/faCloud';
 import { faDungeon } from '@fortawesome/free-solid-svg-icons/faDungeon';
 import { faExternalLinkAlt } from '@fortawesome/free-solid-svg-icons/faExternalLinkAlt';
@@ -474,6 +475,9 @@ class NavigationBase extends React.Component {
           { showGatewayLink && this.menuItem('/gateways', <Trans>menuItemGateway</Trans>,
             <FontAwesomeIcon icon={faDungeon} className={classes.shrinkIcon} />) }
 
+          { this.menuItem('/slos', <Trans>menuItemSLOs</Trans>,
+            <FontAwesomeIcon icon={faBullseye} className={classes.shrinkIcon} />) }
+
         </MenuList>
 
         <Divider />
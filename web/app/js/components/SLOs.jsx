@@ -0,0 +1,225 @@
+import { displayName, formatLatencyMs } from './util/Utils.js';
+import { handlePageVisibility, withPageVisibility } from './util/PageVisibility.jsx';
+import BaseTable from './BaseTable.jsx';
+import ErrorBanner from './ErrorBanner.jsx';
+import PropTypes from 'prop-types';
+import React from 'react';
+import Spinner from './util/Spinner.jsx';
+import { Trans } from '@lingui/macro';
+import Tooltip from '@material-ui/core/Tooltip';
+import WarningIcon from '@material-ui/icons/Warning';
+import _isEmpty from 'lodash/isEmpty';
+import _isNil from 'lodash/isNil';
+import { withContext } from './util/AppContext.jsx';
+
+const formatPercent = ratio => `${(ratio * 100).toFixed(2)}%`;
+
+const hasTraffic = sr => parseInt(sr.successCount, 10) + parseInt(sr.failureCount, 10) > 0;
+
+const processSLOs = rsp => {
+  if (_isNil(rsp.ok)) {
+    return [];
+  }
+  return rsp.ok.objectives.map(o => ({
+    key: `${o.namespace}-${o.name}`,
+    namespace: o.namespace,
+    name: o.name,
+    target: o.target,
+    window: o.window,
+    successRate: o.successRate,
+    latency: o.latency,
+    error: o.error,
+  }));
+};
+
+const sloColumns = [
+  {
+    title: <Trans>columnTitleNamespace</Trans>,
+    dataIndex: 'namespace',
+    filter: d => d.namespace,
+    sorter: d => d.namespace,
+  },
+  {
+    title: <Trans>columnTitleName</Trans>,
+    dataIndex: 'name',
+    filter: d => d.name,
+    sorter: d => d.name,
+    render: d => (
+      <React.Fragment>
+        {d.name}
+        {_isEmpty(d.error) ? null : (
+          <Tooltip title={d.error}>
+            <WarningIcon fontSize="small" color="error" />
+          </Tooltip>
+        )}
+      </React.Fragment>
+    ),
+  },
+  {
+    title: <Trans>columnTitleTarget</Trans>,
+    dataIndex: 'target',
+    filter: d => displayName(d.target),
+    render: d => displayName(d.target),
+  },
+  {
+    title: <Trans>columnTitleWindow</Trans>,
+    dataIndex: 'window',
+  },
+  {
+    title: <Trans>columnTitleSuccessRateObjective</Trans>,
+    dataIndex: 'successRateObjective',
+    isNumeric: true,
+    render: d => _isNil(d.successRate) ? '---' : formatPercent(d.successRate.objective),
+  },
+  {
+    title: <Trans>columnTitleSuccessRate</Trans>,
+    dataIndex: 'successRate',
+    isNumeric: true,
+    render: d => _isNil(d.successRate) || !hasTraffic(d.successRate) ? '---' : formatPercent(d.successRate.successRate),
+  },
+  {
+    title: <Trans>columnTitleErrorBudget</Trans>,
+    dataIndex: 'errorBudget',
+    isNumeric: true,
+    render: d => _isNil(d.successRate) ? '---' : formatPercent(d.successRate.errorBudgetRemaining),
+  },
+  {
+    title: <Trans>columnTitleBurnRate</Trans>,
+    dataIndex: 'burnRate',
+    isNumeric: true,
+    render: d => _isNil(d.successRate) ? '---' : `${d.successRate.burnRate.toFixed(2)}x (${d.successRate.burnRateWindow})`,
+  },
+  {
+    title: <Trans>columnTitleLatencyObjective</Trans>,
+    dataIndex: 'latencyObjective',
+    isNumeric: true,
+    render: d => _isNil(d.latency) ? '---' : `p${d.latency.percentile} ≤ ${formatLatencyMs(parseInt(d.latency.thresholdMs, 10))}`,
+  },
+  {
+    title: <Trans>columnTitleLatency</Trans>,
+    dataIndex: 'latency',
+    isNumeric: true,
+    render: d => {
+      if (_isNil(d.latency) || d.latency.noData) {
+        return '---';
+      }
+      const latency = formatLatencyMs(parseInt(d.latency.latencyMs, 10));
+      return d.latency.met ? latency : <span className="status-poor">{latency}</span>;
+    },
+  },
+];
+
+class SLOs extends React.Component {
+  constructor(props) {
+    super(props);
+    this.api = props.api;
+    this.handleApiError = this.handleApiError.bind(this);
+    this.loadFromServer = this.loadFromServer.bind(this);
+    this.state = {
+      pollingInterval: 10000,
+      objectives: [],
+      pendingRequests: false,
+      loaded: false,
+      error: null,
+    };
+  }
+
+  componentDidMount() {
+    this.startServerPolling();
+  }
+
+  componentDidUpdate(prevProps) {
+    const { isPageVisible } = this.props;
+
+    handlePageVisibility({
+      prevVisibilityState: prevProps.isPageVisible,
+      currentVisibilityState: isPageVisible,
+      onVisible: () => this.startServerPolling(),
+      onHidden: () => this.stopServerPolling(),
+    });
+  }
+
+  componentWillUnmount() {
+    this.stopServerPolling();
+  }
+
+  startServerPolling() {
+    const { pollingInterval } = this.state;
+
+    this.loadFromServer();
+    this.timerId = window.setInterval(this.loadFromServer, pollingInterval);
+  }
+
+  stopServerPolling() {
+    window.clearInterval(this.timerId);
+    this.api.cancelCurrentRequests();
+    this.setState({ pendingRequests: false });
+  }
+
+  loadFromServer() {
+    const { pendingRequests } = this.state;
+    if (pendingRequests) {
+      return; // don't make more requests if the ones we sent haven't completed
+    }
+    this.setState({ pendingRequests: true });
+
+    this.api.setCurrentRequests([this.api.fetchSLOs()]);
+
+    Promise.all(this.api.getCurrentPromises())
+      .then(([slos]) => {
+        this.setState({
+          objectives: processSLOs(slos),
+          loaded: true,
+          pendingRequests: false,
+          error: slos.error ? { error: slos.error.error } : null,
+        });
+      })
+      .catch(this.handleApiError);
+  }
+
+  handleApiError = e => {
+    if (e.isCanceled) {
+      return;
+    }
+
+    this.setState({
+      pendingRequests: false,
+      error: e,
+    });
+  };
+
+  render() {
+    const { objectives, loaded, error } = this.state;
+    return (
+      <div className="page-content">
+        {!error ? null : <ErrorBanner message={error} />}
+        {!loaded ? (
+          <Spinner />
+        ) : (
+          <div className="page-section">
+            <BaseTable
+              defaultOrderBy="name"
+              enableFilter
+              tableRows={objectives}
+              tableColumns={sloColumns}
+              tableClassName="metric-table"
+              title={<Trans>tableTitleSLOs</Trans>}
+              padding="dense" />
+          </div>
+        )}
+      </div>
+    );
+  }
+}
+
+SLOs.propTypes = {
+  api: PropTypes.shape({
+    cancelCurrentRequests: PropTypes.func.isRequired,
+    fetchSLOs: PropTypes.func.isRequired,
+    getCurrentPromises: PropTypes.func.isRequired,
+    setCurrentRequests: PropTypes.func.isRequired,
+  }).isRequired,
+  isPageVisible: PropTypes.bool.isRequired,
+};
+
+export default withPageVisibility(withContext(SLOs));
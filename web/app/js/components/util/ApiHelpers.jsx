@@ -61,6 +61,7 @@ const ApiHelpers = (pathPrefix, defaultMetricsWindow = '1m') => {
   const servicesPath = '/api/services';
   const edgesPath = '/api/edges';
   const gatewaysPath = '/api/gateways';
+  const slosPath = '/api/slos';
   const l5dExtensionsPath = '/api/extension';
 
   const validMetricsWindows = {
@@ -142,6 +143,10 @@ const ApiHelpers = (pathPrefix, defaultMetricsWindow = '1m') => {
     return apiFetch(gatewaysPath);
   };
 
+  const fetchSLOs = () => {
+    return apiFetch(slosPath);
+  };
+
   const fetchCheck = () => {
     return apiFetch('/api/check');
   };
@@ -260,6 +265,7 @@ const ApiHelpers = (pathPrefix, defaultMetricsWindow = '1m') => {
     fetchServices,
     fetchEdges,
     fetchGateways,
+    fetchSLOs,
     fetchExtension,
     fetchCheck,
     fetchResourceDefinition,
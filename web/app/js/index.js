@@ -21,6 +21,7 @@ import React from 'react';
 import ReactDOM from 'react-dom';
 import ResourceDetail from './components/ResourceDetail.jsx';
 import ResourceList from './components/ResourceList.jsx';
+import SLOs from './components/SLOs.jsx';
 import ServiceMesh from './components/ServiceMesh.jsx';
 import Tap from './components/Tap.jsx';
 import Top from './components/Top.jsx';
@@ -138,6 +139,9 @@ function AppHTML() {
               <Route
                 path={`${pathPrefix}/gateways`}
                 render={props => <Navigation {...props} ChildComponent={Gateway} resource="gateway" />} />
+              <Route
+                path={`${pathPrefix}/slos`}
+                render={props => <Navigation {...props} ChildComponent={SLOs} />} />
               <Route
                 exact
                 path={`${pathPrefix}/namespaces/:namespace`}
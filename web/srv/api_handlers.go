@@ -476,3 +476,15 @@ func (h *handler) handleAPIGateways(w http.ResponseWriter, req *http.Request, _
 	}
 	renderJSONPb(w, result)
 }
+
+func (h *handler) handleAPISLOs(w http.ResponseWriter, req *http.Request, _ httprouter.Params) {
+	sloRequest := &metricsPb.ServiceLevelObjectivesRequest{
+		Namespace: req.FormValue("namespace"),
+	}
+	result, err := h.apiClient.ServiceLevelObjectives(req.Context(), sloRequest)
+	if err != nil {
+		renderJSONError(w, err, http.StatusInternalServerError)
+		return
+	}
+	renderJSONPb(w, result)
+}
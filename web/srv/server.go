@@ -137,6 +137,7 @@ func NewServer(
 	server.router.GET("/controlplane", handler.handleIndex)
 	server.router.GET("/namespaces", handler.handleIndex)
 	server.router.GET("/gateways", handler.handleIndex)
+	server.router.GET("/slos", handler.handleIndex)
 
 	// paths for a list of resources by namespace
 	server.router.GET("/namespaces/:namespace/daemonsets", handler.handleIndex)
@@ -195,6 +196,7 @@ func NewServer(
 	server.router.GET("/api/check", handler.handleAPICheck)
 	server.router.GET("/api/resource-definition", handler.handleAPIResourceDefinition)
 	server.router.GET("/api/gateways", handler.handleAPIGateways)
+	server.router.GET("/api/slos", handler.handleAPISLOs)
 	server.router.GET("/api/extension", handler.handleGetExtension)
 
 	// grafana proxy